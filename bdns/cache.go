@@ -0,0 +1,184 @@
+package bdns
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// challengePrefix is the label under which DNS-01 challenge TXT records are
+// provisioned. Lookups of these are never cached, since the subscriber may
+// have only just provisioned the record and the VA must see it.
+const challengePrefix = "_acme-challenge."
+
+// cachingClient is an implementation of Client which caches the answers of an
+// underlying Client in memory, for as long as their TTL allows but no longer
+// than a configured maximum. Empty answers (NXDOMAIN and NODATA) are cached
+// according to their negative caching TTL, subject to a separate maximum.
+// Errors are never cached. It is safe for concurrent access so long as the
+// underlying Client is.
+type cachingClient struct {
+	// Note: This must be a regular mutex, not an RWMutex, because cache.Get()
+	// actually mutates the lru.Cache (by updating the last-used info).
+	sync.Mutex
+	under          Client
+	maxTTL         time.Duration
+	maxNegativeTTL time.Duration
+	cache          *lru.Cache
+	clk            clock.Clock
+	requests       *prometheus.CounterVec
+}
+
+var _ Client = &cachingClient{}
+
+// NewCache returns a Client which caches up to maxEntries answers from under.
+// Answers are cached for their TTL, capped at maxTTL, or for empty answers at
+// maxNegativeTTL. A cap of zero disables caching of that kind of answer.
+func NewCache(
+	under Client,
+	maxEntries int,
+	maxTTL time.Duration,
+	maxNegativeTTL time.Duration,
+	clk clock.Clock,
+	stats prometheus.Registerer,
+) *cachingClient {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dns_cache_requests",
+		Help: "Counter of DNS lookups made through the cache, sliced by query type and whether the answer was cached",
+	}, []string{"qtype", "status"})
+	stats.MustRegister(requests)
+	return &cachingClient{
+		under:          under,
+		maxTTL:         maxTTL,
+		maxNegativeTTL: maxNegativeTTL,
+		cache:          lru.New(maxEntries),
+		clk:            clk,
+		requests:       requests,
+	}
+}
+
+type cacheKey struct {
+	qtype    uint16
+	hostname string
+}
+
+type cacheEntry struct {
+	txts     []string
+	ips      []net.IP
+	caas     []*dns.CAA
+	response string
+	info     LookupInfo
+	expires  time.Time
+}
+
+// get returns the unexpired entry for the given query, if any, along with
+// the remaining lifetime of the entry.
+func (cc *cachingClient) get(key cacheKey) (cacheEntry, time.Duration, bool) {
+	qtype := dns.TypeToString[key.qtype]
+	cc.Lock()
+	defer cc.Unlock()
+	val, ok := cc.cache.Get(key)
+	if !ok {
+		cc.requests.WithLabelValues(qtype, "miss").Inc()
+		return cacheEntry{}, 0, false
+	}
+	entry := val.(cacheEntry)
+	remaining := entry.expires.Sub(cc.clk.Now())
+	if remaining <= 0 {
+		cc.cache.Remove(key)
+		cc.requests.WithLabelValues(qtype, "expired").Inc()
+		return cacheEntry{}, 0, false
+	}
+	cc.requests.WithLabelValues(qtype, "hit").Inc()
+	return entry, remaining, true
+}
+
+// put caches entry for the TTL in its LookupInfo, capped at the maximum for
+// positive or negative answers as appropriate.
+func (cc *cachingClient) put(key cacheKey, entry cacheEntry, negative bool) {
+	ttl := entry.info.TTL
+	limit := cc.maxTTL
+	if negative {
+		limit = cc.maxNegativeTTL
+	}
+	if ttl > limit {
+		ttl = limit
+	}
+	if ttl <= 0 {
+		return
+	}
+	entry.expires = cc.clk.Now().Add(ttl)
+	cc.Lock()
+	defer cc.Unlock()
+	cc.cache.Add(key, entry)
+}
+
+func newCacheKey(qtype uint16, hostname string) cacheKey {
+	return cacheKey{qtype: qtype, hostname: strings.ToLower(strings.TrimSuffix(hostname, "."))}
+}
+
+// withRemaining returns info with its TTL reduced to the remaining lifetime of
+// the cache entry it came from.
+func withRemaining(info LookupInfo, remaining time.Duration) LookupInfo {
+	info.TTL = remaining.Truncate(time.Second)
+	return info
+}
+
+// LookupTXT returns the cached TXT records for hostname, or looks them up.
+// Lookups of DNS-01 challenge records are always passed through.
+func (cc *cachingClient) LookupTXT(ctx context.Context, hostname string) ([]string, LookupInfo, error) {
+	key := newCacheKey(dns.TypeTXT, hostname)
+	if strings.HasPrefix(key.hostname, challengePrefix) {
+		cc.requests.WithLabelValues(dns.TypeToString[key.qtype], "uncacheable").Inc()
+		return cc.under.LookupTXT(ctx, hostname)
+	}
+	entry, remaining, ok := cc.get(key)
+	if ok {
+		return append([]string(nil), entry.txts...), withRemaining(entry.info, remaining), nil
+	}
+	txts, info, err := cc.under.LookupTXT(ctx, hostname)
+	if err != nil {
+		return nil, info, err
+	}
+	cc.put(key, cacheEntry{txts: append([]string(nil), txts...), info: info}, len(txts) == 0)
+	return txts, info, nil
+}
+
+// LookupHost returns the cached addresses for hostname, or looks them up.
+func (cc *cachingClient) LookupHost(ctx context.Context, hostname string) ([]net.IP, LookupInfo, error) {
+	key := newCacheKey(dns.TypeA, hostname)
+	entry, remaining, ok := cc.get(key)
+	if ok {
+		return append([]net.IP(nil), entry.ips...), withRemaining(entry.info, remaining), nil
+	}
+	ips, info, err := cc.under.LookupHost(ctx, hostname)
+	if err != nil {
+		return nil, info, err
+	}
+	cc.put(key, cacheEntry{ips: append([]net.IP(nil), ips...), info: info}, len(ips) == 0)
+	return ips, info, nil
+}
+
+// LookupCAA returns the cached CAA records for hostname, or looks them up.
+// This is where the cache helps most, since checking CAA for a name means
+// looking up every one of its parent domains.
+func (cc *cachingClient) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, string, LookupInfo, error) {
+	key := newCacheKey(dns.TypeCAA, hostname)
+	entry, remaining, ok := cc.get(key)
+	if ok {
+		return append([]*dns.CAA(nil), entry.caas...), entry.response, withRemaining(entry.info, remaining), nil
+	}
+	caas, response, info, err := cc.under.LookupCAA(ctx, hostname)
+	if err != nil {
+		return nil, response, info, err
+	}
+	cc.put(key, cacheEntry{caas: append([]*dns.CAA(nil), caas...), response: response, info: info}, len(caas) == 0)
+	return caas, response, info, nil
+}
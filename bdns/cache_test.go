@@ -0,0 +1,131 @@
+package bdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// countingClient is a Client which answers every lookup with a fixed TTL and
+// counts the lookups it receives.
+type countingClient struct {
+	ttl     time.Duration
+	lookups map[string]int
+}
+
+func (c *countingClient) LookupTXT(_ context.Context, hostname string) ([]string, LookupInfo, error) {
+	c.lookups["TXT "+hostname]++
+	return []string{"hello"}, LookupInfo{TTL: c.ttl}, nil
+}
+
+func (c *countingClient) LookupHost(_ context.Context, hostname string) ([]net.IP, LookupInfo, error) {
+	c.lookups["A "+hostname]++
+	if hostname == "servfail.com" {
+		return nil, LookupInfo{}, errors.New("SERVFAIL")
+	}
+	return []net.IP{net.ParseIP("127.0.0.1")}, LookupInfo{TTL: c.ttl}, nil
+}
+
+func (c *countingClient) LookupCAA(_ context.Context, hostname string) ([]*dns.CAA, string, LookupInfo, error) {
+	c.lookups["CAA "+hostname]++
+	if hostname == "example.com" {
+		return []*dns.CAA{{Tag: "issue", Value: "letsencrypt.org"}}, "response", LookupInfo{TTL: c.ttl}, nil
+	}
+	return nil, "", LookupInfo{TTL: c.ttl}, nil
+}
+
+func TestCache(t *testing.T) {
+	clk := clock.NewFake()
+	under := &countingClient{ttl: time.Hour, lookups: map[string]int{}}
+	cache := NewCache(under, 10, 10*time.Minute, time.Minute, clk, metrics.NoopRegisterer)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		caas, response, info, err := cache.LookupCAA(ctx, "example.com")
+		test.AssertNotError(t, err, "LookupCAA failed")
+		test.AssertEquals(t, len(caas), 1)
+		test.AssertEquals(t, response, "response")
+		if i > 0 {
+			// Cached answers report the remaining lifetime of the entry.
+			test.AssertEquals(t, info.TTL, 10*time.Minute)
+		}
+		_, _, _, err = cache.LookupCAA(ctx, "www.example.com")
+		test.AssertNotError(t, err, "LookupCAA failed")
+	}
+	test.AssertEquals(t, under.lookups["CAA example.com"], 1)
+	test.AssertEquals(t, under.lookups["CAA www.example.com"], 1)
+	test.AssertMetricWithLabelsEquals(t, cache.requests, prometheus.Labels{"qtype": "CAA", "status": "hit"}, 4)
+	test.AssertMetricWithLabelsEquals(t, cache.requests, prometheus.Labels{"qtype": "CAA", "status": "miss"}, 2)
+
+	// Names are case-insensitive.
+	_, _, _, err := cache.LookupCAA(ctx, "EXAMPLE.com.")
+	test.AssertNotError(t, err, "LookupCAA failed")
+	test.AssertEquals(t, under.lookups["CAA example.com"], 1)
+
+	// The empty answer expires first, after the maximum negative TTL.
+	clk.Add(2 * time.Minute)
+	_, _, _, _ = cache.LookupCAA(ctx, "example.com")
+	_, _, _, _ = cache.LookupCAA(ctx, "www.example.com")
+	test.AssertEquals(t, under.lookups["CAA example.com"], 1)
+	test.AssertEquals(t, under.lookups["CAA www.example.com"], 2)
+	test.AssertMetricWithLabelsEquals(t, cache.requests, prometheus.Labels{"qtype": "CAA", "status": "expired"}, 1)
+
+	// And the positive answer after the maximum TTL, despite its longer TTL.
+	clk.Add(9 * time.Minute)
+	_, _, _, _ = cache.LookupCAA(ctx, "example.com")
+	test.AssertEquals(t, under.lookups["CAA example.com"], 2)
+
+	// Errors aren't cached.
+	for i := 0; i < 2; i++ {
+		_, _, err = cache.LookupHost(ctx, "servfail.com")
+		test.AssertError(t, err, "LookupHost didn't fail")
+	}
+	test.AssertEquals(t, under.lookups["A servfail.com"], 2)
+
+	// Neither are DNS-01 challenge records.
+	for i := 0; i < 2; i++ {
+		_, _, err = cache.LookupTXT(ctx, "_acme-challenge.example.com")
+		test.AssertNotError(t, err, "LookupTXT failed")
+		_, _, err = cache.LookupTXT(ctx, "example.com")
+		test.AssertNotError(t, err, "LookupTXT failed")
+	}
+	test.AssertEquals(t, under.lookups["TXT _acme-challenge.example.com"], 2)
+	test.AssertEquals(t, under.lookups["TXT example.com"], 1)
+	test.AssertMetricWithLabelsEquals(t, cache.requests, prometheus.Labels{"qtype": "TXT", "status": "uncacheable"}, 2)
+}
+
+func TestCacheZeroTTL(t *testing.T) {
+	under := &countingClient{lookups: map[string]int{}}
+	cache := NewCache(under, 10, time.Hour, time.Hour, clock.NewFake(), metrics.NoopRegisterer)
+	for i := 0; i < 2; i++ {
+		_, _, err := cache.LookupHost(context.Background(), "example.com")
+		test.AssertNotError(t, err, "LookupHost failed")
+	}
+	test.AssertEquals(t, under.lookups["A example.com"], 2)
+}
+
+func TestResponseTTL(t *testing.T) {
+	m := new(dns.Msg)
+	test.AssertEquals(t, responseTTL(m), time.Duration(0))
+
+	soa, err := dns.NewRR("example.com. 3600 IN SOA ns.example.com. hostmaster.example.com. 1 3600 600 86400 300")
+	test.AssertNotError(t, err, "parsing SOA")
+	m.Ns = []dns.RR{soa}
+	test.AssertEquals(t, responseTTL(m), 300*time.Second)
+
+	a, err := dns.NewRR("example.com. 60 IN A 127.0.0.1")
+	test.AssertNotError(t, err, "parsing A")
+	cname, err := dns.NewRR("www.example.com. 120 IN CNAME example.com.")
+	test.AssertNotError(t, err, "parsing CNAME")
+	m.Answer = []dns.RR{cname, a}
+	test.AssertEquals(t, responseTTL(m), 60*time.Second)
+}
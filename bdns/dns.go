@@ -2,6 +2,7 @@ package bdns
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -15,141 +16,16 @@ import (
 	"github.com/miekg/dns"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/letsencrypt/boulder/iana"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 )
 
-func parseCidr(network string, comment string) net.IPNet {
-	_, net, err := net.ParseCIDR(network)
-	if err != nil {
-		panic(fmt.Sprintf("error parsing %s (%s): %s", network, comment, err))
-	}
-	return *net
-}
-
-var (
-	// Private CIDRs to ignore
-	privateNetworks = []net.IPNet{
-		// RFC1918
-		// 10.0.0.0/8
-		{
-			IP:   []byte{10, 0, 0, 0},
-			Mask: []byte{255, 0, 0, 0},
-		},
-		// 172.16.0.0/12
-		{
-			IP:   []byte{172, 16, 0, 0},
-			Mask: []byte{255, 240, 0, 0},
-		},
-		// 192.168.0.0/16
-		{
-			IP:   []byte{192, 168, 0, 0},
-			Mask: []byte{255, 255, 0, 0},
-		},
-		// RFC5735
-		// 127.0.0.0/8
-		{
-			IP:   []byte{127, 0, 0, 0},
-			Mask: []byte{255, 0, 0, 0},
-		},
-		// RFC1122 Section 3.2.1.3
-		// 0.0.0.0/8
-		{
-			IP:   []byte{0, 0, 0, 0},
-			Mask: []byte{255, 0, 0, 0},
-		},
-		// RFC3927
-		// 169.254.0.0/16
-		{
-			IP:   []byte{169, 254, 0, 0},
-			Mask: []byte{255, 255, 0, 0},
-		},
-		// RFC 5736
-		// 192.0.0.0/24
-		{
-			IP:   []byte{192, 0, 0, 0},
-			Mask: []byte{255, 255, 255, 0},
-		},
-		// RFC 5737
-		// 192.0.2.0/24
-		{
-			IP:   []byte{192, 0, 2, 0},
-			Mask: []byte{255, 255, 255, 0},
-		},
-		// 198.51.100.0/24
-		{
-			IP:   []byte{198, 51, 100, 0},
-			Mask: []byte{255, 255, 255, 0},
-		},
-		// 203.0.113.0/24
-		{
-			IP:   []byte{203, 0, 113, 0},
-			Mask: []byte{255, 255, 255, 0},
-		},
-		// RFC 3068
-		// 192.88.99.0/24
-		{
-			IP:   []byte{192, 88, 99, 0},
-			Mask: []byte{255, 255, 255, 0},
-		},
-		// RFC 2544, Errata 423
-		// 198.18.0.0/15
-		{
-			IP:   []byte{198, 18, 0, 0},
-			Mask: []byte{255, 254, 0, 0},
-		},
-		// RFC 3171
-		// 224.0.0.0/4
-		{
-			IP:   []byte{224, 0, 0, 0},
-			Mask: []byte{240, 0, 0, 0},
-		},
-		// RFC 1112
-		// 240.0.0.0/4
-		{
-			IP:   []byte{240, 0, 0, 0},
-			Mask: []byte{240, 0, 0, 0},
-		},
-		// RFC 919 Section 7
-		// 255.255.255.255/32
-		{
-			IP:   []byte{255, 255, 255, 255},
-			Mask: []byte{255, 255, 255, 255},
-		},
-		// RFC 6598
-		// 100.64.0.0/10
-		{
-			IP:   []byte{100, 64, 0, 0},
-			Mask: []byte{255, 192, 0, 0},
-		},
-	}
-	// Sourced from https://www.iana.org/assignments/iana-ipv6-special-registry/iana-ipv6-special-registry.xhtml
-	// where Global, Source, or Destination is False
-	privateV6Networks = []net.IPNet{
-		parseCidr("::/128", "RFC 4291: Unspecified Address"),
-		parseCidr("::1/128", "RFC 4291: Loopback Address"),
-		parseCidr("::ffff:0:0/96", "RFC 4291: IPv4-mapped Address"),
-		parseCidr("100::/64", "RFC 6666: Discard Address Block"),
-		parseCidr("2001::/23", "RFC 2928: IETF Protocol Assignments"),
-		parseCidr("2001:2::/48", "RFC 5180: Benchmarking"),
-		parseCidr("2001:db8::/32", "RFC 3849: Documentation"),
-		parseCidr("2001::/32", "RFC 4380: TEREDO"),
-		parseCidr("fc00::/7", "RFC 4193: Unique-Local"),
-		parseCidr("fe80::/10", "RFC 4291: Section 2.5.6 Link-Scoped Unicast"),
-		parseCidr("ff00::/8", "RFC 4291: Section 2.7"),
-		// We disable validations to IPs under the 6to4 anycase prefix because
-		// there's too much risk of a malicious actor advertising the prefix and
-		// answering validations for a 6to4 host they do not control.
-		// https://community.letsencrypt.org/t/problems-validating-ipv6-against-host-running-6to4/18312/9
-		parseCidr("2002::/16", "RFC 7526: 6to4 anycast prefix deprecated"),
-	}
-)
-
 // Client queries for DNS records
 type Client interface {
-	LookupTXT(context.Context, string) (txts []string, err error)
-	LookupHost(context.Context, string) ([]net.IP, error)
-	LookupCAA(context.Context, string) ([]*dns.CAA, string, error)
+	LookupTXT(context.Context, string) (txts []string, info LookupInfo, err error)
+	LookupHost(context.Context, string) ([]net.IP, LookupInfo, error)
+	LookupCAA(context.Context, string) ([]*dns.CAA, string, LookupInfo, error)
 }
 
 // impl represents a client that talks to an external resolver
@@ -160,21 +36,32 @@ type impl struct {
 	maxTries                 int
 	clk                      clock.Clock
 	log                      blog.Logger
+	// dnssec is nil unless in-process DNSSEC validation has been enabled with
+	// EnableDNSSEC.
+	dnssec *dnssecValidator
+	// health is nil unless DNS server health tracking has been enabled with
+	// EnableServerHealth.
+	health *serverHealth
 
 	queryTime         *prometheus.HistogramVec
 	totalLookupTime   *prometheus.HistogramVec
 	timeoutCounter    *prometheus.CounterVec
 	idMismatchCounter *prometheus.CounterVec
+	dnssecCounter     *prometheus.CounterVec
+	serverLatency     *prometheus.GaugeVec
+	serverEjected     *prometheus.GaugeVec
+	serverEjections   *prometheus.CounterVec
 }
 
 var _ Client = &impl{}
 
 type exchanger interface {
-	Exchange(m *dns.Msg, a string) (*dns.Msg, time.Duration, error)
+	ExchangeContext(ctx context.Context, m *dns.Msg, a string) (*dns.Msg, time.Duration, error)
 }
 
 // New constructs a new DNS resolver object that utilizes the
-// provided list of DNS servers for resolution.
+// provided list of DNS servers for resolution. The tlsConfig is used to verify
+// DNS-over-TLS and DNS-over-HTTPS servers; if nil, the system roots are used.
 func New(
 	readTimeout time.Duration,
 	servers ServerProvider,
@@ -182,12 +69,9 @@ func New(
 	clk clock.Clock,
 	maxTries int,
 	log blog.Logger,
+	tlsConfig *tls.Config,
 ) Client {
-	dnsClient := new(dns.Client)
-
-	// Set timeout for underlying net.Conn
-	dnsClient.ReadTimeout = readTimeout
-	dnsClient.Net = "udp"
+	dnsClient := newTransportExchanger(readTimeout, tlsConfig, stats)
 
 	queryTime := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -195,7 +79,7 @@ func New(
 			Help:    "Time taken to perform a DNS query",
 			Buckets: metrics.InternetFacingBuckets,
 		},
-		[]string{"qtype", "result", "resolver"},
+		[]string{"qtype", "result", "resolver", "transport"},
 	)
 	totalLookupTime := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -219,7 +103,35 @@ func New(
 		},
 		[]string{"qtype", "resolver"},
 	)
-	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter)
+	dnssecCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_dnssec_validations",
+			Help: "Counter of in-process DNSSEC validations sliced by query type and result",
+		},
+		[]string{"qtype", "result"},
+	)
+	serverLatency := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dns_server_latency_seconds",
+			Help: "Moving average of successful DNS query latency, by resolver, if server health tracking is enabled",
+		},
+		[]string{"resolver"},
+	)
+	serverEjected := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dns_server_ejected",
+			Help: "Whether a resolver is currently ejected for failing too many queries, if server health tracking is enabled",
+		},
+		[]string{"resolver"},
+	)
+	serverEjections := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_server_ejections",
+			Help: "Counter of resolver ejections for failing too many queries",
+		},
+		[]string{"resolver"},
+	)
+	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter, dnssecCounter, serverLatency, serverEjected, serverEjections)
 
 	return &impl{
 		dnsClient:                dnsClient,
@@ -231,6 +143,10 @@ func New(
 		totalLookupTime:          totalLookupTime,
 		timeoutCounter:           timeoutCounter,
 		idMismatchCounter:        idMismatchCounter,
+		dnssecCounter:            dnssecCounter,
+		serverLatency:            serverLatency,
+		serverEjected:            serverEjected,
+		serverEjections:          serverEjections,
 		log:                      log,
 	}
 }
@@ -244,16 +160,142 @@ func NewTest(
 	stats prometheus.Registerer,
 	clk clock.Clock,
 	maxTries int,
-	log blog.Logger) Client {
-	resolver := New(readTimeout, servers, stats, clk, maxTries, log)
+	log blog.Logger,
+	tlsConfig *tls.Config) Client {
+	resolver := New(readTimeout, servers, stats, clk, maxTries, log, tlsConfig)
 	resolver.(*impl).allowRestrictedAddresses = true
 	return resolver
 }
 
+// EnableDNSSEC turns on in-process DNSSEC validation for a Client constructed
+// by New or NewTest, rather than relying on the upstream resolver to validate
+// responses. Validation builds a chain of trust from the given trust anchors,
+// DS records for the root zone in presentation format. If none are given,
+// RootTrustAnchor is used.
+func EnableDNSSEC(client Client, trustAnchors []string) error {
+	dnsClient, ok := client.(*impl)
+	if !ok {
+		return fmt.Errorf("DNSSEC validation is not supported by %T", client)
+	}
+	if len(trustAnchors) == 0 {
+		trustAnchors = []string{RootTrustAnchor}
+	}
+	anchors, err := ParseTrustAnchors(trustAnchors)
+	if err != nil {
+		return err
+	}
+	dnsClient.dnssec = &dnssecValidator{
+		anchors:  anchors,
+		clk:      dnsClient.clk,
+		exchange: dnsClient.exchangeOne,
+	}
+	return nil
+}
+
+// exchange performs a DNS exchange using exchangeOne and, if in-process DNSSEC
+// validation is enabled, validates the response. A response that fails
+// validation results in a BogusError.
+func (dnsClient *impl) exchange(ctx context.Context, hostname string, qtype uint16) (*dns.Msg, LookupInfo, error) {
+	resp, err := dnsClient.exchangeOne(ctx, hostname, qtype)
+	if err != nil {
+		return resp, LookupInfo{}, err
+	}
+	// Other errors are reported by wrapErr regardless of DNSSEC.
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return resp, LookupInfo{Responses: []*dns.Msg{resp}}, nil
+	}
+	if dnsClient.dnssec == nil {
+		return resp, LookupInfo{TTL: responseTTL(resp), Responses: []*dns.Msg{resp}, Chain: aliasChain(hostname, resp)}, nil
+	}
+
+	status, err := dnsClient.dnssec.validate(ctx, hostname, qtype, resp)
+	result := string(status)
+	if err != nil {
+		result = "bogus"
+		dnsClient.log.Infof("DNSSEC validation failed: %s", err)
+	}
+	dnsClient.dnssecCounter.With(prometheus.Labels{
+		"qtype":  dns.TypeToString[qtype],
+		"result": result,
+	}).Inc()
+	if err != nil {
+		return nil, LookupInfo{Responses: []*dns.Msg{resp}}, err
+	}
+	return resp, LookupInfo{DNSSEC: status, TTL: responseTTL(resp), Responses: []*dns.Msg{resp}, Chain: aliasChain(hostname, resp)}, nil
+}
+
+// aliasChain returns the names which the CNAME and DNAME records in the answer
+// section of resp redirected a query for hostname to, in the order they were
+// followed. Resolvers usually, but not necessarily, order the answer section
+// along the chain, so each step searches the whole section. A DNAME is
+// accompanied by a CNAME synthesized from it (RFC 6672 Section 3.4), which is
+// skipped since the DNAME has already been followed.
+func aliasChain(hostname string, resp *dns.Msg) []string {
+	var chain []string
+	current := dns.Fqdn(strings.ToLower(hostname))
+	// Each record can be followed at most once, which also stops a looping
+	// chain.
+	for i := 0; i < len(resp.Answer); i++ {
+		next := ""
+		for _, rr := range resp.Answer {
+			owner := strings.ToLower(rr.Header().Name)
+			switch rr := rr.(type) {
+			case *dns.DNAME:
+				if current != owner && dns.IsSubDomain(owner, current) {
+					next = strings.TrimSuffix(current, owner) + strings.ToLower(rr.Target)
+				}
+			case *dns.CNAME:
+				if current == owner {
+					next = strings.ToLower(rr.Target)
+				}
+			}
+			if next != "" {
+				break
+			}
+		}
+		if next == "" {
+			break
+		}
+		current = dns.Fqdn(next)
+		chain = append(chain, strings.TrimSuffix(current, "."))
+	}
+	return chain
+}
+
+// responseTTL returns the lowest TTL of the records in the answer section of
+// resp or, if there are none, the negative caching TTL from the SOA record in
+// the authority section, which is the lower of the record's TTL and its
+// minimum TTL field (RFC 2308 Section 5).
+func responseTTL(resp *dns.Msg) time.Duration {
+	var ttl uint32
+	found := false
+	for _, rr := range resp.Answer {
+		if !found || rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+			found = true
+		}
+	}
+	if found {
+		return time.Duration(ttl) * time.Second
+	}
+	for _, rr := range resp.Ns {
+		soa, ok := rr.(*dns.SOA)
+		if !ok {
+			continue
+		}
+		ttl = soa.Hdr.Ttl
+		if soa.Minttl < ttl {
+			ttl = soa.Minttl
+		}
+		return time.Duration(ttl) * time.Second
+	}
+	return 0
+}
+
 // exchangeOne performs a single DNS exchange with a randomly chosen server
 // out of the server list, returning the response, time, and error (if any).
-// We assume that the upstream resolver requests and validates DNSSEC records
-// itself.
+// Unless in-process DNSSEC validation is enabled, we assume that the upstream
+// resolver requests and validates DNSSEC records itself.
 func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype uint16) (resp *dns.Msg, err error) {
 	m := new(dns.Msg)
 	// Set question type
@@ -268,19 +310,31 @@ func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype u
 	// Tell the resolver that we're willing to receive responses up to 4096 bytes.
 	// This happens sometimes when there are a very large number of CAA records
 	// present.
-	m.SetEdns0(4096, false)
+	//
+	// If we're validating DNSSEC ourselves, ask for signatures with the DO bit
+	// and tell the resolver not to filter out responses it considers bogus, so
+	// that the result of validation is ours alone.
+	if dnsClient.dnssec != nil {
+		m.SetEdns0(4096, true)
+		m.CheckingDisabled = true
+	} else {
+		m.SetEdns0(4096, false)
+	}
 
 	servers, err := dnsClient.servers.Addrs()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list DNS servers: %w", err)
 	}
+	if dnsClient.health != nil {
+		servers = dnsClient.health.order(servers)
+	}
 	chosenServerIndex := 0
 	chosenServer := servers[chosenServerIndex]
 
 	// Strip off the IP address part of the server address because
 	// we talk to the same server on multiple ports, and don't want
 	// to blow up the cardinality.
-	chosenServerIP, _, err := net.SplitHostPort(chosenServer)
+	chosenServerIP, transport, err := serverHost(chosenServer)
 	if err != nil {
 		return
 	}
@@ -309,13 +363,16 @@ func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype u
 		// to blow up the cardinality.
 		// Note: validateServerAddress() has already checked net.SplitHostPort()
 		// and ensures that chosenServer can't be a bare port, e.g. ":1337"
-		chosenServerIP, _, err = net.SplitHostPort(chosenServer)
+		chosenServerIP, transport, err = serverHost(chosenServer)
 		if err != nil {
 			return
 		}
 
 		go func() {
-			rsp, rtt, err := client.Exchange(m, chosenServer)
+			rsp, rtt, err := client.ExchangeContext(ctx, m, chosenServer)
+			if dnsClient.health != nil {
+				dnsClient.health.record(chosenServerIP, rtt, rsp, err)
+			}
 			result := "failed"
 			if rsp != nil {
 				result = dns.RcodeToString[rsp.Rcode]
@@ -330,9 +387,10 @@ func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype u
 				}
 			}
 			dnsClient.queryTime.With(prometheus.Labels{
-				"qtype":    qtypeStr,
-				"result":   result,
-				"resolver": chosenServerIP,
+				"qtype":     qtypeStr,
+				"result":    result,
+				"resolver":  chosenServerIP,
+				"transport": transport,
 			}).Observe(rtt.Seconds())
 			ch <- dnsResp{m: rsp, err: err}
 		}()
@@ -392,6 +450,20 @@ func (dnsClient *impl) exchangeOne(ctx context.Context, hostname string, qtype u
 
 }
 
+// serverHost returns the host part of a DNS server address, for use in
+// metrics, along with the address's transport.
+func serverHost(server string) (string, string, error) {
+	addr, err := parseServerAddr(server)
+	if err != nil {
+		return "", "", err
+	}
+	host, _, err := net.SplitHostPort(addr.hostPort)
+	if err != nil {
+		return "", "", err
+	}
+	return host, addr.transport, nil
+}
+
 // isTLD returns a simplified view of whether something is a TLD: does it have
 // any dots in it? This returns true or false as a string, and is meant solely
 // for Prometheus metrics.
@@ -411,13 +483,13 @@ type dnsResp struct {
 // LookupTXT sends a DNS query to find all TXT records associated with
 // the provided hostname which it returns along with the returned
 // DNS authority section.
-func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string, error) {
+func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string, LookupInfo, error) {
 	var txt []string
 	dnsType := dns.TypeTXT
-	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
+	r, info, err := dnsClient.exchange(ctx, hostname, dnsType)
 	errWrap := wrapErr(dnsType, hostname, r, err)
 	if errWrap != nil {
-		return nil, errWrap
+		return nil, LookupInfo{Responses: info.Responses}, errWrap
 	}
 
 	for _, answer := range r.Answer {
@@ -428,64 +500,57 @@ func (dnsClient *impl) LookupTXT(ctx context.Context, hostname string) ([]string
 		}
 	}
 
-	return txt, err
+	return txt, info, err
 }
 
-func isPrivateV4(ip net.IP) bool {
-	for _, net := range privateNetworks {
-		if net.Contains(ip) {
-			return true
-		}
-	}
-	return false
-}
-
-func isPrivateV6(ip net.IP) bool {
-	for _, net := range privateV6Networks {
-		if net.Contains(ip) {
-			return true
-		}
-	}
-	return false
-}
-
-func (dnsClient *impl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, error) {
-	resp, err := dnsClient.exchangeOne(ctx, hostname, ipType)
+func (dnsClient *impl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, LookupInfo, error) {
+	resp, info, err := dnsClient.exchange(ctx, hostname, ipType)
 	errWrap := wrapErr(ipType, hostname, resp, err)
 	if errWrap != nil {
-		return nil, errWrap
+		return nil, LookupInfo{Responses: info.Responses}, errWrap
 	}
-	return resp.Answer, nil
+	return resp.Answer, info, nil
 }
 
 // LookupHost sends a DNS query to find all A and AAAA records associated with
 // the provided hostname. This method assumes that the external resolver will
 // chase CNAME/DNAME aliases and return relevant records. It will retry
 // requests in the case of temporary network errors. It returns an error if
-// both the A and AAAA lookups fail or are empty, but succeeds otherwise.
-func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.IP, error) {
+// both the A and AAAA lookups fail or are empty, but succeeds otherwise. If
+// either lookup returns a bogus DNSSEC response, it returns that error.
+func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.IP, LookupInfo, error) {
 	var recordsA, recordsAAAA []dns.RR
+	var infoA, infoAAAA LookupInfo
 	var errA, errAAAA error
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
+		recordsA, infoA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
 	}()
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
+		recordsAAAA, infoAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
 	}()
 	wg.Wait()
 
+	// Both responses are evidence of what the lookup saw, even if one or both
+	// of them is the reason it failed.
+	responses := append(infoA.Responses, infoAAAA.Responses...)
+
+	var bogus BogusError
+	if errors.As(errA, &bogus) || errors.As(errAAAA, &bogus) {
+		return nil, LookupInfo{Responses: responses}, bogus
+	}
+
 	var addrsA []net.IP
 	if errA == nil {
 		for _, answer := range recordsA {
 			if answer.Header().Rrtype == dns.TypeA {
 				a, ok := answer.(*dns.A)
-				if ok && a.A.To4() != nil && (!isPrivateV4(a.A) || dnsClient.allowRestrictedAddresses) {
+				if ok && a.A.To4() != nil && (!iana.IsReservedIPv4(a.A) || dnsClient.allowRestrictedAddresses) {
 					addrsA = append(addrsA, a.A)
 				}
 			}
@@ -500,7 +565,7 @@ func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.I
 		for _, answer := range recordsAAAA {
 			if answer.Header().Rrtype == dns.TypeAAAA {
 				aaaa, ok := answer.(*dns.AAAA)
-				if ok && aaaa.AAAA.To16() != nil && (!isPrivateV6(aaaa.AAAA) || dnsClient.allowRestrictedAddresses) {
+				if ok && aaaa.AAAA.To16() != nil && (!iana.IsReservedIPv6(aaaa.AAAA) || dnsClient.allowRestrictedAddresses) {
 					addrsAAAA = append(addrsAAAA, aaaa.AAAA)
 				}
 			}
@@ -516,19 +581,41 @@ func (dnsClient *impl) LookupHost(ctx context.Context, hostname string) ([]net.I
 		// branching. We don't use ProblemDetails and SubProblemDetails here, because
 		// this error will get wrapped in a DNSError and further munged by higher
 		// layers in the stack.
-		return nil, fmt.Errorf("%w; %s", errA, errAAAA)
+		return nil, LookupInfo{Responses: responses}, fmt.Errorf("%w; %s", errA, errAAAA)
 	}
 
-	return append(addrsA, addrsAAAA...), nil
+	var info LookupInfo
+	switch {
+	case errA != nil:
+		info = infoAAAA
+		info.Responses = responses
+	case errAAAA != nil:
+		info = infoA
+		info.Responses = responses
+	default:
+		info.DNSSEC = infoA.DNSSEC.combine(infoAAAA.DNSSEC)
+		info.TTL = infoA.TTL
+		if infoAAAA.TTL < info.TTL {
+			info.TTL = infoAAAA.TTL
+		}
+		info.Responses = responses
+		// The A and AAAA queries follow the same aliases, so their chains
+		// only differ if the zone changed between them.
+		info.Chain = infoA.Chain
+		if len(infoAAAA.Chain) > len(info.Chain) {
+			info.Chain = infoAAAA.Chain
+		}
+	}
+	return append(addrsA, addrsAAAA...), info, nil
 }
 
 // LookupCAA sends a DNS query to find all CAA records associated with
 // the provided hostname and the complete dig-style RR `response`. This
 // response is quite verbose, however it's only populated when the CAA
 // response is non-empty.
-func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, string, error) {
+func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, string, LookupInfo, error) {
 	dnsType := dns.TypeCAA
-	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
+	r, info, err := dnsClient.exchange(ctx, hostname, dnsType)
 
 	// Special case: for CAA, treat NXDOMAIN as a successful response
 	// containing an empty set of records. This can come up in
@@ -536,12 +623,12 @@ func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.C
 	// TXT records for DNS-01 challenge) and then removed after
 	// validation but before CAA rechecking.
 	if err == nil && r.Rcode == dns.RcodeNameError {
-		return nil, "", nil
+		return nil, "", info, nil
 	}
 
 	errWrap := wrapErr(dnsType, hostname, r, err)
 	if errWrap != nil {
-		return nil, "", errWrap
+		return nil, "", LookupInfo{Responses: info.Responses}, errWrap
 	}
 
 	var CAAs []*dns.CAA
@@ -554,7 +641,7 @@ func (dnsClient *impl) LookupCAA(ctx context.Context, hostname string) ([]*dns.C
 	if len(CAAs) > 0 {
 		response = r.String()
 	}
-	return CAAs, response, nil
+	return CAAs, response, info, nil
 }
 
 // logDNSError logs the provided err result from making a query for hostname to
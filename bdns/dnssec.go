@@ -0,0 +1,552 @@
+package bdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+)
+
+// RootTrustAnchor is the DS record for the root zone's KSK-2017, as published
+// by IANA at https://data.iana.org/root-anchors/root-anchors.xml. It is the
+// default trust anchor used when DNSSEC validation is enabled without any
+// explicitly configured trust anchors.
+const RootTrustAnchor = ". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+
+// maxDNSSECQueries bounds the number of DS and DNSKEY queries made while
+// validating a single response, so that a maliciously deep or looping chain of
+// trust can't keep the VA busy indefinitely.
+const maxDNSSECQueries = 64
+
+// maxZoneTrustTTL caps how long the outcome of building the chain of trust to
+// a zone is cached for, regardless of the TTLs of its DS and DNSKEY records.
+const maxZoneTrustTTL = time.Hour
+
+// maxCachedZones bounds the number of zones whose chain of trust is cached.
+const maxCachedZones = 10000
+
+// maxNSEC3Iterations is the largest number of additional NSEC3 hash iterations
+// that will be computed. NSEC3 records using more are ignored, as RFC 9276
+// Section 3.2 permits, so they can't be used to make the VA do expensive work.
+const maxNSEC3Iterations = 150
+
+// DNSSECStatus describes the outcome of validating a DNS response.
+type DNSSECStatus string
+
+const (
+	// DNSSECUnchecked means the response was not validated, because in-process
+	// DNSSEC validation is not enabled.
+	DNSSECUnchecked = DNSSECStatus("")
+	// DNSSECSecure means every record in the response was validated back to a
+	// trust anchor.
+	DNSSECSecure = DNSSECStatus("secure")
+	// DNSSECInsecure means at least some of the response was proven to come
+	// from a zone with no chain of trust, i.e. an unsigned zone.
+	DNSSECInsecure = DNSSECStatus("insecure")
+)
+
+// combine returns the weaker of two statuses. Unchecked is the weakest.
+func (s DNSSECStatus) combine(other DNSSECStatus) DNSSECStatus {
+	if s == DNSSECUnchecked || other == DNSSECUnchecked {
+		return DNSSECUnchecked
+	}
+	if s == DNSSECInsecure || other == DNSSECInsecure {
+		return DNSSECInsecure
+	}
+	return DNSSECSecure
+}
+
+// LookupInfo describes how the answer to a lookup was obtained.
+type LookupInfo struct {
+	// DNSSEC is the result of validating the answer, if in-process DNSSEC
+	// validation is enabled.
+	DNSSEC DNSSECStatus
+	// TTL is how long the answer may be cached for: the lowest TTL of the
+	// records in the answer or, for an empty answer, the negative caching TTL
+	// from the zone's SOA record (RFC 2308). It is zero if the answer must not
+	// be cached.
+	TTL time.Duration
+	// Responses are the DNS responses the answer was taken from, for
+	// recording as evidence of what the lookup saw. They must not be
+	// modified. Unlike the other fields, they are also set when a lookup
+	// fails, to any responses which were received.
+	Responses []*dns.Msg
+	// Chain is the sequence of names, in lowercase and without trailing dots,
+	// which CNAME and DNAME records in the answer redirected the query to, in
+	// the order they were followed. It is empty if the queried name wasn't an
+	// alias.
+	Chain []string
+}
+
+// BogusError is returned when in-process DNSSEC validation is enabled and a
+// response could not be validated, i.e. it is bogus. It is distinct from Error
+// so that callers can tell a bogus answer apart from a failure to get one.
+type BogusError struct {
+	recordType uint16
+	hostname   string
+	reason     string
+}
+
+func (e BogusError) Error() string {
+	return fmt.Sprintf("DNS problem: looking up %s for %s: DNSSEC: Bogus: %s",
+		dns.TypeToString[e.recordType], e.hostname, e.reason)
+}
+
+// errInsecureZone is returned internally when a chain of trust ends at a
+// provably unsigned delegation.
+var errInsecureZone = errors.New("zone is provably unsigned")
+
+// ParseTrustAnchors parses DS records in presentation format, e.g.
+// RootTrustAnchor. Only trust anchors for the root zone are supported.
+func ParseTrustAnchors(anchors []string) ([]*dns.DS, error) {
+	var parsed []*dns.DS
+	for _, anchor := range anchors {
+		rr, err := dns.NewRR(anchor)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trust anchor %q: %w", anchor, err)
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			return nil, fmt.Errorf("trust anchor %q is not a DS record", anchor)
+		}
+		if ds.Hdr.Name != "." {
+			return nil, fmt.Errorf("trust anchor %q is not for the root zone", anchor)
+		}
+		parsed = append(parsed, ds)
+	}
+	if len(parsed) == 0 {
+		return nil, errors.New("no trust anchors provided")
+	}
+	return parsed, nil
+}
+
+// dnssecValidator validates responses from the upstream resolver by building a
+// chain of trust from the configured root trust anchors, fetching DS and DNSKEY
+// records as needed.
+type dnssecValidator struct {
+	anchors []*dns.DS
+	clk     clock.Clock
+	// exchange performs a single query with the DO bit set.
+	exchange func(ctx context.Context, hostname string, qtype uint16) (*dns.Msg, error)
+
+	// zones caches the outcome of building the chain of trust to each zone,
+	// so that its DS and DNSKEY records aren't fetched and checked again for
+	// every lookup.
+	zonesMu sync.Mutex
+	zones   map[string]zoneTrust
+}
+
+// zoneTrust is the outcome of building the chain of trust to a zone: either
+// its validated DNSKEY RRset, or errInsecureZone or errNotZone.
+type zoneTrust struct {
+	keys    []*dns.DNSKEY
+	err     error
+	expires time.Time
+}
+
+func (v *dnssecValidator) cachedTrust(zone string) (zoneTrust, bool) {
+	v.zonesMu.Lock()
+	defer v.zonesMu.Unlock()
+	trust, ok := v.zones[zone]
+	if !ok || !v.clk.Now().Before(trust.expires) {
+		return zoneTrust{}, false
+	}
+	return trust, true
+}
+
+func (v *dnssecValidator) cacheTrust(zone string, trust zoneTrust) {
+	now := v.clk.Now()
+	if !now.Before(trust.expires) {
+		return
+	}
+	v.zonesMu.Lock()
+	defer v.zonesMu.Unlock()
+	if v.zones == nil {
+		v.zones = make(map[string]zoneTrust)
+	}
+	if len(v.zones) >= maxCachedZones {
+		for name, cached := range v.zones {
+			if !now.Before(cached.expires) {
+				delete(v.zones, name)
+			}
+		}
+		if len(v.zones) >= maxCachedZones {
+			v.zones = make(map[string]zoneTrust)
+		}
+	}
+	v.zones[zone] = trust
+}
+
+// validate checks the signatures on the answer section of resp, or on the
+// authority section for negative responses. Every RRset must either carry a
+// valid signature chaining back to a trust anchor, or be proven to come from an
+// unsigned zone. A negative response from a signed zone must also carry NSEC
+// or NSEC3 records proving that the name, or the type at that name, doesn't
+// exist.
+func (v *dnssecValidator) validate(ctx context.Context, hostname string, qtype uint16, resp *dns.Msg) (DNSSECStatus, error) {
+	qname := dns.CanonicalName(hostname)
+	section := resp.Answer
+	if resp.Rcode == dns.RcodeNameError || !hasType(resp.Answer, qtype) {
+		section = append(append([]dns.RR{}, resp.Answer...), resp.Ns...)
+	}
+	rrsets, sigs := splitRRsets(section)
+
+	c := &dnssecChain{dnssecValidator: v, ctx: ctx, trust: make(map[string]zoneTrust)}
+	if len(rrsets) == 0 {
+		// Nothing to validate, which can only be acceptable if the name is in
+		// an unsigned zone.
+		err := c.proveInsecure(qname)
+		if err != nil {
+			return "", BogusError{qtype, hostname, err.Error()}
+		}
+		return DNSSECInsecure, nil
+	}
+
+	status := DNSSECSecure
+	for key, rrset := range rrsets {
+		err := c.verifyRRset(rrset, sigs[key], "")
+		if errors.Is(err, errInsecureZone) {
+			status = DNSSECInsecure
+			continue
+		}
+		if err != nil {
+			return "", BogusError{qtype, hostname, err.Error()}
+		}
+	}
+
+	// Every record in a negative response from a signed zone is validly
+	// signed, but that means nothing unless the NSEC or NSEC3 records among
+	// them actually deny the name or type that was asked for. If the answer
+	// is an alias, it's the end of the chain that's denied.
+	if status == DNSSECSecure && (resp.Rcode == dns.RcodeNameError || !hasType(resp.Answer, qtype)) {
+		target := qname
+		chain := aliasChain(hostname, resp)
+		if len(chain) > 0 {
+			target = dns.Fqdn(chain[len(chain)-1])
+		}
+		err := proveDenial(target, qtype, resp.Rcode == dns.RcodeNameError, resp.Ns)
+		if err != nil {
+			return "", BogusError{qtype, hostname, err.Error()}
+		}
+	}
+	return status, nil
+}
+
+// dnssecChain holds the state of validating a single response.
+type dnssecChain struct {
+	*dnssecValidator
+	ctx context.Context
+	// trust maps each zone visited while validating this response to the
+	// outcome of building its chain of trust.
+	trust   map[string]zoneTrust
+	queries int
+}
+
+func (c *dnssecChain) query(name string, qtype uint16) (*dns.Msg, error) {
+	c.queries++
+	if c.queries > maxDNSSECQueries {
+		return nil, errors.New("too many queries needed to build chain of trust")
+	}
+	resp, err := c.exchange(c.ctx, name, qtype)
+	if err != nil {
+		return nil, fmt.Errorf("querying %s %s: %w", dns.TypeToString[qtype], name, err)
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("querying %s %s: got %s", dns.TypeToString[qtype], name, dns.RcodeToString[resp.Rcode])
+	}
+	return resp, nil
+}
+
+// verifyRRset checks that rrset carries at least one valid signature made by a
+// validated key. If the rrset has no signatures, it must be in a provably
+// unsigned zone. Signatures made by notSigner are ignored, which stops a zone
+// from vouching for its own DS records.
+func (c *dnssecChain) verifyRRset(rrset []dns.RR, sigs []*dns.RRSIG, notSigner string) error {
+	owner := dns.CanonicalName(rrset[0].Header().Name)
+	rrtype := dns.TypeToString[rrset[0].Header().Rrtype]
+	if len(sigs) == 0 {
+		// When checking the records that delegate to notSigner, the question
+		// is whether notSigner's parent is unsigned.
+		target := owner
+		if notSigner != "" {
+			target = parentDomain(notSigner)
+		}
+		err := c.proveInsecure(target)
+		if err != nil {
+			return fmt.Errorf("%s %s is unsigned: %w", owner, rrtype, err)
+		}
+		return errInsecureZone
+	}
+
+	lastErr := fmt.Errorf("no usable signature for %s %s", owner, rrtype)
+	for _, sig := range sigs {
+		signer := dns.CanonicalName(sig.SignerName)
+		if signer == notSigner || !dns.IsSubDomain(signer, owner) {
+			continue
+		}
+		if !sig.ValidityPeriod(c.clk.Now()) {
+			lastErr = fmt.Errorf("signature on %s %s is expired or not yet valid", owner, rrtype)
+			continue
+		}
+		keys, err := c.zoneKeys(signer)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			err = sig.Verify(key, rrset)
+			if err == nil {
+				return nil
+			}
+			lastErr = fmt.Errorf("signature on %s %s did not verify: %w", owner, rrtype, err)
+		}
+	}
+	return lastErr
+}
+
+// zoneKeys returns the validated DNSKEY RRset of zone, errInsecureZone if the
+// zone is provably unsigned, or errNotZone if there is provably no zone cut at
+// that name.
+func (c *dnssecChain) zoneKeys(zone string) ([]*dns.DNSKEY, error) {
+	trust, ok := c.trust[zone]
+	if !ok {
+		trust, ok = c.cachedTrust(zone)
+	}
+	if !ok {
+		var err error
+		trust, err = c.establishTrust(zone)
+		if err != nil {
+			return nil, err
+		}
+		c.cacheTrust(zone, trust)
+	}
+	c.trust[zone] = trust
+	return trust.keys, trust.err
+}
+
+// establishTrust builds the chain of trust to zone from its parent, by
+// validating its DS RRset, or the denial of one, and then its DNSKEY RRset.
+func (c *dnssecChain) establishTrust(zone string) (zoneTrust, error) {
+	var dsSet []*dns.DS
+	ttl := maxZoneTrustTTL
+	if zone == "." {
+		dsSet = c.anchors
+	} else {
+		resp, err := c.query(zone, dns.TypeDS)
+		if err != nil {
+			return zoneTrust{}, err
+		}
+		if respTTL := responseTTL(resp); respTTL < ttl {
+			ttl = respTTL
+		}
+		rrsets, sigs := splitRRsets(resp.Answer)
+		key := rrsetKey{zone, dns.TypeDS}
+		if len(rrsets[key]) == 0 {
+			err = c.deniesDS(zone, resp)
+		} else {
+			err = c.verifyRRset(rrsets[key], sigs[key], zone)
+		}
+		if errors.Is(err, errInsecureZone) || errors.Is(err, errNotZone) {
+			return zoneTrust{err: err, expires: c.clk.Now().Add(ttl)}, nil
+		}
+		if err != nil {
+			return zoneTrust{}, err
+		}
+		for _, rr := range rrsets[key] {
+			dsSet = append(dsSet, rr.(*dns.DS))
+		}
+	}
+	return c.verifyKeys(zone, dsSet, ttl)
+}
+
+// verifyKeys fetches the DNSKEY RRset of zone and checks that it is signed by
+// a key matching one of the given DS records. The result expires after ttl, or
+// sooner if the DNSKEY RRset's TTL is shorter or its signature expires first.
+func (c *dnssecChain) verifyKeys(zone string, dsSet []*dns.DS, ttl time.Duration) (zoneTrust, error) {
+	resp, err := c.query(zone, dns.TypeDNSKEY)
+	if err != nil {
+		return zoneTrust{}, err
+	}
+	if respTTL := responseTTL(resp); respTTL < ttl {
+		ttl = respTTL
+	}
+	rrsets, sigs := splitRRsets(resp.Answer)
+	key := rrsetKey{zone, dns.TypeDNSKEY}
+	var keys []*dns.DNSKEY
+	for _, rr := range rrsets[key] {
+		keys = append(keys, rr.(*dns.DNSKEY))
+	}
+
+	now := c.clk.Now()
+	for _, k := range keys {
+		if k.Flags&dns.ZONE == 0 || !matchesDS(k, dsSet) {
+			continue
+		}
+		for _, sig := range sigs[key] {
+			if sig.KeyTag != k.KeyTag() || !sig.ValidityPeriod(now) {
+				continue
+			}
+			if sig.Verify(k, rrsets[key]) == nil {
+				expires := now.Add(ttl)
+				sigExpires := time.Unix(int64(sig.Expiration), 0)
+				if sigExpires.Before(expires) {
+					expires = sigExpires
+				}
+				return zoneTrust{keys: keys, expires: expires}, nil
+			}
+		}
+	}
+	return zoneTrust{}, fmt.Errorf("no DNSKEY for %s matching its DS records signs its DNSKEY RRset", zone)
+}
+
+// proveInsecure walks down from the root to name, following the chain of
+// trust, and succeeds only if it reaches a provably unsigned delegation.
+func (c *dnssecChain) proveInsecure(name string) error {
+	_, err := c.zoneKeys(".")
+	if err != nil {
+		return err
+	}
+	labels := dns.SplitDomainName(name)
+	for i := len(labels) - 1; i >= 0; i-- {
+		cut := dns.Fqdn(strings.Join(labels[i:], "."))
+		_, err := c.zoneKeys(cut)
+		if errors.Is(err, errInsecureZone) {
+			return nil
+		}
+		if err != nil && !errors.Is(err, errNotZone) {
+			return err
+		}
+	}
+	return fmt.Errorf("no unsigned delegation above %s", name)
+}
+
+// errNotZone is returned by deniesDS when a name provably has no DS records
+// because it is not a delegation point.
+var errNotZone = errors.New("not a zone")
+
+// deniesDS checks for a validly signed NSEC or NSEC3 record in resp, a NODATA
+// response to a DS query for name. It returns errInsecureZone if the record
+// shows that name is an unsigned delegation, or if name's parent is itself
+// unsigned, and errNotZone if it shows that name is not a delegation at all.
+func (c *dnssecChain) deniesDS(name string, resp *dns.Msg) error {
+	rrsets, sigs := splitRRsets(resp.Ns)
+	for key, rrset := range rrsets {
+		for _, rr := range rrset {
+			var types []uint16
+			var optOut bool
+			switch rr := rr.(type) {
+			case *dns.NSEC:
+				if dns.CanonicalName(rr.Hdr.Name) != name {
+					continue
+				}
+				types = rr.TypeBitMap
+			case *dns.NSEC3:
+				if rr.Match(name) {
+					types = rr.TypeBitMap
+				} else if rr.Cover(name) && rr.Flags&1 == 1 {
+					// An opt-out NSEC3 record covering name means any
+					// delegation at name is unsigned.
+					optOut = true
+				} else {
+					continue
+				}
+			default:
+				continue
+			}
+			err := c.verifyRRset(rrset, sigs[key], name)
+			if err != nil {
+				return err
+			}
+			if optOut {
+				return errInsecureZone
+			}
+			if containsType(types, dns.TypeDS) {
+				return fmt.Errorf("denial of existence for %s DS lists DS", name)
+			}
+			if !containsType(types, dns.TypeNS) {
+				return errNotZone
+			}
+			return errInsecureZone
+		}
+	}
+	// Unsigned zones don't serve NSEC records, so a missing denial is fine if
+	// the parent is unsigned.
+	if c.proveInsecure(parentDomain(name)) == nil {
+		return errInsecureZone
+	}
+	return fmt.Errorf("no signed denial of existence for %s DS", name)
+}
+
+// parentDomain returns the name with its first label removed.
+func parentDomain(name string) string {
+	i, end := dns.NextLabel(name, 0)
+	if end {
+		return "."
+	}
+	return name[i:]
+}
+
+// matchesDS returns true if key hashes to one of the DS records.
+func matchesDS(key *dns.DNSKEY, dsSet []*dns.DS) bool {
+	for _, ds := range dsSet {
+		if ds.KeyTag != key.KeyTag() || ds.Algorithm != key.Algorithm {
+			continue
+		}
+		computed := key.ToDS(ds.DigestType)
+		if computed != nil && strings.EqualFold(computed.Digest, ds.Digest) {
+			return true
+		}
+	}
+	return false
+}
+
+type rrsetKey struct {
+	name   string
+	rrtype uint16
+}
+
+// splitRRsets groups records into RRsets by owner name and type, separating
+// out the RRSIGs covering each RRset.
+func splitRRsets(rrs []dns.RR) (map[rrsetKey][]dns.RR, map[rrsetKey][]*dns.RRSIG) {
+	rrsets := make(map[rrsetKey][]dns.RR)
+	sigs := make(map[rrsetKey][]*dns.RRSIG)
+	for _, rr := range rrs {
+		name := dns.CanonicalName(rr.Header().Name)
+		switch rr := rr.(type) {
+		case *dns.RRSIG:
+			key := rrsetKey{name, rr.TypeCovered}
+			sigs[key] = append(sigs[key], rr)
+		case *dns.OPT:
+			continue
+		default:
+			key := rrsetKey{name, rr.Header().Rrtype}
+			rrsets[key] = append(rrsets[key], rr)
+		}
+	}
+	return rrsets, sigs
+}
+
+func hasType(rrs []dns.RR, rrtype uint16) bool {
+	for _, rr := range rrs {
+		if rr.Header().Rrtype == rrtype {
+			return true
+		}
+	}
+	return false
+}
+
+func containsType(types []uint16, rrtype uint16) bool {
+	for _, t := range types {
+		if t == rrtype {
+			return true
+		}
+	}
+	return false
+}
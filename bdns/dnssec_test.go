@@ -0,0 +1,340 @@
+package bdns
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// signedZone is a zone served by signedZonesServer. If key is nil the zone is
+// unsigned.
+type signedZone struct {
+	name    string
+	key     *dns.DNSKEY
+	signer  crypto.Signer
+	records []dns.RR
+	// corrupt lists owner names whose signatures, other than those on NSEC
+	// records, are made over the wrong data.
+	corrupt map[string]bool
+	// unsigned lists owner names whose signatures, other than those on NSEC
+	// records, are stripped.
+	unsigned map[string]bool
+}
+
+func newSignedZone(t *testing.T, name string, signed bool) *signedZone {
+	t.Helper()
+	z := &signedZone{name: name, corrupt: map[string]bool{}, unsigned: map[string]bool{}}
+	suffix := strings.TrimPrefix(name, ".")
+	z.add(t, name+" 3600 IN SOA ns."+suffix+" hostmaster."+suffix+" 1 3600 600 86400 300")
+	if !signed {
+		return z
+	}
+	z.key = &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     dns.ZONE | dns.SEP,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := z.key.Generate(256)
+	test.AssertNotError(t, err, "generating zone key")
+	z.signer = priv.(crypto.Signer)
+	z.records = append(z.records, z.key)
+	return z
+}
+
+func (z *signedZone) add(t *testing.T, record string) {
+	t.Helper()
+	rr, err := dns.NewRR(record)
+	test.AssertNotError(t, err, "parsing test record")
+	z.records = append(z.records, rr)
+}
+
+// delegate adds a DS record for child, signed by z.
+func (z *signedZone) delegate(child *signedZone) {
+	z.records = append(z.records, child.key.ToDS(dns.SHA256))
+}
+
+// addNSEC3 adds a chain of NSEC3 records, without opt-out, to z for the given
+// names and the types at each of them.
+func (z *signedZone) addNSEC3(names map[string][]uint16) {
+	hashes := make(map[string][]uint16)
+	var sorted []string
+	for name, types := range names {
+		hash := dns.HashName(name, dns.SHA1, 0, "")
+		hashes[hash] = types
+		sorted = append(sorted, hash)
+	}
+	sort.Strings(sorted)
+	for i, hash := range sorted {
+		z.records = append(z.records, &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: strings.ToLower(hash) + "." + z.name, Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: 300},
+			Hash:       dns.SHA1,
+			HashLength: 20,
+			NextDomain: sorted[(i+1)%len(sorted)],
+			TypeBitMap: hashes[hash],
+		})
+	}
+}
+
+// rrset returns the records at name of the given type, along with their
+// signature, valid for an hour either side of now.
+func (z *signedZone) rrset(t *testing.T, name string, qtype uint16, now time.Time) []dns.RR {
+	t.Helper()
+	var rrset []dns.RR
+	for _, rr := range z.records {
+		if rr.Header().Name == name && rr.Header().Rrtype == qtype {
+			rrset = append(rrset, rr)
+		}
+	}
+	if len(rrset) == 0 || z.key == nil || (z.unsigned[name] && qtype != dns.TypeNSEC) {
+		return rrset
+	}
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: 3600},
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.name,
+		Algorithm:  z.key.Algorithm,
+		Inception:  uint32(now.Add(-time.Hour).Unix()),
+		Expiration: uint32(now.Add(time.Hour).Unix()),
+	}
+	signed := rrset
+	if z.corrupt[name] && qtype != dns.TypeNSEC {
+		signed = []dns.RR{&dns.A{Hdr: *rrset[0].Header(), A: net.ParseIP("192.0.2.1")}}
+	}
+	err := sig.Sign(z.signer, signed)
+	test.AssertNotError(t, err, "signing test RRset")
+	return append(rrset, sig)
+}
+
+// signedZonesServer starts a DNS server answering for the given zones, which
+// must be ordered from the root down, and returns its address. Signatures are
+// valid for an hour either side of signedAt.
+func signedZonesServer(t *testing.T, signedAt time.Time, zones ...*signedZone) string {
+	t.Helper()
+	handler := func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(r)
+		q := r.Question[0]
+		qname := strings.ToLower(q.Name)
+
+		// Find the deepest zone containing the name. DS records are served by
+		// the parent side of a delegation.
+		var zone *signedZone
+		for _, z := range zones {
+			if dns.IsSubDomain(z.name, qname) && !(q.Qtype == dns.TypeDS && z.name == qname) {
+				zone = z
+			}
+		}
+		m.Answer = zone.rrset(t, qname, q.Qtype, signedAt)
+		if len(m.Answer) == 0 {
+			// Rather than picking out the NSEC or NSEC3 records which deny
+			// the query, send all of them and leave it to the validator.
+			m.Ns = zone.rrset(t, zone.name, dns.TypeSOA, signedAt)
+			exists := false
+			owners := make(map[string]bool)
+			for _, rr := range zone.records {
+				owner := rr.Header().Name
+				exists = exists || dns.IsSubDomain(qname, owner)
+				rrtype := rr.Header().Rrtype
+				if (rrtype == dns.TypeNSEC || rrtype == dns.TypeNSEC3) && !owners[owner] {
+					owners[owner] = true
+					m.Ns = append(m.Ns, zone.rrset(t, owner, rrtype, signedAt)...)
+				}
+			}
+			if !exists {
+				m.Rcode = dns.RcodeNameError
+			}
+		}
+		_ = w.WriteMsg(m)
+	}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "listening for test DNS server")
+	server := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(handler)}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+	return pc.LocalAddr().String()
+}
+
+func setupDNSSEC(t *testing.T) (Client, *signedZone, clock.FakeClock) {
+	clk := clock.NewFake()
+	clk.Set(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	root := newSignedZone(t, ".", true)
+	com := newSignedZone(t, "com.", true)
+	example := newSignedZone(t, "example.com.", true)
+	unsigned := newSignedZone(t, "unsigned.com.", false)
+	nsec3 := newSignedZone(t, "nsec3.com.", true)
+	nodenial := newSignedZone(t, "nodenial.com.", true)
+
+	root.delegate(com)
+	com.delegate(example)
+	com.delegate(nsec3)
+	com.delegate(nodenial)
+	// Prove that unsigned.com is an unsigned delegation.
+	com.add(t, "unsigned.com. 300 IN NSEC vvv.com. NS RRSIG NSEC")
+
+	example.add(t, "www.example.com. 300 IN A 127.0.0.1")
+	example.add(t, "example.com. 300 IN TXT \"hello\"")
+	example.add(t, "example.com. 300 IN CAA 0 issue \"letsencrypt.org\"")
+	example.add(t, "bogus.example.com. 300 IN A 127.0.0.1")
+	example.corrupt["bogus.example.com."] = true
+	example.add(t, "stripped.example.com. 300 IN A 127.0.0.1")
+	example.unsigned["stripped.example.com."] = true
+	example.add(t, "example.com. 300 IN NSEC bogus.example.com. SOA TXT RRSIG NSEC DNSKEY CAA")
+	example.add(t, "bogus.example.com. 300 IN NSEC stripped.example.com. A RRSIG NSEC")
+	example.add(t, "stripped.example.com. 300 IN NSEC www.example.com. A RRSIG NSEC")
+	example.add(t, "www.example.com. 300 IN NSEC example.com. A RRSIG NSEC")
+	unsigned.add(t, "www.unsigned.com. 300 IN A 127.0.0.1")
+	nsec3.add(t, "www.nsec3.com. 300 IN A 127.0.0.1")
+	nsec3.addNSEC3(map[string][]uint16{
+		"nsec3.com.":     {dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY},
+		"www.nsec3.com.": {dns.TypeA, dns.TypeRRSIG},
+	})
+	nodenial.add(t, "www.nodenial.com. 300 IN A 127.0.0.1")
+
+	addr := signedZonesServer(t, clk.Now(), root, com, example, unsigned, nsec3, nodenial)
+	staticProvider, err := NewStaticProvider([]string{addr})
+	test.AssertNotError(t, err, "Got error creating StaticProvider")
+	client := NewTest(time.Second*10, staticProvider, metrics.NoopRegisterer, clk, 1, blog.UseMock(), nil)
+	return client, root, clk
+}
+
+func TestDNSSECValidation(t *testing.T) {
+	client, root, clk := setupDNSSEC(t)
+
+	// Without DNSSEC validation enabled, nothing is checked.
+	_, info, err := client.LookupHost(context.Background(), "bogus.example.com")
+	test.AssertNotError(t, err, "LookupHost failed without DNSSEC validation")
+	test.AssertEquals(t, info.DNSSEC, DNSSECUnchecked)
+
+	err = EnableDNSSEC(client, []string{root.key.ToDS(dns.SHA256).String()})
+	test.AssertNotError(t, err, "enabling DNSSEC validation")
+
+	ips, info, err := client.LookupHost(context.Background(), "www.example.com")
+	test.AssertNotError(t, err, "LookupHost failed for signed name")
+	test.AssertEquals(t, len(ips), 1)
+	test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+
+	txts, info, err := client.LookupTXT(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupTXT failed for signed name")
+	test.AssertDeepEquals(t, txts, []string{"hello"})
+	test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+
+	caas, _, info, err := client.LookupCAA(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupCAA failed for signed name")
+	test.AssertEquals(t, len(caas), 1)
+	test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+
+	// A signed negative response is secure too.
+	caas, _, info, err = client.LookupCAA(context.Background(), "www.example.com")
+	test.AssertNotError(t, err, "LookupCAA failed for signed name without CAA")
+	test.AssertEquals(t, len(caas), 0)
+	test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+
+	_, info, err = client.LookupHost(context.Background(), "www.unsigned.com")
+	test.AssertNotError(t, err, "LookupHost failed for name in unsigned zone")
+	test.AssertEquals(t, info.DNSSEC, DNSSECInsecure)
+
+	// As are signed denials of existence using NSEC and NSEC3.
+	for _, name := range []string{"nothere.example.com", "www.nsec3.com", "nothere.nsec3.com"} {
+		_, _, info, err = client.LookupCAA(context.Background(), name)
+		test.AssertNotError(t, err, "LookupCAA failed for name with signed denial")
+		test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+	}
+
+	for _, name := range []string{"bogus.example.com", "stripped.example.com"} {
+		_, _, err = client.LookupHost(context.Background(), name)
+		test.AssertError(t, err, "LookupHost didn't fail for bogus name")
+		var bogus BogusError
+		test.Assert(t, errors.As(err, &bogus), "expected a BogusError")
+		test.AssertContains(t, err.Error(), "DNSSEC: Bogus")
+	}
+
+	// A signed negative response without NSEC or NSEC3 records proves
+	// nothing.
+	_, _, _, err = client.LookupCAA(context.Background(), "www.nodenial.com")
+	test.AssertError(t, err, "LookupCAA didn't fail without a denial of existence")
+	test.AssertContains(t, err.Error(), "no NSEC or NSEC3 records deny")
+
+	// Once the signatures expire, everything signed is bogus.
+	clk.Add(2 * time.Hour)
+	_, _, err = client.LookupHost(context.Background(), "www.example.com")
+	test.AssertError(t, err, "LookupHost didn't fail with expired signatures")
+	test.AssertContains(t, err.Error(), "expired or not yet valid")
+}
+
+func TestDNSSECZoneTrustCache(t *testing.T) {
+	client, root, clk := setupDNSSEC(t)
+	err := EnableDNSSEC(client, []string{root.key.ToDS(dns.SHA256).String()})
+	test.AssertNotError(t, err, "enabling DNSSEC validation")
+	validator := client.(*impl).dnssec
+
+	_, _, err = client.LookupTXT(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupTXT failed for signed name")
+	trust, ok := validator.cachedTrust("example.com.")
+	test.Assert(t, ok, "example.com's keys weren't cached")
+	test.AssertEquals(t, len(trust.keys), 1)
+
+	// With the chain of trust cached, no more DS or DNSKEY queries are needed.
+	validator.exchange = func(context.Context, string, uint16) (*dns.Msg, error) {
+		return nil, errors.New("unexpected query")
+	}
+	_, info, err := client.LookupHost(context.Background(), "www.example.com")
+	test.AssertNotError(t, err, "LookupHost failed with a cached chain of trust")
+	test.AssertEquals(t, info.DNSSEC, DNSSECSecure)
+
+	// Nothing is cached for longer than the TTLs of the DS and DNSKEY records
+	// allow.
+	clk.Add(time.Hour)
+	_, ok = validator.cachedTrust("example.com.")
+	test.Assert(t, !ok, "example.com's keys were cached past their TTL")
+}
+
+func TestDNSSECWrongTrustAnchor(t *testing.T) {
+	client, _, _ := setupDNSSEC(t)
+
+	err := EnableDNSSEC(client, nil)
+	test.AssertNotError(t, err, "enabling DNSSEC validation with the default trust anchor")
+
+	_, _, err = client.LookupTXT(context.Background(), "example.com")
+	var bogus BogusError
+	test.Assert(t, errors.As(err, &bogus), "expected a BogusError")
+
+	// Even a name in an unsigned zone can't be validated without a chain of
+	// trust down to the unsigned delegation.
+	_, _, err = client.LookupHost(context.Background(), "www.unsigned.com")
+	test.Assert(t, errors.As(err, &bogus), "expected a BogusError")
+}
+
+func TestParseTrustAnchors(t *testing.T) {
+	anchors, err := ParseTrustAnchors([]string{RootTrustAnchor})
+	test.AssertNotError(t, err, "parsing the root trust anchor")
+	test.AssertEquals(t, len(anchors), 1)
+	test.AssertEquals(t, anchors[0].KeyTag, uint16(20326))
+
+	_, err = ParseTrustAnchors(nil)
+	test.AssertError(t, err, "parsed empty trust anchors")
+	_, err = ParseTrustAnchors([]string{"example.com. IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"})
+	test.AssertError(t, err, "parsed trust anchor for a zone other than the root")
+	_, err = ParseTrustAnchors([]string{". IN A 127.0.0.1"})
+	test.AssertError(t, err, "parsed trust anchor which isn't a DS record")
+	_, err = ParseTrustAnchors([]string{"not a record"})
+	test.AssertError(t, err, "parsed malformed trust anchor")
+}
@@ -0,0 +1,306 @@
+package bdns
+
+import (
+	"expvar"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// latencyWeight and failureWeight are the weights given to each new
+	// sample in a server's moving average latency and failure rate.
+	latencyWeight = 0.2
+	failureWeight = 0.1
+)
+
+// HealthConfig configures outlier detection for DNS servers. Zero values are
+// replaced by the defaults noted on each field.
+type HealthConfig struct {
+	// MaxFailureRate is the moving average rate of failed queries (timeouts,
+	// network errors and SERVFAIL responses) above which a server is ejected.
+	// Defaults to 0.5.
+	MaxFailureRate float64
+	// MinQueries is the number of queries a server must have answered since
+	// it was last ejected before it can be ejected again. Defaults to 10.
+	MinQueries int
+	// EjectionTime is how long a server is ejected for the first time. Each
+	// consecutive ejection lasts EjectionTime longer, up to MaxEjectionTime.
+	// Defaults to 30 seconds.
+	EjectionTime time.Duration
+	// MaxEjectionTime defaults to 5 minutes.
+	MaxEjectionTime time.Duration
+	// MaxEjectedPercent is the highest percentage of servers which may be
+	// ejected at once. Since SERVFAIL is often caused by a broken
+	// authoritative server rather than the resolver, this stops a burst of
+	// lookups for broken domains from ejecting every server. Defaults to 50.
+	MaxEjectedPercent int
+}
+
+func (c HealthConfig) withDefaults() HealthConfig {
+	if c.MaxFailureRate == 0 {
+		c.MaxFailureRate = 0.5
+	}
+	if c.MinQueries == 0 {
+		c.MinQueries = 10
+	}
+	if c.EjectionTime == 0 {
+		c.EjectionTime = 30 * time.Second
+	}
+	if c.MaxEjectionTime == 0 {
+		c.MaxEjectionTime = 5 * time.Minute
+	}
+	if c.MaxEjectedPercent == 0 {
+		c.MaxEjectedPercent = 50
+	}
+	return c
+}
+
+// ServerState is a snapshot of the health of a DNS server, as published by
+// PublishServerHealth.
+type ServerState struct {
+	Server       string    `json:"server"`
+	Latency      float64   `json:"latencySeconds"`
+	FailureRate  float64   `json:"failureRate"`
+	Queries      int64     `json:"queries"`
+	Servfails    int64     `json:"servfails"`
+	Errors       int64     `json:"errors"`
+	Ejections    int64     `json:"ejections"`
+	EjectedUntil time.Time `json:"ejectedUntil"`
+}
+
+type serverState struct {
+	ServerState
+	// sampled is whether the server has answered a query, and so has a
+	// meaningful latency.
+	sampled bool
+	// sinceEjection is the number of queries since the server was last
+	// ejected.
+	sinceEjection int
+	// consecutiveEjections is the number of times the server has been ejected
+	// without answering MinQueries queries in between.
+	consecutiveEjections int
+}
+
+// serverHealth tracks the latency and failure rate of each DNS server, keyed
+// by host so that servers reached on several ports share their state. It
+// orders servers for each query so that the fastest healthy server is
+// preferred, and servers which fail too often are tried last until their
+// ejection expires.
+type serverHealth struct {
+	sync.Mutex
+	conf   HealthConfig
+	clk    clock.Clock
+	states map[string]*serverState
+
+	latencyGauge  *prometheus.GaugeVec
+	ejectedGauge  *prometheus.GaugeVec
+	ejectionCount *prometheus.CounterVec
+}
+
+func newServerHealth(conf HealthConfig, clk clock.Clock, latencyGauge, ejectedGauge *prometheus.GaugeVec, ejectionCount *prometheus.CounterVec) *serverHealth {
+	return &serverHealth{
+		conf:          conf.withDefaults(),
+		clk:           clk,
+		states:        make(map[string]*serverState),
+		latencyGauge:  latencyGauge,
+		ejectedGauge:  ejectedGauge,
+		ejectionCount: ejectionCount,
+	}
+}
+
+// state returns the state for host, creating it if needed. The lock must be
+// held.
+func (sh *serverHealth) state(host string) *serverState {
+	s, ok := sh.states[host]
+	if !ok {
+		s = &serverState{ServerState: ServerState{Server: host}}
+		sh.states[host] = s
+	}
+	return s
+}
+
+// ejected returns whether s is currently ejected. The lock must be held.
+func (sh *serverHealth) ejected(s *serverState) bool {
+	return s.EjectedUntil.After(sh.clk.Now())
+}
+
+// record updates the state of host with the outcome of a query which took rtt.
+// A query which returned an error or a SERVFAIL response counts as a failure.
+func (sh *serverHealth) record(host string, rtt time.Duration, resp *dns.Msg, err error) {
+	sh.Lock()
+	defer sh.Unlock()
+	s := sh.state(host)
+	s.Queries++
+	failed := 0.0
+	switch {
+	case err != nil:
+		s.Errors++
+		failed = 1
+	case resp.Rcode == dns.RcodeServerFailure:
+		s.Servfails++
+		failed = 1
+	default:
+		if !s.sampled {
+			s.Latency = rtt.Seconds()
+			s.sampled = true
+		} else {
+			s.Latency += latencyWeight * (rtt.Seconds() - s.Latency)
+		}
+		sh.latencyGauge.WithLabelValues(host).Set(s.Latency)
+	}
+	// Queries sent to an ejected server, because every other server failed,
+	// don't count towards its readmission.
+	if sh.ejected(s) {
+		return
+	}
+	sh.ejectedGauge.WithLabelValues(host).Set(0)
+	s.sinceEjection++
+	s.FailureRate += failureWeight * (failed - s.FailureRate)
+	if s.sinceEjection < sh.conf.MinQueries {
+		return
+	}
+	if s.FailureRate <= sh.conf.MaxFailureRate {
+		s.consecutiveEjections = 0
+		return
+	}
+
+	ejected := 1
+	for _, other := range sh.states {
+		if other != s && sh.ejected(other) {
+			ejected++
+		}
+	}
+	if ejected*100 > sh.conf.MaxEjectedPercent*len(sh.states) {
+		return
+	}
+	s.consecutiveEjections++
+	ejectionTime := time.Duration(s.consecutiveEjections) * sh.conf.EjectionTime
+	if ejectionTime > sh.conf.MaxEjectionTime {
+		ejectionTime = sh.conf.MaxEjectionTime
+	}
+	s.EjectedUntil = sh.clk.Now().Add(ejectionTime)
+	s.Ejections++
+	// Start afresh once the ejection expires, so the server is judged on its
+	// behaviour from then on.
+	s.sinceEjection = 0
+	s.FailureRate = 0
+	sh.ejectedGauge.WithLabelValues(host).Set(1)
+	sh.ejectionCount.WithLabelValues(host).Inc()
+}
+
+// order returns servers in the order they should be tried: healthy servers
+// first, with the first chosen as the faster of two at random ("power of two
+// choices", so that slower servers still see enough queries to notice if they
+// speed up) and the rest from fastest to slowest, followed by ejected servers
+// from the soonest to be readmitted. Servers which haven't answered a query
+// yet count as the fastest, so that they're tried.
+func (sh *serverHealth) order(servers []string) []string {
+	type candidate struct {
+		addr  string
+		state ServerState
+		ok    bool
+	}
+	sh.Lock()
+	var healthy, ejected []candidate
+	for _, addr := range servers {
+		host, _, err := serverHost(addr)
+		if err != nil {
+			// Leave this for exchangeOne to report.
+			healthy = append(healthy, candidate{addr: addr})
+			continue
+		}
+		s := sh.state(host)
+		c := candidate{addr: addr, state: s.ServerState, ok: s.sampled}
+		if sh.ejected(s) {
+			ejected = append(ejected, c)
+		} else {
+			healthy = append(healthy, c)
+		}
+	}
+	sh.Unlock()
+
+	latency := func(c candidate) float64 {
+		if !c.ok {
+			return 0
+		}
+		return c.state.Latency
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return latency(healthy[i]) < latency(healthy[j])
+	})
+	sort.SliceStable(ejected, func(i, j int) bool {
+		return ejected[i].state.EjectedUntil.Before(ejected[j].state.EjectedUntil)
+	})
+	if len(healthy) > 1 {
+		// healthy is sorted, so the lower of two distinct indices is the
+		// faster choice.
+		i := rand.Intn(len(healthy))
+		j := rand.Intn(len(healthy) - 1)
+		if j >= i {
+			j++
+		}
+		if j < i {
+			i = j
+		}
+		first := healthy[i]
+		copy(healthy[1:i+1], healthy[:i])
+		healthy[0] = first
+	}
+
+	ordered := make([]string, 0, len(servers))
+	for _, c := range append(healthy, ejected...) {
+		ordered = append(ordered, c.addr)
+	}
+	return ordered
+}
+
+// snapshot returns the state of every server seen so far, sorted by server.
+func (sh *serverHealth) snapshot() []ServerState {
+	sh.Lock()
+	defer sh.Unlock()
+	states := make([]ServerState, 0, len(sh.states))
+	for _, s := range sh.states {
+		state := s.ServerState
+		if !sh.ejected(s) {
+			state.EjectedUntil = time.Time{}
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Server < states[j].Server
+	})
+	return states
+}
+
+// EnableServerHealth turns on outlier detection for a Client constructed by
+// New or NewTest, so that queries prefer the fastest DNS servers and avoid
+// those which are failing, rather than choosing among servers at random.
+func EnableServerHealth(client Client, conf HealthConfig) error {
+	dnsClient, ok := client.(*impl)
+	if !ok {
+		return fmt.Errorf("DNS server health tracking is not supported by %T", client)
+	}
+	dnsClient.health = newServerHealth(conf, dnsClient.clk, dnsClient.serverLatency, dnsClient.serverEjected, dnsClient.serverEjections)
+	return nil
+}
+
+// PublishServerHealth publishes the state of each DNS server used by a Client
+// with server health tracking enabled as the expvar with the given name,
+// which is served on the debug server's /debug/vars endpoint.
+func PublishServerHealth(client Client, name string) error {
+	dnsClient, ok := client.(*impl)
+	if !ok || dnsClient.health == nil {
+		return fmt.Errorf("DNS server health tracking is not enabled for %T", client)
+	}
+	expvar.Publish(name, expvar.Func(func() any {
+		return dnsClient.health.snapshot()
+	}))
+	return nil
+}
@@ -0,0 +1,138 @@
+package bdns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func setupServerHealth(t *testing.T, servers []string, conf HealthConfig) (*impl, clock.FakeClock) {
+	t.Helper()
+	staticProvider, err := NewStaticProvider(servers)
+	test.AssertNotError(t, err, "Got error creating StaticProvider")
+	clk := clock.NewFake()
+	client := NewTest(time.Second*10, staticProvider, metrics.NoopRegisterer, clk, len(servers), blog.UseMock(), nil)
+	err = EnableServerHealth(client, conf)
+	test.AssertNotError(t, err, "enabling server health tracking")
+	return client.(*impl), clk
+}
+
+func TestServerHealthEjection(t *testing.T) {
+	client, clk := setupServerHealth(t, []string{"a:53", "b:53", "c:53"}, HealthConfig{})
+	mock := &rotateFailureExchanger{
+		brokenAddresses: map[string]bool{"a:53": true, "b:53": true},
+		lookups:         make(map[string]int),
+	}
+	client.dnsClient = mock
+
+	for i := 0; i < 50; i++ {
+		_, _, err := client.LookupTXT(context.Background(), "example.com")
+		test.AssertNotError(t, err, "Expected no error from eventual retry with functional server")
+	}
+
+	// Only one of the broken servers can be ejected, since ejecting both
+	// would leave less than half of the servers in use.
+	ejected := map[string]bool{}
+	for _, s := range client.health.snapshot() {
+		if !s.EjectedUntil.IsZero() {
+			ejected[s.Server] = true
+		}
+	}
+	test.AssertEquals(t, len(ejected), 1)
+	test.Assert(t, !ejected["c"], "the working server was ejected")
+	test.AssertMetricWithLabelsEquals(t, client.serverEjections, prometheus.Labels{}, 1)
+
+	// The ejected server is only tried after the others.
+	for host := range ejected {
+		order := client.health.order([]string{host + ":53", "c:53"})
+		test.AssertDeepEquals(t, order, []string{"c:53", host + ":53"})
+	}
+
+	// Once the ejection expires, the server is back in rotation.
+	clk.Add(31 * time.Second)
+	for _, s := range client.health.snapshot() {
+		test.Assert(t, s.EjectedUntil.IsZero(), "server still ejected after ejection time")
+	}
+}
+
+func TestServerHealthLatency(t *testing.T) {
+	client, _ := setupServerHealth(t, []string{"a:53", "b:53"}, HealthConfig{})
+	sh := client.health
+	ok := &dns.Msg{}
+
+	// Servers which haven't been queried are tried first.
+	sh.record("a", 100*time.Millisecond, ok, nil)
+	test.AssertDeepEquals(t, sh.order([]string{"a:53", "b:53"}), []string{"b:53", "a:53"})
+
+	// And then the faster server is preferred.
+	sh.record("b", 300*time.Millisecond, ok, nil)
+	for i := 0; i < 10; i++ {
+		test.AssertDeepEquals(t, sh.order([]string{"b:53", "a:53"}), []string{"a:53", "b:53"})
+	}
+
+	// Failures don't affect latency, but SERVFAILs count towards ejection.
+	for i := 0; i < 20; i++ {
+		sh.record("a", 0, &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeServerFailure}}, nil)
+	}
+	states := sh.snapshot()
+	test.AssertEquals(t, states[0].Server, "a")
+	test.AssertEquals(t, states[0].Latency, 0.1)
+	test.AssertEquals(t, states[0].Servfails, int64(20))
+	test.AssertEquals(t, states[0].Ejections, int64(1))
+	test.AssertDeepEquals(t, sh.order([]string{"a:53", "b:53"}), []string{"b:53", "a:53"})
+	test.AssertMetricWithLabelsEquals(t, client.serverLatency, prometheus.Labels{"resolver": "a"}, 0.1)
+	test.AssertMetricWithLabelsEquals(t, client.serverEjected, prometheus.Labels{"resolver": "a"}, 1)
+}
+
+func TestServerHealthRepeatedEjection(t *testing.T) {
+	client, clk := setupServerHealth(t, []string{"a:53", "b:53"}, HealthConfig{EjectionTime: time.Minute, MaxEjectionTime: 90 * time.Second})
+	sh := client.health
+	fail := func() {
+		for i := 0; i < 10; i++ {
+			sh.record("a", 0, nil, errors.New("timeout"))
+		}
+	}
+	sh.record("b", 0, &dns.Msg{}, nil)
+
+	fail()
+	test.AssertEquals(t, sh.states["a"].EjectedUntil, clk.Now().Add(time.Minute))
+
+	// Queries sent while ejected don't count towards readmission.
+	fail()
+	test.AssertEquals(t, sh.states["a"].Ejections, int64(1))
+
+	// A server which fails again as soon as it's readmitted is ejected for
+	// longer, up to the maximum.
+	clk.Add(time.Minute)
+	fail()
+	test.AssertEquals(t, sh.states["a"].EjectedUntil, clk.Now().Add(90*time.Second))
+}
+
+func TestPublishServerHealth(t *testing.T) {
+	client, _ := setupServerHealth(t, []string{"a:53"}, HealthConfig{})
+	client.health.record("a", time.Second, &dns.Msg{}, nil)
+
+	err := PublishServerHealth(client, "testDNSServers")
+	test.AssertNotError(t, err, "publishing server health")
+	var states []ServerState
+	err = json.Unmarshal([]byte(expvar.Get("testDNSServers").String()), &states)
+	test.AssertNotError(t, err, "unmarshalling published server health")
+	test.AssertEquals(t, len(states), 1)
+	test.AssertEquals(t, states[0].Server, "a")
+	test.AssertEquals(t, states[0].Queries, int64(1))
+
+	unhealthy := NewTest(time.Second, nil, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock(), nil)
+	err = PublishServerHealth(unhealthy, "testDNSServersDisabled")
+	test.AssertError(t, err, "published server health without tracking enabled")
+}
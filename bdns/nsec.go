@@ -0,0 +1,263 @@
+package bdns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// proveDenial checks that the NSEC or NSEC3 records among rrs, which must
+// already have been validated, prove that name doesn't exist (if nxdomain is
+// true) or that it has no records of type qtype. The checks are those of RFC
+// 4035 Section 5.4 for NSEC and RFC 5155 Section 8 for NSEC3, including the
+// proofs that no wildcard could have matched name.
+func proveDenial(name string, qtype uint16, nxdomain bool, rrs []dns.RR) error {
+	name = dns.CanonicalName(name)
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, rr := range rrs {
+		switch rr := rr.(type) {
+		case *dns.NSEC:
+			nsecs = append(nsecs, rr)
+		case *dns.NSEC3:
+			if rr.Hash == dns.SHA1 && rr.Iterations <= maxNSEC3Iterations {
+				nsec3s = append(nsec3s, rr)
+			}
+		}
+	}
+
+	var proven bool
+	switch {
+	case len(nsecs) > 0 && nxdomain:
+		proven = nsecProvesNXDomain(nsecs, name)
+	case len(nsecs) > 0:
+		proven = nsecProvesNoData(nsecs, name, qtype)
+	case len(nsec3s) > 0 && nxdomain:
+		proven = nsec3ProvesNXDomain(nsec3s, name)
+	case len(nsec3s) > 0:
+		proven = nsec3ProvesNoData(nsec3s, name, qtype)
+	default:
+		return fmt.Errorf("no NSEC or NSEC3 records deny %s %s", name, dns.TypeToString[qtype])
+	}
+	if !proven {
+		if nxdomain {
+			return fmt.Errorf("NSEC or NSEC3 records don't prove that %s doesn't exist", name)
+		}
+		return fmt.Errorf("NSEC or NSEC3 records don't prove that %s has no %s records", name, dns.TypeToString[qtype])
+	}
+	return nil
+}
+
+// nsecProvesNXDomain returns true if one NSEC record shows that there is no
+// name, and another that there is no wildcard at the closest encloser which
+// could have been expanded to the name.
+func nsecProvesNXDomain(nsecs []*dns.NSEC, name string) bool {
+	for _, nsec := range nsecs {
+		if !nsecCovers(nsec, name) {
+			continue
+		}
+		wildcard := wildcardAt(nsecClosestEncloser(nsec, name))
+		for _, other := range nsecs {
+			if nsecCovers(other, wildcard) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nsecProvesNoData returns true if an NSEC record at name shows it has no
+// records of type qtype, if an NSEC record shows that name is an empty
+// non-terminal, or if the name doesn't exist but an NSEC record at the
+// wildcard which would have been expanded to it shows that it has no records
+// of type qtype.
+func nsecProvesNoData(nsecs []*dns.NSEC, name string, qtype uint16) bool {
+	for _, nsec := range nsecs {
+		if dns.CanonicalName(nsec.Hdr.Name) == name {
+			if nsecDeniesType(nsec.TypeBitMap, qtype, name) {
+				return true
+			}
+			continue
+		}
+		if !nsecCovers(nsec, name) {
+			continue
+		}
+		// A name with no records of its own, but with descendants which do,
+		// sorts between the NSEC record before it and its first descendant.
+		next := dns.CanonicalName(nsec.NextDomain)
+		if next != name && dns.IsSubDomain(name, next) {
+			return true
+		}
+		wildcard := wildcardAt(nsecClosestEncloser(nsec, name))
+		for _, other := range nsecs {
+			if dns.CanonicalName(other.Hdr.Name) == wildcard && nsecDeniesType(other.TypeBitMap, qtype, wildcard) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nsecCovers returns true if nsec proves that name doesn't exist, because name
+// falls strictly between the NSEC record's owner and next names in canonical
+// order.
+func nsecCovers(nsec *dns.NSEC, name string) bool {
+	owner := dns.CanonicalName(nsec.Hdr.Name)
+	next := dns.CanonicalName(nsec.NextDomain)
+	// Names below a delegation or a DNAME aren't in the zone which signed the
+	// NSEC record, so it can't say anything about them.
+	if owner != name && dns.IsSubDomain(owner, name) && isCut(nsec.TypeBitMap) {
+		return false
+	}
+	if compareNames(owner, name) >= 0 {
+		return false
+	}
+	if compareNames(owner, next) < 0 {
+		return compareNames(name, next) < 0
+	}
+	// The last NSEC record in a zone points back to the zone's apex.
+	return dns.IsSubDomain(next, name)
+}
+
+// nsecClosestEncloser returns the closest encloser of name, i.e. its deepest
+// existing ancestor, as shown by nsec, an NSEC record covering name: it is the
+// deeper of name's common ancestors with the owner and next names.
+func nsecClosestEncloser(nsec *dns.NSEC, name string) string {
+	common := dns.CompareDomainName(name, nsec.Hdr.Name)
+	if n := dns.CompareDomainName(name, nsec.NextDomain); n > common {
+		common = n
+	}
+	labels := dns.SplitDomainName(name)
+	return dns.Fqdn(strings.Join(labels[len(labels)-common:], "."))
+}
+
+// nsec3ProvesNXDomain returns true if the NSEC3 records prove the closest
+// encloser of name, and that there is no wildcard at the closest encloser.
+func nsec3ProvesNXDomain(nsec3s []*dns.NSEC3, name string) bool {
+	ce, _, ok := nsec3ClosestEncloser(nsec3s, name)
+	if !ok {
+		return false
+	}
+	return nsec3Covering(nsec3s, wildcardAt(ce)) != nil
+}
+
+// nsec3ProvesNoData returns true if an NSEC3 record matching name shows it
+// has no records of type qtype, if name is a DS query for a delegation
+// covered by an opt-out NSEC3 record, or if the closest encloser of name has a
+// wildcard which has no records of type qtype.
+func nsec3ProvesNoData(nsec3s []*dns.NSEC3, name string, qtype uint16) bool {
+	match := nsec3Matching(nsec3s, name)
+	if match != nil {
+		return nsecDeniesType(match.TypeBitMap, qtype, name)
+	}
+	ce, nextCloser, ok := nsec3ClosestEncloser(nsec3s, name)
+	if !ok {
+		return false
+	}
+	if qtype == dns.TypeDS && nextCloser.Flags&1 == 1 {
+		return true
+	}
+	wildcard := wildcardAt(ce)
+	match = nsec3Matching(nsec3s, wildcard)
+	return match != nil && nsecDeniesType(match.TypeBitMap, qtype, wildcard)
+}
+
+// nsec3ClosestEncloser finds the closest encloser proof for name: an NSEC3
+// record matching one of its ancestors, and one covering the next closer name,
+// the ancestor one label longer. It returns the closest encloser and the
+// record covering the next closer name.
+func nsec3ClosestEncloser(nsec3s []*dns.NSEC3, name string) (string, *dns.NSEC3, bool) {
+	labels := dns.SplitDomainName(name)
+	for i := 1; i <= len(labels); i++ {
+		ce := dns.Fqdn(strings.Join(labels[i:], "."))
+		match := nsec3Matching(nsec3s, ce)
+		if match == nil {
+			continue
+		}
+		// The closest encloser can't be a delegation or a DNAME, or name
+		// wouldn't be in the zone which signed the NSEC3 records.
+		if isCut(match.TypeBitMap) {
+			return "", nil, false
+		}
+		nextCloser := nsec3Covering(nsec3s, dns.Fqdn(strings.Join(labels[i-1:], ".")))
+		if nextCloser == nil {
+			return "", nil, false
+		}
+		return ce, nextCloser, true
+	}
+	return "", nil, false
+}
+
+// wildcardAt returns the wildcard name directly below name.
+func wildcardAt(name string) string {
+	if name == "." {
+		return "*."
+	}
+	return "*." + name
+}
+
+func nsec3Matching(nsec3s []*dns.NSEC3, name string) *dns.NSEC3 {
+	for _, nsec3 := range nsec3s {
+		if nsec3.Match(name) {
+			return nsec3
+		}
+	}
+	return nil
+}
+
+// nsec3Covering returns an NSEC3 record whose hash interval strictly contains
+// the hash of name. (*dns.NSEC3).Cover also accepts the record whose hash is
+// the same as name's, which would prove the opposite.
+func nsec3Covering(nsec3s []*dns.NSEC3, name string) *dns.NSEC3 {
+	for _, nsec3 := range nsec3s {
+		if nsec3.Cover(name) && !nsec3.Match(name) {
+			return nsec3
+		}
+	}
+	return nil
+}
+
+// nsecDeniesType returns true if the type bitmap of an NSEC or NSEC3 record at
+// name shows that there are no records of type qtype there, nor a CNAME which
+// would have been followed instead. A parent zone's record at a delegation
+// can only deny DS, which is the only type the parent is authoritative for,
+// and a child zone's record at its apex can't deny DS.
+func nsecDeniesType(types []uint16, qtype uint16, name string) bool {
+	if containsType(types, qtype) || containsType(types, dns.TypeCNAME) {
+		return false
+	}
+	if qtype == dns.TypeDS {
+		return name == "." || !containsType(types, dns.TypeSOA)
+	}
+	return !isCut(types)
+}
+
+// isCut returns true if the type bitmap of an NSEC or NSEC3 record shows that
+// the names below it aren't in the zone which signed the record: because it is
+// a delegation to another zone, or has a DNAME redirecting them.
+func isCut(types []uint16) bool {
+	return containsType(types, dns.TypeDNAME) ||
+		(containsType(types, dns.TypeNS) && !containsType(types, dns.TypeSOA))
+}
+
+// compareNames orders names canonically (RFC 4034 Section 6.1): by comparing
+// their labels case-insensitively, starting from the rightmost, with a name
+// sorting before its descendants.
+func compareNames(a, b string) int {
+	aLabels := dns.SplitDomainName(strings.ToLower(a))
+	bLabels := dns.SplitDomainName(strings.ToLower(b))
+	for i := 1; i <= len(aLabels) && i <= len(bLabels); i++ {
+		c := strings.Compare(aLabels[len(aLabels)-i], bLabels[len(bLabels)-i])
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(aLabels) < len(bLabels):
+		return -1
+	case len(aLabels) > len(bLabels):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,203 @@
+package bdns
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/miekg/dns"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func parseRRs(t *testing.T, records ...string) []dns.RR {
+	t.Helper()
+	var rrs []dns.RR
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		test.AssertNotError(t, err, "parsing test record")
+		rrs = append(rrs, rr)
+	}
+	return rrs
+}
+
+func TestCompareNames(t *testing.T) {
+	// The example ordering from RFC 4034 Section 6.1, less the names with
+	// escaped octets.
+	ordered := []string{
+		"example.",
+		"a.example.",
+		"yljkjljk.a.example.",
+		"Z.a.example.",
+		"zABC.a.EXAMPLE.",
+		"z.example.",
+		"*.z.example.",
+	}
+	shuffled := []string{ordered[3], ordered[6], ordered[0], ordered[5], ordered[1], ordered[4], ordered[2]}
+	sort.Slice(shuffled, func(i, j int) bool { return compareNames(shuffled[i], shuffled[j]) < 0 })
+	test.AssertDeepEquals(t, shuffled, ordered)
+	test.AssertEquals(t, compareNames("Example.COM.", "example.com."), 0)
+}
+
+func TestProveDenialNSEC(t *testing.T) {
+	zone := parseRRs(t,
+		"example.com. 300 IN NSEC a.example.com. SOA TXT RRSIG NSEC DNSKEY",
+		"a.example.com. 300 IN NSEC b.c.example.com. A RRSIG NSEC",
+		"b.c.example.com. 300 IN NSEC sub.example.com. A RRSIG NSEC",
+		"sub.example.com. 300 IN NSEC *.wild.example.com. NS DS RRSIG NSEC",
+		"*.wild.example.com. 300 IN NSEC example.com. A RRSIG NSEC",
+	)
+
+	testCases := []struct {
+		name     string
+		qname    string
+		qtype    uint16
+		nxdomain bool
+		records  []dns.RR
+		wantErr  bool
+	}{
+		{
+			name:     "name covered, no wildcard",
+			qname:    "b.example.com.",
+			nxdomain: true,
+			records:  zone[:2],
+		},
+		{
+			name:     "name covered, but wildcard not denied",
+			qname:    "b.example.com.",
+			nxdomain: true,
+			records:  zone[1:2],
+			wantErr:  true,
+		},
+		{
+			name:     "name not covered",
+			qname:    "a.example.com.",
+			nxdomain: true,
+			records:  zone,
+			wantErr:  true,
+		},
+		{
+			name:     "covered by the last NSEC record in the zone",
+			qname:    "zzz.example.com.",
+			nxdomain: true,
+			records:  zone,
+		},
+		{
+			name:     "outside the zone",
+			qname:    "zzz.example.net.",
+			nxdomain: true,
+			records:  zone,
+			wantErr:  true,
+		},
+		{
+			name:     "below a delegation",
+			qname:    "www.sub.example.com.",
+			nxdomain: true,
+			records:  zone,
+			wantErr:  true,
+		},
+		{
+			name:    "type missing from bitmap",
+			qname:   "a.example.com.",
+			qtype:   dns.TypeCAA,
+			records: zone[1:2],
+		},
+		{
+			name:    "type present in bitmap",
+			qname:   "a.example.com.",
+			qtype:   dns.TypeA,
+			records: zone[1:2],
+			wantErr: true,
+		},
+		{
+			name:    "parent side of a delegation can't deny other types",
+			qname:   "sub.example.com.",
+			qtype:   dns.TypeCAA,
+			records: zone,
+			wantErr: true,
+		},
+		{
+			name:    "parent side of a delegation can deny DS",
+			qname:   "sub.example.com.",
+			qtype:   dns.TypeDS,
+			records: parseRRs(t, "sub.example.com. 300 IN NSEC *.wild.example.com. NS RRSIG NSEC"),
+		},
+		{
+			name:    "zone apex can't deny DS",
+			qname:   "example.com.",
+			qtype:   dns.TypeDS,
+			records: zone,
+			wantErr: true,
+		},
+		{
+			name:    "empty non-terminal",
+			qname:   "c.example.com.",
+			qtype:   dns.TypeCAA,
+			records: zone[1:2],
+		},
+		{
+			name:    "wildcard without the type",
+			qname:   "x.wild.example.com.",
+			qtype:   dns.TypeCAA,
+			records: zone[3:],
+		},
+		{
+			name:    "wildcard with the type",
+			qname:   "x.wild.example.com.",
+			qtype:   dns.TypeA,
+			records: zone[3:],
+			wantErr: true,
+		},
+		{
+			name:    "no NSEC records",
+			qname:   "a.example.com.",
+			qtype:   dns.TypeCAA,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := proveDenial(tc.qname, tc.qtype, tc.nxdomain, tc.records)
+			if tc.wantErr {
+				test.AssertError(t, err, "denial should not have been proven")
+			} else {
+				test.AssertNotError(t, err, "denial should have been proven")
+			}
+		})
+	}
+}
+
+func TestProveDenialNSEC3(t *testing.T) {
+	zone := &signedZone{name: "example.com."}
+	zone.addNSEC3(map[string][]uint16{
+		"example.com.":     {dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY},
+		"www.example.com.": {dns.TypeA, dns.TypeRRSIG},
+		"sub.example.com.": {dns.TypeNS},
+	})
+
+	optOut := &signedZone{name: "example.org."}
+	optOut.addNSEC3(map[string][]uint16{
+		"example.org.": {dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY},
+	})
+	for _, rr := range optOut.records {
+		rr.(*dns.NSEC3).Flags = 1
+	}
+
+	slow := &signedZone{name: "example.com."}
+	slow.addNSEC3(map[string][]uint16{
+		"example.com.": {dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY},
+	})
+	for _, rr := range slow.records {
+		rr.(*dns.NSEC3).Iterations = maxNSEC3Iterations + 1
+	}
+
+	test.AssertNotError(t, proveDenial("nothere.example.com.", 0, true, zone.records), "NXDOMAIN not proven")
+	test.AssertError(t, proveDenial("www.example.com.", 0, true, zone.records), "NXDOMAIN proven for existing name")
+	test.AssertError(t, proveDenial("www.sub.example.com.", 0, true, zone.records), "NXDOMAIN proven below a delegation")
+	test.AssertNotError(t, proveDenial("www.example.com.", dns.TypeCAA, false, zone.records), "NODATA not proven")
+	test.AssertError(t, proveDenial("www.example.com.", dns.TypeA, false, zone.records), "NODATA proven for existing type")
+	test.AssertNotError(t, proveDenial("sub.example.com.", dns.TypeDS, false, zone.records), "NODATA not proven for DS")
+	test.AssertError(t, proveDenial("sub.example.com.", dns.TypeCAA, false, zone.records), "NODATA proven by parent side of delegation")
+	test.AssertNotError(t, proveDenial("sub.example.org.", dns.TypeDS, false, optOut.records), "opt-out not accepted for DS")
+	test.AssertError(t, proveDenial("sub.example.org.", dns.TypeCAA, false, optOut.records), "opt-out accepted for CAA")
+	test.AssertError(t, proveDenial("nothere.example.com.", 0, true, slow.records), "accepted NSEC3 records with too many iterations")
+}
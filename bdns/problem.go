@@ -2,6 +2,7 @@ package bdns
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -38,8 +39,13 @@ func extendedDNSError(msg *dns.Msg) *dns.EDNS0_EDE {
 }
 
 // wrapErr returns a non-nil error if err is non-nil or if resp.Rcode is not dns.RcodeSuccess.
-// The error includes appropriate details about the DNS query that failed.
+// The error includes appropriate details about the DNS query that failed. A
+// BogusError is returned as-is, since it already includes those details.
 func wrapErr(queryType uint16, hostname string, resp *dns.Msg, err error) error {
+	var bogus BogusError
+	if errors.As(err, &bogus) {
+		return err
+	}
 	if err != nil {
 		return Error{
 			recordType: queryType,
@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,9 +39,17 @@ var _ ServerProvider = &staticProvider{}
 // host/IP and port separated by colon. Additionally, if the host is a literal
 // IPv6 address, it must be enclosed in square brackets.
 // (https://golang.org/src/net/dial.go?s=9833:9881#L281)
+//
+// The address may also be a DNS-over-TLS or DNS-over-HTTPS URL, as described
+// on serverAddr, in which case the port is optional.
 func validateServerAddress(address string) error {
+	server, err := parseServerAddr(address)
+	if err != nil {
+		return err
+	}
+
 	// Ensure the host and port portions of `address` can be split.
-	host, port, err := net.SplitHostPort(address)
+	host, port, err := net.SplitHostPort(server.hostPort)
 	if err != nil {
 		return err
 	}
@@ -114,9 +123,23 @@ type dynamicProvider struct {
 	service string
 	// domain is the name to look up SRV records within.
 	domain string
+	// transport is the transport used to query the DNS backends: TransportUDP,
+	// TransportTLS or TransportHTTPS.
+	transport string
+	// dohPath is the URL path of the DNS-over-HTTPS endpoint, if transport is
+	// TransportHTTPS.
+	dohPath string
+	// tlsServerName, if set, is the name verified in every backend's
+	// certificate for TransportTLS and TransportHTTPS, rather than the SRV
+	// Target.
+	tlsServerName string
 	// A map of IP addresses (results of A record lookups for SRV Targets) to
 	// ports (Port fields in SRV records) associated with those addresses.
 	addrs map[string][]uint16
+	// A map of IP addresses to the SRV Target they were looked up for, which is
+	// the name verified in the backend's certificate for TransportTLS and
+	// TransportHTTPS.
+	names map[string]string
 	// Other internal bookkeeping state.
 	cancel        chan interface{}
 	mu            sync.RWMutex
@@ -204,13 +227,29 @@ func StartDynamicProvider(c *cmd.DNSProvider, refresh time.Duration) (*dynamicPr
 		}
 	}
 
+	transport := c.Transport
+	if transport == "" {
+		transport = TransportUDP
+	}
+	if transport != TransportUDP && transport != TransportTLS && transport != TransportHTTPS {
+		return nil, fmt.Errorf("unsupported transport %q", transport)
+	}
+	dohPath := c.DoHPath
+	if dohPath == "" {
+		dohPath = "/dns-query"
+	}
+
 	dp := dynamicProvider{
-		dnsAuthority: dnsAuthority,
-		service:      service,
-		domain:       c.SRVLookup.Domain,
-		addrs:        make(map[string][]uint16),
-		cancel:       make(chan interface{}),
-		refresh:      refresh,
+		dnsAuthority:  dnsAuthority,
+		service:       service,
+		domain:        c.SRVLookup.Domain,
+		transport:     transport,
+		dohPath:       dohPath,
+		tlsServerName: c.TLSServerName,
+		addrs:         make(map[string][]uint16),
+		names:         make(map[string]string),
+		cancel:        make(chan interface{}),
+		refresh:       refresh,
 		updateCounter: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "dns_update",
@@ -286,6 +325,7 @@ func (dp *dynamicProvider) update() error {
 	}
 
 	addrPorts := make(map[string][]uint16)
+	names := make(map[string]string)
 	for _, srv := range srvs {
 		addrs, err := resolver.LookupHost(ctx, srv.Target)
 		if err != nil {
@@ -298,23 +338,36 @@ func (dp *dynamicProvider) update() error {
 				return fmt.Errorf("invalid addr %q from SRV record %q: %w", joinedHostPort, record, err)
 			}
 			addrPorts[addr] = append(addrPorts[addr], srv.Port)
+			names[addr] = strings.TrimSuffix(srv.Target, ".")
+			if dp.tlsServerName != "" {
+				names[addr] = dp.tlsServerName
+			}
 		}
 	}
 
 	dp.mu.Lock()
 	dp.addrs = addrPorts
+	dp.names = names
 	dp.mu.Unlock()
 	return nil
 }
 
 // Addrs returns a shuffled list of IP/port pairs, with the guarantee that no
-// two IP/port pairs will share the same IP.
+// two IP/port pairs will share the same IP. For TransportTLS and
+// TransportHTTPS, each pair is formatted as a URL, as described on serverAddr,
+// with the SRV Target as the name to verify.
 func (dp *dynamicProvider) Addrs() ([]string, error) {
 	var r []string
 	dp.mu.RLock()
 	for ip, ports := range dp.addrs {
 		port := fmt.Sprint(ports[rand.Intn(len(ports))])
 		addr := net.JoinHostPort(ip, port)
+		switch dp.transport {
+		case TransportTLS:
+			addr = fmt.Sprintf("tls://%s#%s", addr, dp.names[ip])
+		case TransportHTTPS:
+			addr = fmt.Sprintf("https://%s%s#%s", addr, dp.dohPath, dp.names[ip])
+		}
 		r = append(r, addr)
 	}
 	dp.mu.RUnlock()
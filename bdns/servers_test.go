@@ -51,6 +51,18 @@ func Test_validateServerAddress(t *testing.T) {
 		{"fqdn string for port", args{"bar.foo.baz:bar"}, true},
 		{"fqdn port out of range high", args{"bar.foo.baz:65536"}, true},
 		{"fqdn port out of range low", args{"bar.foo.baz:0"}, true},
+
+		// DNS-over-TLS and DNS-over-HTTPS cases
+		{"tls with port", args{"tls://1.1.1.1:853"}, false},
+		{"tls without port", args{"tls://1.1.1.1"}, false},
+		{"tls with server name", args{"tls://1.1.1.1#one.one.one.one"}, false},
+		{"https with path", args{"https://1.1.1.1/dns-query"}, false},
+		{"https ipv6 with port", args{"https://[2606:4700:4700::1111]:8443/dns-query"}, false},
+		// sad path
+		{"unknown transport", args{"quic://1.1.1.1:853"}, true},
+		{"tls with path", args{"tls://1.1.1.1:853/dns-query"}, true},
+		{"tls port out of range", args{"tls://1.1.1.1:65536"}, true},
+		{"https with user info", args{"https://user@1.1.1.1/dns-query"}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
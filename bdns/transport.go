@@ -0,0 +1,306 @@
+package bdns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// TransportUDP is plain DNS over UDP, used for server addresses without a
+	// scheme.
+	TransportUDP = "udp"
+	// TransportTLS is DNS-over-TLS (RFC 7858), used for server addresses of
+	// the form "tls://host:port".
+	TransportTLS = "tls"
+	// TransportHTTPS is DNS-over-HTTPS (RFC 8484), used for server addresses
+	// of the form "https://host:port/path".
+	TransportHTTPS = "https"
+
+	// maxIdleTLSConns is the number of idle DNS-over-TLS connections kept open
+	// to each server for reuse.
+	maxIdleTLSConns = 8
+)
+
+// serverAddr is a parsed DNS server address, as provided by a ServerProvider.
+//
+// Plain DNS servers are given as "host:port". DNS-over-TLS servers are given
+// as "tls://host:port" and DNS-over-HTTPS servers as "https://host:port/path",
+// with the port defaulting to 853 and 443 respectively. For both, an optional
+// URL fragment overrides the name expected in the server's certificate, which
+// otherwise is the host, e.g. "tls://10.77.77.77:853#unbound.service.consul".
+type serverAddr struct {
+	transport string
+	// hostPort is the address to dial.
+	hostPort string
+	// serverName is the name to verify in the server's certificate.
+	serverName string
+	// url is the DNS-over-HTTPS endpoint, without any fragment.
+	url string
+}
+
+// parseServerAddr parses a DNS server address in one of the forms described
+// on serverAddr.
+func parseServerAddr(addr string) (serverAddr, error) {
+	if !strings.Contains(addr, "://") {
+		return serverAddr{transport: TransportUDP, hostPort: addr}, nil
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return serverAddr{}, err
+	}
+	var defaultPort string
+	switch u.Scheme {
+	case TransportTLS:
+		defaultPort = "853"
+		if u.Path != "" || u.RawQuery != "" {
+			return serverAddr{}, errors.New("DNS-over-TLS address cannot have a path or query")
+		}
+	case TransportHTTPS:
+		defaultPort = "443"
+	default:
+		return serverAddr{}, fmt.Errorf("unsupported transport %q", u.Scheme)
+	}
+	if u.User != nil {
+		return serverAddr{}, errors.New("address cannot include user info")
+	}
+
+	hostPort := u.Host
+	if u.Port() == "" {
+		hostPort = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	serverName := u.Fragment
+	if serverName == "" {
+		serverName = u.Hostname()
+	}
+	u.Fragment = ""
+	return serverAddr{
+		transport:  u.Scheme,
+		hostPort:   hostPort,
+		serverName: serverName,
+		url:        u.String(),
+	}, nil
+}
+
+// transportExchanger is an exchanger which sends each query using the
+// transport selected by the server address: plain UDP, DNS-over-TLS, or
+// DNS-over-HTTPS. Connections to DNS-over-TLS and DNS-over-HTTPS servers are
+// reused across queries.
+type transportExchanger struct {
+	udp         *dns.Client
+	tls         *dns.Client
+	tlsConfig   *tls.Config
+	readTimeout time.Duration
+
+	mu       sync.Mutex
+	idleConn map[string][]*dns.Conn
+	// httpClients holds an HTTP client, and so a pool of connections, for
+	// each name verified in DNS-over-HTTPS servers' certificates.
+	httpClients map[string]*http.Client
+
+	connCounter *prometheus.CounterVec
+}
+
+var _ exchanger = &transportExchanger{}
+
+// newTransportExchanger constructs a transportExchanger. The tlsConfig is used
+// to verify DNS-over-TLS and DNS-over-HTTPS servers and may be nil, in which
+// case the system roots are used.
+func newTransportExchanger(readTimeout time.Duration, tlsConfig *tls.Config, stats prometheus.Registerer) *transportExchanger {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	connCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_transport_connections",
+			Help: "Counter of connections used for DNS-over-TLS queries, sliced by whether they were newly dialed or reused",
+		},
+		[]string{"transport", "reused"},
+	)
+	stats.MustRegister(connCounter)
+
+	return &transportExchanger{
+		udp: &dns.Client{
+			Net:         "udp",
+			ReadTimeout: readTimeout,
+		},
+		tls: &dns.Client{
+			Net:         "tcp-tls",
+			ReadTimeout: readTimeout,
+			Timeout:     readTimeout,
+		},
+		tlsConfig:   tlsConfig,
+		readTimeout: readTimeout,
+		idleConn:    make(map[string][]*dns.Conn),
+		httpClients: make(map[string]*http.Client),
+		connCounter: connCounter,
+	}
+}
+
+// ExchangeContext sends m to the server at addr using the transport selected by
+// the address.
+func (te *transportExchanger) ExchangeContext(ctx context.Context, m *dns.Msg, addr string) (*dns.Msg, time.Duration, error) {
+	server, err := parseServerAddr(addr)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch server.transport {
+	case TransportTLS:
+		return te.exchangeTLS(ctx, m, server)
+	case TransportHTTPS:
+		return te.exchangeHTTPS(ctx, m, server)
+	default:
+		return te.udp.ExchangeContext(ctx, m, server.hostPort)
+	}
+}
+
+// exchangeTLS sends m over an idle DNS-over-TLS connection to the server, or
+// a new one if there is none, and returns the connection to the idle pool if
+// the exchange succeeds. If an idle connection turns out to have been closed
+// by the server, the query is retried once over a new connection.
+func (te *transportExchanger) exchangeTLS(ctx context.Context, m *dns.Msg, server serverAddr) (*dns.Msg, time.Duration, error) {
+	key := server.hostPort + "#" + server.serverName
+	for {
+		conn := te.getIdleConn(key)
+		reused := conn != nil
+		if conn == nil {
+			tlsConfig := te.tlsConfig.Clone()
+			tlsConfig.ServerName = server.serverName
+			client := &dns.Client{
+				Net:         te.tls.Net,
+				ReadTimeout: te.tls.ReadTimeout,
+				Timeout:     te.tls.Timeout,
+				TLSConfig:   tlsConfig,
+			}
+			var err error
+			conn, err = client.DialContext(ctx, server.hostPort)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		te.connCounter.With(prometheus.Labels{
+			"transport": TransportTLS,
+			"reused":    fmt.Sprint(reused),
+		}).Inc()
+
+		resp, rtt, err := te.tls.ExchangeWithConn(m, conn)
+		if err != nil {
+			_ = conn.Close()
+			if reused {
+				continue
+			}
+			return nil, rtt, err
+		}
+		te.putIdleConn(key, conn)
+		return resp, rtt, nil
+	}
+}
+
+func (te *transportExchanger) getIdleConn(key string) *dns.Conn {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	conns := te.idleConn[key]
+	if len(conns) == 0 {
+		return nil
+	}
+	conn := conns[len(conns)-1]
+	te.idleConn[key] = conns[:len(conns)-1]
+	return conn
+}
+
+func (te *transportExchanger) putIdleConn(key string, conn *dns.Conn) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	if len(te.idleConn[key]) >= maxIdleTLSConns {
+		_ = conn.Close()
+		return
+	}
+	te.idleConn[key] = append(te.idleConn[key], conn)
+}
+
+// httpClient returns the HTTP client for DNS-over-HTTPS servers whose
+// certificates are verified against serverName, creating it if needed.
+func (te *transportExchanger) httpClient(serverName string) *http.Client {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	client, ok := te.httpClients[serverName]
+	if ok {
+		return client
+	}
+	tlsConfig := te.tlsConfig.Clone()
+	tlsConfig.ServerName = serverName
+	client = &http.Client{
+		Timeout: te.readTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig:     tlsConfig,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConnsPerHost: maxIdleTLSConns,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+	te.httpClients[serverName] = client
+	return client
+}
+
+// exchangeHTTPS sends m to the server as a DNS-over-HTTPS POST request.
+// Connections are reused by the underlying http.Transport.
+func (te *transportExchanger) exchangeHTTPS(ctx context.Context, m *dns.Msg, server serverAddr) (*dns.Msg, time.Duration, error) {
+	// RFC 8484 Section 4.1 recommends a message ID of zero, to make responses
+	// more cacheable.
+	query := m.Copy()
+	query.Id = 0
+	body, err := query.Pack()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	req.Host = server.serverName
+
+	start := time.Now()
+	resp, err := te.httpClient(server.serverName).Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Since(start), fmt.Errorf("DNS-over-HTTPS server returned HTTP status %d", resp.StatusCode)
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	rtt := time.Since(start)
+	if err != nil {
+		return nil, rtt, err
+	}
+
+	r := new(dns.Msg)
+	err = r.Unpack(respBody)
+	if err != nil {
+		return nil, rtt, err
+	}
+	if r.Id != query.Id {
+		return nil, rtt, dns.ErrId
+	}
+	r.Id = m.Id
+	return r, rtt, nil
+}
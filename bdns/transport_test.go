@@ -0,0 +1,173 @@
+package bdns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// txtAnswer returns a response to r containing a single TXT record.
+func txtAnswer(r *dns.Msg, txt string) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	m.Answer = append(m.Answer, &dns.TXT{
+		Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+		Txt: []string{txt},
+	})
+	return m
+}
+
+// startSecureServers starts a DNS-over-HTTPS server and a DNS-over-TLS server,
+// both using a certificate for "example.com" and 127.0.0.1, and returns their
+// addresses along with a pool containing that certificate.
+func startSecureServers(t *testing.T) (string, string, *x509.CertPool) {
+	t.Helper()
+	doh := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/dns-message" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		test.AssertNotError(t, err, "reading DoH request")
+		q := new(dns.Msg)
+		err = q.Unpack(body)
+		test.AssertNotError(t, err, "unpacking DoH request")
+		resp, err := txtAnswer(q, "over-https").Pack()
+		test.AssertNotError(t, err, "packing DoH response")
+		w.Header().Set("Content-Type", "application/dns-message")
+		_, _ = w.Write(resp)
+	}))
+	doh.StartTLS()
+	t.Cleanup(doh.Close)
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: doh.TLS.Certificates})
+	test.AssertNotError(t, err, "listening for DoT server")
+	dot := &dns.Server{
+		Listener: ln,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			_ = w.WriteMsg(txtAnswer(r, "over-tls"))
+		}),
+	}
+	go func() {
+		_ = dot.ActivateAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = dot.Shutdown()
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(doh.Certificate())
+	return doh.URL + "/dns-query", "tls://" + ln.Addr().String(), roots
+}
+
+func newSecureTestClient(t *testing.T, server string, roots *x509.CertPool) Client {
+	t.Helper()
+	staticProvider, err := NewStaticProvider([]string{server})
+	test.AssertNotError(t, err, "Got error creating StaticProvider")
+	return NewTest(time.Second*10, staticProvider, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock(), &tls.Config{RootCAs: roots})
+}
+
+func TestDNSOverTLS(t *testing.T) {
+	_, dotAddr, roots := startSecureServers(t)
+
+	client := newSecureTestClient(t, dotAddr, roots)
+	for i := 0; i < 3; i++ {
+		txts, _, err := client.LookupTXT(context.Background(), "example.com")
+		test.AssertNotError(t, err, "LookupTXT over DNS-over-TLS failed")
+		test.AssertDeepEquals(t, txts, []string{"over-tls"})
+	}
+
+	// The connection from the first lookup was reused by the others.
+	te := client.(*impl).dnsClient.(*transportExchanger)
+	test.AssertMetricWithLabelsEquals(t, te.connCounter, prometheus.Labels{"transport": "tls", "reused": "false"}, 1)
+	test.AssertMetricWithLabelsEquals(t, te.connCounter, prometheus.Labels{"transport": "tls", "reused": "true"}, 2)
+
+	// The server's certificate doesn't include this name.
+	client = newSecureTestClient(t, dotAddr+"#wrong.example.net", roots)
+	_, _, err := client.LookupTXT(context.Background(), "example.com")
+	test.AssertError(t, err, "LookupTXT succeeded despite certificate name mismatch")
+
+	// Nor is it trusted by the system roots.
+	client = newSecureTestClient(t, dotAddr, nil)
+	_, _, err = client.LookupTXT(context.Background(), "example.com")
+	test.AssertError(t, err, "LookupTXT succeeded with untrusted certificate")
+}
+
+func TestDNSOverHTTPS(t *testing.T) {
+	dohAddr, _, roots := startSecureServers(t)
+
+	client := newSecureTestClient(t, dohAddr, roots)
+	txts, _, err := client.LookupTXT(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupTXT over DNS-over-HTTPS failed")
+	test.AssertDeepEquals(t, txts, []string{"over-https"})
+
+	client = newSecureTestClient(t, dohAddr+"#example.com", roots)
+	txts, _, err = client.LookupTXT(context.Background(), "example.com")
+	test.AssertNotError(t, err, "LookupTXT over DNS-over-HTTPS with server name failed")
+	test.AssertDeepEquals(t, txts, []string{"over-https"})
+
+	client = newSecureTestClient(t, dohAddr+"#wrong.example.net", roots)
+	_, _, err = client.LookupTXT(context.Background(), "example.com")
+	test.AssertError(t, err, "LookupTXT succeeded despite certificate name mismatch")
+
+	// The request is abandoned along with the query's context.
+	te := client.(*impl).dnsClient.(*transportExchanger)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m := new(dns.Msg)
+	m.SetQuestion("example.com.", dns.TypeTXT)
+	_, _, err = te.ExchangeContext(ctx, m, dohAddr)
+	test.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestParseServerAddr(t *testing.T) {
+	testCases := []struct {
+		addr string
+		want serverAddr
+	}{
+		{"10.0.0.1:53", serverAddr{transport: TransportUDP, hostPort: "10.0.0.1:53"}},
+		{"tls://10.0.0.1", serverAddr{transport: TransportTLS, hostPort: "10.0.0.1:853", serverName: "10.0.0.1", url: "tls://10.0.0.1"}},
+		{"tls://[::1]:8853#dns.example", serverAddr{transport: TransportTLS, hostPort: "[::1]:8853", serverName: "dns.example", url: "tls://[::1]:8853"}},
+		{"https://dns.example/dns-query", serverAddr{transport: TransportHTTPS, hostPort: "dns.example:443", serverName: "dns.example", url: "https://dns.example/dns-query"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.addr, func(t *testing.T) {
+			got, err := parseServerAddr(tc.addr)
+			test.AssertNotError(t, err, "parsing server address")
+			test.AssertEquals(t, got, tc.want)
+		})
+	}
+}
+
+func TestDynamicProviderTransports(t *testing.T) {
+	ip := net.ParseIP("10.77.77.77").String()
+	dp := &dynamicProvider{
+		transport: TransportTLS,
+		addrs:     map[string][]uint16{ip: {853}},
+		names:     map[string]string{ip: "unbound.service.consul"},
+	}
+	addrs, err := dp.Addrs()
+	test.AssertNotError(t, err, "getting addresses")
+	test.AssertDeepEquals(t, addrs, []string{"tls://10.77.77.77:853#unbound.service.consul"})
+
+	dp.transport = TransportHTTPS
+	dp.dohPath = "/dns-query"
+	addrs, err = dp.Addrs()
+	test.AssertNotError(t, err, "getting addresses")
+	test.AssertDeepEquals(t, addrs, []string{"https://10.77.77.77:853/dns-query#unbound.service.consul"})
+}
@@ -231,6 +231,7 @@ func (ca *certificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 		RegID:        regID,
 		Issued:       nowNanos,
 		IssuerNameID: int64(issuerNameID),
+		Alternate:    issueReq.Alternate,
 	}
 
 	if features.Enabled(features.StoreLintingCertificateInsteadOfPrecertificate) {
@@ -249,10 +250,11 @@ func (ca *certificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 				serialHex, hex.EncodeToString(precertDER), issuerNameID, issueReq.RegistrationID, issueReq.OrderID, err)
 			if ca.orphanQueue != nil {
 				ca.queueOrphan(&orphanedCert{
-					DER:      precertDER,
-					RegID:    regID,
-					Precert:  true,
-					IssuerID: int64(issuerNameID),
+					DER:       precertDER,
+					RegID:     regID,
+					Precert:   true,
+					IssuerID:  int64(issuerNameID),
+					Alternate: issueReq.Alternate,
 				})
 			}
 			return nil, err
@@ -349,7 +351,7 @@ func (ca *certificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 	ca.log.AuditInfof("Signing cert success: serial=[%s] regID=[%d] names=[%s] certificate=[%s]",
 		serialHex, req.RegistrationID, names, hex.EncodeToString(certDER))
 
-	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, precert.SerialNumber, certDER, int64(issuer.Cert.NameID()))
+	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, precert.SerialNumber, certDER, int64(issuer.Cert.NameID()), req.Alternate)
 	if err != nil {
 		return nil, err
 	}
@@ -468,6 +470,7 @@ func (ca *certificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 			Issued:       nowNanos,
 			IssuerNameID: int64(issuer.Cert.NameID()),
 			OcspNotReady: true,
+			Alternate:    issueReq.Alternate,
 		})
 		if err != nil {
 			return nil, nil, err
@@ -495,12 +498,14 @@ func (ca *certificateAuthorityImpl) storeCertificate(
 	orderID int64,
 	serialBigInt *big.Int,
 	certDER []byte,
-	issuerID int64) error {
+	issuerID int64,
+	alternate bool) error {
 	var err error
 	_, err = ca.sa.AddCertificate(ctx, &sapb.AddCertificateRequest{
-		Der:    certDER,
-		RegID:  regID,
-		Issued: ca.clk.Now().UnixNano(),
+		Der:       certDER,
+		RegID:     regID,
+		Issued:    ca.clk.Now().UnixNano(),
+		Alternate: alternate,
 	})
 	if err != nil {
 		ca.orphanCount.With(prometheus.Labels{"type": "cert"}).Inc()
@@ -511,9 +516,10 @@ func (ca *certificateAuthorityImpl) storeCertificate(
 			core.SerialToString(serialBigInt), hex.EncodeToString(certDER), issuerID, regID, orderID, err)
 		if ca.orphanQueue != nil {
 			ca.queueOrphan(&orphanedCert{
-				DER:      certDER,
-				RegID:    regID,
-				IssuerID: issuerID,
+				DER:       certDER,
+				RegID:     regID,
+				IssuerID:  issuerID,
+				Alternate: alternate,
 			})
 		}
 		return err
@@ -526,6 +532,9 @@ type orphanedCert struct {
 	RegID    int64
 	Precert  bool
 	IssuerID int64
+	// Alternate is true for the alternate certificate of a dual certificate
+	// order, which must not be counted towards rate limits.
+	Alternate bool
 }
 
 func (ca *certificateAuthorityImpl) queueOrphan(o *orphanedCert) {
@@ -582,15 +591,17 @@ func (ca *certificateAuthorityImpl) integrateOrphan() error {
 			RegID:        orphan.RegID,
 			Issued:       issued.UnixNano(),
 			IssuerNameID: orphan.IssuerID,
+			Alternate:    orphan.Alternate,
 		})
 		if err != nil && !errors.Is(err, berrors.Duplicate) {
 			return fmt.Errorf("failed to store orphaned precertificate: %s", err)
 		}
 	} else {
 		_, err = ca.sa.AddCertificate(context.Background(), &sapb.AddCertificateRequest{
-			Der:    orphan.DER,
-			RegID:  orphan.RegID,
-			Issued: issued.UnixNano(),
+			Der:       orphan.DER,
+			RegID:     orphan.RegID,
+			Issued:    issued.UnixNano(),
+			Alternate: orphan.Alternate,
 		})
 		if err != nil && !errors.Is(err, berrors.Duplicate) {
 			return fmt.Errorf("failed to store orphaned certificate: %s", err)
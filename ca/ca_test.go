@@ -168,7 +168,7 @@ func init() {
 	caCert, caKey, err = issuance.LoadIssuer(issuance.IssuerLoc{
 		File:     caKeyFile,
 		CertFile: caCertFile,
-	})
+	}, metrics.NoopRegisterer)
 	if err != nil {
 		panic(fmt.Sprintf("Unable to load %q and %q: %s", caKeyFile, caCertFile, err))
 	}
@@ -927,6 +927,7 @@ type queueSA struct {
 
 	issued        time.Time
 	issuedPrecert time.Time
+	alternate     bool
 }
 
 func (qsa *queueSA) AddCertificate(_ context.Context, req *sapb.AddCertificateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
@@ -936,6 +937,7 @@ func (qsa *queueSA) AddCertificate(_ context.Context, req *sapb.AddCertificateRe
 		return nil, berrors.DuplicateError("is a dupe")
 	}
 	qsa.issued = time.Unix(0, req.Issued).UTC()
+	qsa.alternate = req.Alternate
 	return nil, nil
 }
 
@@ -1069,6 +1071,7 @@ func TestOrphanQueue(t *testing.T) {
 		tmpl.SerialNumber,
 		certDER,
 		1,
+		false,
 	)
 	test.AssertError(t, err, "storeCertificate didn't fail when AddCertificate failed")
 
@@ -1100,7 +1103,8 @@ func TestOrphanQueue(t *testing.T) {
 		t.Fatalf("Unexpected error, wanted %q, got %q", goque.ErrEmpty, err)
 	}
 
-	// add cert to queue, and recreate queue to make sure it still has the cert
+	// add an alternate cert to queue, and recreate queue to make sure it still
+	// has the cert and that it is still marked as alternate
 	qsa.fail = true
 	qsa.duplicate = false
 	err = ca.storeCertificate(
@@ -1110,6 +1114,7 @@ func TestOrphanQueue(t *testing.T) {
 		tmpl.SerialNumber,
 		certDER,
 		1,
+		true,
 	)
 	test.AssertError(t, err, "storeCertificate didn't fail when AddCertificate failed")
 	err = orphanQueue.Close()
@@ -1125,6 +1130,7 @@ func TestOrphanQueue(t *testing.T) {
 	if !qsa.issued.Equal(fakeNow) {
 		t.Errorf("expected issued time to be %s, got %s", fakeNow, qsa.issued)
 	}
+	test.Assert(t, qsa.alternate, "integrated orphan wasn't marked as alternate")
 	err = ca.integrateOrphan()
 	if err != goque.ErrEmpty {
 		t.Fatalf("Unexpected error, wanted %q, got %q", goque.ErrEmpty, err)
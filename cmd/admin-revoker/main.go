@@ -14,7 +14,9 @@ import (
 	"os/user"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
@@ -25,11 +27,13 @@ import (
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/policy"
 	"github.com/letsencrypt/boulder/privatekey"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/revocation"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const usageString = `
@@ -42,6 +46,13 @@ usage:
   private-key-block      -config <path> -comment="<string>" -dry-run=<bool>    <priv-key-path>
   private-key-revoke     -config <path> -comment="<string>" -dry-run=<bool>    <priv-key-path>
   clear-email            -config <path> <email-address>
+  validation-perspectives -config <path> <authz-id>
+  block-name             -config <path> -type=<block-type> -reason="<string>" -expires=<duration> <name>
+  unblock-name           -config <path> <blocked-name-id>
+  search-blocked-names   -config <path> -include-expired=<bool> -include-removed=<bool> <substring>
+  list-reviews           -config <path> -status=<review-status>
+  approve-order          -config <path> -reason="<string>" <order-id>
+  deny-order             -config <path> -reason="<string>" <order-id>
 
 
 descriptions:
@@ -62,6 +73,20 @@ descriptions:
                          table. <priv-key-path> is expected to be the path to a PEM
                          formatted file containing an RSA or ECDSA private key.
   clear-email            Delete all instances of a given email from all accounts (slow).
+  validation-perspectives
+                         Print the result of each validation of an authorization from
+                         each network perspective, primary VA first.
+  block-name             Add a name to the hostname blocklist. The RA loads new entries
+                         periodically, in addition to those in its hostname policy file.
+                         The name must be one we could otherwise issue for: wildcards and
+                         public suffixes are refused.
+  unblock-name           Remove an entry, by ID, from the hostname blocklist. The entry is
+                         kept, marked as removed by the current user.
+  search-blocked-names   List the hostname blocklist entries whose names contain the given
+                         substring. Use "" to list all entries.
+  list-reviews           List the orders queued for manual review, oldest first.
+  approve-order          Approve an order queued for manual review, allowing it to proceed.
+  deny-order             Deny an order queued for manual review, making it invalid.
 
 flags:
   all:
@@ -72,6 +97,22 @@ flags:
                          perform the requested block or revoke action. Only implemented for
                          private-key-block and private-key-revoke.
     -comment             Comment to include in the blocked keys table entry. (default: "")
+
+  block-name:
+    -type                exact: blocks only the name itself. highRisk or admin: blocks the
+                         name and all of its subdomains. (default: "exact")
+    -reason              Reason for the block, recorded with the entry. (required)
+    -expires             How long the block lasts, e.g. "720h". 0 (default): never expires.
+
+  search-blocked-names:
+    -include-expired     true: also list entries which have expired. (default: false)
+    -include-removed     true: also list entries which have been removed. (default: false)
+
+  list-reviews:
+    -status              pending, approved, or denied. "" lists all reviews. (default: "pending")
+
+  approve-order | deny-order:
+    -reason              Reason for the decision, recorded with the review. (required)
 `
 
 type Config struct {
@@ -249,6 +290,167 @@ func (r *revoker) clearEmailAddress(ctx context.Context, email string) error {
 	return nil
 }
 
+// printValidationPerspectives writes the result of each validation of the
+// given authorization from each network perspective to w, one per line.
+func (r *revoker) printValidationPerspectives(ctx context.Context, authzID int64, w io.Writer) error {
+	resp, err := r.sac.GetValidationPerspectives(ctx, &sapb.AuthorizationID2{Id: authzID})
+	if err != nil {
+		return err
+	}
+	if len(resp.Perspectives) == 0 {
+		return fmt.Errorf("no validation perspectives recorded for authorization %d", authzID)
+	}
+	for _, p := range resp.Perspectives {
+		result := "success"
+		if !p.Success {
+			result = "failure"
+		}
+		line := fmt.Sprintf("perspective=[%s] rir=[%s] result=[%s]", p.Perspective, p.Rir, result)
+		if p.Problem != nil {
+			line += fmt.Sprintf(" problem=[%s: %s]", p.Problem.ProblemType, p.Problem.Detail)
+		}
+		_, err = fmt.Fprintln(w, line)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockName adds name to the hostname blocklist, recording the current user as
+// its creator. If expires is non-zero the entry lapses after that long.
+func (r *revoker) blockName(ctx context.Context, name string, blockType string, reason string, expires time.Duration) (*sapb.BlockedName, error) {
+	if reason == "" {
+		return nil, errors.New("a reason is required to block a name")
+	}
+	name = strings.ToLower(name)
+	err := policy.ValidBlockedName(name)
+	if err != nil {
+		return nil, fmt.Errorf("refusing to block %q: %w", name, err)
+	}
+	u, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+	req := &sapb.AddBlockedNameRequest{
+		Name:      name,
+		BlockType: blockType,
+		Reason:    reason,
+		Creator:   u.Username,
+	}
+	if expires > 0 {
+		req.Expires = timestamppb.New(r.clk.Now().Add(expires))
+	}
+	bn, err := r.sac.AddBlockedName(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	r.log.AuditInfof("Blocked name: %s", formatBlockedName(bn))
+	return bn, nil
+}
+
+// unblockName removes the hostname blocklist entry with the given ID,
+// recording the current user as its remover.
+func (r *revoker) unblockName(ctx context.Context, id int64) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	_, err = r.sac.RemoveBlockedName(ctx, &sapb.RemoveBlockedNameRequest{Id: id, Remover: u.Username})
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Removed blocked name with ID %d, remover=[%s]", id, u.Username)
+	return nil
+}
+
+// printBlockedNames writes the hostname blocklist entries whose names contain
+// search to w, one per line.
+func (r *revoker) printBlockedNames(ctx context.Context, search string, includeExpired, includeRemoved bool, w io.Writer) error {
+	resp, err := r.sac.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{
+		Search:         search,
+		IncludeExpired: includeExpired,
+		IncludeRemoved: includeRemoved,
+	})
+	if err != nil {
+		return err
+	}
+	for _, bn := range resp.Names {
+		_, err = fmt.Fprintln(w, formatBlockedName(bn))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatBlockedName(bn *sapb.BlockedName) string {
+	expires := "never"
+	if bn.Expires != nil {
+		expires = bn.Expires.AsTime().Format(time.RFC3339)
+	}
+	s := fmt.Sprintf("id=[%d] name=[%s] type=[%s] reason=[%s] creator=[%s] created=[%s] expires=[%s]",
+		bn.Id, bn.Name, bn.BlockType, bn.Reason, bn.Creator, bn.Created.AsTime().Format(time.RFC3339), expires)
+	if bn.Removed != nil {
+		s += fmt.Sprintf(" removed=[%s] removedBy=[%s]", bn.Removed.AsTime().Format(time.RFC3339), bn.RemovedBy)
+	}
+	return s
+}
+
+// printOrderReviews writes the order reviews with the given status, or all
+// order reviews if status is "", to w, one per line.
+func (r *revoker) printOrderReviews(ctx context.Context, status string, w io.Writer) error {
+	resp, err := r.sac.GetOrderReviews(ctx, &sapb.GetOrderReviewsRequest{Status: status})
+	if err != nil {
+		return err
+	}
+	for _, review := range resp.Reviews {
+		_, err = fmt.Fprintln(w, formatOrderReview(review))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reviewOrder approves or denies an order queued for manual review, recording
+// the current user as the reviewer.
+func (r *revoker) reviewOrder(ctx context.Context, orderID int64, approved bool, reason string) (*sapb.OrderReview, error) {
+	if reason == "" {
+		return nil, errors.New("a reason is required to review an order")
+	}
+	u, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+	review, err := r.rac.ReviewOrder(ctx, &rapb.ReviewOrderRequest{
+		OrderID:  orderID,
+		Approved: approved,
+		Reviewer: u.Username,
+		Reason:   reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.AuditInfof("Reviewed order: %s", formatOrderReview(review))
+	return review, nil
+}
+
+func formatOrderReview(review *sapb.OrderReview) string {
+	reasons := make([]string, len(review.Reasons))
+	for i, reason := range review.Reasons {
+		reasons[i] = fmt.Sprintf("%s: %s", reason.Name, reason.Rule)
+	}
+	line := fmt.Sprintf("order=[%d] account=[%d] status=[%s] created=[%s] reasons=[%s]",
+		review.OrderID, review.RegistrationID, review.Status,
+		review.Created.AsTime().Format(time.RFC3339), strings.Join(reasons, ", "))
+	if review.Decided != nil {
+		line += fmt.Sprintf(" reviewer=[%s] decided=[%s] reason=[%s]",
+			review.Reviewer, review.Decided.AsTime().Format(time.RFC3339), review.DecisionReason)
+	}
+	return line
+}
+
 func (r *revoker) revokeIncidentTableSerials(ctx context.Context, tableName string, reasonCode revocation.Reason, parallelism int) error {
 	wg := new(sync.WaitGroup)
 	work := make(chan string, parallelism)
@@ -555,6 +757,12 @@ func main() {
 		"true (default): only queries for affected certificates. false: will perform the requested block or revoke action",
 	)
 	comment := flagSet.String("comment", "", "Comment to include in the blocked key database entry ")
+	blockType := flagSet.String("type", "exact", "Type of hostname block: exact, highRisk, or admin")
+	reason := flagSet.String("reason", "", "Reason to record with the blocked name entry or order review decision")
+	expires := flagSet.Duration("expires", 0, "How long the blocked name entry lasts. 0 means it never expires")
+	includeExpired := flagSet.Bool("include-expired", false, "Also list blocked name entries which have expired")
+	includeRemoved := flagSet.Bool("include-removed", false, "Also list blocked name entries which have been removed")
+	reviewStatus := flagSet.String("status", "pending", "Status of the order reviews to list: pending, approved, denied, or \"\" for all")
 	err := flagSet.Parse(os.Args[2:])
 	if err == flag.ErrHelp {
 		os.Exit(1)
@@ -675,6 +883,43 @@ func main() {
 		err := r.clearEmailAddress(ctx, email)
 		cmd.FailOnError(err, "Clearing email address")
 
+	case command == "validation-perspectives" && len(args) == 1:
+		// 1: authzID
+		authzID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Authorization ID argument must be an integer")
+		err = r.printValidationPerspectives(ctx, authzID, os.Stdout)
+		cmd.FailOnError(err, "Couldn't get validation perspectives")
+
+	case command == "block-name" && len(args) == 1:
+		// 1: name
+		bn, err := r.blockName(ctx, args[0], *blockType, *reason, *expires)
+		cmd.FailOnError(err, "Couldn't block name")
+		fmt.Println(formatBlockedName(bn))
+
+	case command == "unblock-name" && len(args) == 1:
+		// 1: blocked name ID
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Blocked name ID argument must be an integer")
+		err = r.unblockName(ctx, id)
+		cmd.FailOnError(err, "Couldn't unblock name")
+
+	case command == "search-blocked-names" && len(args) == 1:
+		// 1: substring
+		err := r.printBlockedNames(ctx, args[0], *includeExpired, *includeRemoved, os.Stdout)
+		cmd.FailOnError(err, "Couldn't search blocked names")
+
+	case command == "list-reviews" && len(args) == 0:
+		err := r.printOrderReviews(ctx, *reviewStatus, os.Stdout)
+		cmd.FailOnError(err, "Couldn't list order reviews")
+
+	case (command == "approve-order" || command == "deny-order") && len(args) == 1:
+		// 1: order ID
+		orderID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Order ID argument must be an integer")
+		review, err := r.reviewOrder(ctx, orderID, command == "approve-order", *reason)
+		cmd.FailOnError(err, "Couldn't review order")
+		fmt.Println(formatOrderReview(review))
+
 	default:
 		fmt.Fprintf(os.Stderr, "unrecognized subcommand %q\n\n", command)
 		usage()
@@ -13,6 +13,7 @@ import (
 	"net"
 	"os"
 	"os/user"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/mocks"
 	"github.com/letsencrypt/boulder/ra"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
@@ -36,6 +38,7 @@ import (
 	"github.com/letsencrypt/boulder/test/vars"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type mockOCSPA struct {
@@ -328,6 +331,103 @@ func TestPrivateKeyRevoke(t *testing.T) {
 	test.AssertEquals(t, commentFromDB, expectedDBComment)
 }
 
+type mockSAWithPerspectives struct {
+	mocks.StorageAuthority
+	perspectives []*corepb.PerspectiveResult
+}
+
+func (sa *mockSAWithPerspectives) GetValidationPerspectives(_ context.Context, req *sapb.AuthorizationID2, _ ...grpc.CallOption) (*sapb.ValidationPerspectives, error) {
+	if req.Id != 1234 {
+		return &sapb.ValidationPerspectives{}, nil
+	}
+	return &sapb.ValidationPerspectives{Perspectives: sa.perspectives}, nil
+}
+
+func TestPrintValidationPerspectives(t *testing.T) {
+	r := revoker{sac: &mockSAWithPerspectives{
+		perspectives: []*corepb.PerspectiveResult{
+			{Perspective: "primary", Rir: "ARIN", Success: true},
+			{Perspective: "remoteA", Rir: "RIPE", Problem: &corepb.ProblemDetails{ProblemType: "connection", Detail: "timeout"}},
+		},
+	}}
+
+	var out strings.Builder
+	err := r.printValidationPerspectives(context.Background(), 1234, &out)
+	test.AssertNotError(t, err, "printing validation perspectives")
+	test.AssertEquals(t, out.String(), "perspective=[primary] rir=[ARIN] result=[success]\n"+
+		"perspective=[remoteA] rir=[RIPE] result=[failure] problem=[connection: timeout]\n")
+
+	err = r.printValidationPerspectives(context.Background(), 1, &out)
+	test.AssertError(t, err, "printed validation perspectives for authorization without any")
+}
+
+// mockSAWithBlockedNames is a StorageAuthority which keeps blocked names in
+// memory.
+type mockSAWithBlockedNames struct {
+	mocks.StorageAuthority
+	names []*sapb.BlockedName
+}
+
+func (sa *mockSAWithBlockedNames) AddBlockedName(ctx context.Context, req *sapb.AddBlockedNameRequest, _ ...grpc.CallOption) (*sapb.BlockedName, error) {
+	bn, err := sa.StorageAuthority.AddBlockedName(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	bn.Id = int64(len(sa.names) + 1)
+	sa.names = append(sa.names, bn)
+	return bn, nil
+}
+
+func (sa *mockSAWithBlockedNames) GetBlockedNames(_ context.Context, req *sapb.GetBlockedNamesRequest, _ ...grpc.CallOption) (*sapb.BlockedNames, error) {
+	var names []*sapb.BlockedName
+	for _, bn := range sa.names {
+		if strings.Contains(bn.Name, req.Search) {
+			names = append(names, bn)
+		}
+	}
+	return &sapb.BlockedNames{Names: names}, nil
+}
+
+func TestBlockName(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC))
+	log := blog.NewMock()
+	msa := &mockSAWithBlockedNames{StorageAuthority: *mocks.NewStorageAuthority(fc)}
+	r := revoker{sac: msa, clk: fc, log: log}
+
+	_, err := r.blockName(context.Background(), "example.com", "exact", "", 0)
+	test.AssertError(t, err, "blocked a name without a reason")
+
+	// Only names we could otherwise issue for can be blocked, so that a typo
+	// can't block a TLD or public suffix along with all of its subdomains.
+	for _, name := range []string{"com", "co.uk", "*.example.com", "example.invalid", "exa mple.com", "192.168.1.1"} {
+		_, err = r.blockName(context.Background(), name, "highRisk", "typo", 0)
+		test.AssertError(t, err, fmt.Sprintf("blocked invalid name %q", name))
+	}
+	test.AssertEquals(t, len(msa.names), 0)
+
+	bn, err := r.blockName(context.Background(), "WWW.example.com", "exact", "phishing", 0)
+	test.AssertNotError(t, err, "blocking name")
+	u, err := user.Current()
+	test.AssertNotError(t, err, "getting current user")
+	test.AssertEquals(t, bn.Creator, u.Username)
+	test.Assert(t, bn.Expires == nil, "unexpired block has an expiry")
+	test.AssertEquals(t, len(log.GetAllMatching(`Blocked name: id=\[1\] name=\[www.example.com\]`)), 1)
+
+	_, err = r.blockName(context.Background(), "example.net", "highRisk", "takedown", 24*time.Hour)
+	test.AssertNotError(t, err, "blocking name")
+
+	var out strings.Builder
+	err = r.printBlockedNames(context.Background(), "example.net", false, false, &out)
+	test.AssertNotError(t, err, "printing blocked names")
+	test.AssertEquals(t, out.String(), fmt.Sprintf("id=[2] name=[example.net] type=[highRisk] reason=[takedown] "+
+		"creator=[%s] created=[2023-07-02T00:00:00Z] expires=[2023-07-03T00:00:00Z]\n", u.Username))
+
+	err = r.unblockName(context.Background(), 1)
+	test.AssertNotError(t, err, "unblocking name")
+	test.AssertEquals(t, len(log.GetAllMatching(`Removed blocked name with ID 1, remover=\[`+u.Username+`\]`)), 1)
+}
+
 type entry struct {
 	jwk      string
 	serial   *big.Int
@@ -493,3 +593,78 @@ func setup(t *testing.T) testCtx {
 		log:     log,
 	}
 }
+
+// mockSAWithOrderReviews is a StorageAuthority which returns a single pending
+// order review.
+type mockSAWithOrderReviews struct {
+	mocks.StorageAuthority
+}
+
+func (sa *mockSAWithOrderReviews) GetOrderReviews(_ context.Context, req *sapb.GetOrderReviewsRequest, _ ...grpc.CallOption) (*sapb.OrderReviews, error) {
+	if req.Status != "" && req.Status != "pending" {
+		return &sapb.OrderReviews{}, nil
+	}
+	return &sapb.OrderReviews{Reviews: []*sapb.OrderReview{{
+		Id:             1,
+		OrderID:        10,
+		RegistrationID: 5,
+		Reasons: []*sapb.OrderReviewReason{
+			{Name: "bank.example", Rule: "ReviewRequiredNames:bank.example"},
+			{Name: "www.bank.example", Rule: "ReviewRequiredNames:bank.example"},
+		},
+		Status:  "pending",
+		Created: timestamppb.New(time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC)),
+	}}}, nil
+}
+
+// mockRAWithOrderReviews is a RegistrationAuthorityClient which decides order
+// reviews as asked.
+type mockRAWithOrderReviews struct {
+	rapb.RegistrationAuthorityClient
+}
+
+func (ra *mockRAWithOrderReviews) ReviewOrder(_ context.Context, req *rapb.ReviewOrderRequest, _ ...grpc.CallOption) (*sapb.OrderReview, error) {
+	status := "denied"
+	if req.Approved {
+		status = "approved"
+	}
+	return &sapb.OrderReview{
+		Id:             1,
+		OrderID:        req.OrderID,
+		RegistrationID: 5,
+		Status:         status,
+		Created:        timestamppb.New(time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC)),
+		Reviewer:       req.Reviewer,
+		DecisionReason: req.Reason,
+		Decided:        timestamppb.New(time.Date(2023, 7, 3, 1, 0, 0, 0, time.UTC)),
+	}, nil
+}
+
+func TestOrderReviews(t *testing.T) {
+	fc := clock.NewFake()
+	log := blog.NewMock()
+	r := revoker{
+		rac: &mockRAWithOrderReviews{},
+		sac: &mockSAWithOrderReviews{StorageAuthority: *mocks.NewStorageAuthority(fc)},
+		clk: fc,
+		log: log,
+	}
+
+	var out strings.Builder
+	err := r.printOrderReviews(context.Background(), "pending", &out)
+	test.AssertNotError(t, err, "printing order reviews")
+	test.AssertEquals(t, out.String(), "order=[10] account=[5] status=[pending] created=[2023-07-03T00:00:00Z] "+
+		"reasons=[bank.example: ReviewRequiredNames:bank.example, www.bank.example: ReviewRequiredNames:bank.example]\n")
+
+	_, err = r.reviewOrder(context.Background(), 10, true, "")
+	test.AssertError(t, err, "reviewed an order without a reason")
+
+	review, err := r.reviewOrder(context.Background(), 10, false, "phishing")
+	test.AssertNotError(t, err, "denying order")
+	u, err := user.Current()
+	test.AssertNotError(t, err, "getting current user")
+	test.AssertEquals(t, review.Reviewer, u.Username)
+	test.AssertEquals(t, formatOrderReview(review), fmt.Sprintf("order=[10] account=[5] status=[denied] "+
+		"created=[2023-07-03T00:00:00Z] reasons=[] reviewer=[%s] decided=[2023-07-03T01:00:00Z] reason=[phishing]", u.Username))
+	test.AssertEquals(t, len(log.GetAllMatching(`Reviewed order: order=\[10\] account=\[5\] status=\[denied\]`)), 1)
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/goodkey/sagoodkey"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/linter"
+	"github.com/letsencrypt/boulder/pkcs11helpers"
 	"github.com/letsencrypt/boulder/policy"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
@@ -96,6 +98,17 @@ type Config struct {
 		// not end with a slash. Example: "http://prod.c.lencr.org".
 		CRLDPBase string `validate:"required,url,startswith=http://,endsnotwith=/"`
 
+		// HSMHealthCheckInterval, if non-zero, causes the CA to check at most
+		// once per interval that each issuer's key can still be found in its
+		// HSM, and to report all of its gRPC services as unhealthy while any
+		// key is failing its check. The issuer keys themselves are never used
+		// to sign for these checks. Instead, an issuer whose location sets
+		// HealthCheckKeyLabel also has the HSM sign and verify a random digest
+		// with that dedicated key. Without one, only the key lookup is checked,
+		// so an HSM which can find keys but can no longer sign goes unnoticed.
+		// Issuers whose keys are loaded from files aren't checked.
+		HSMHealthCheckInterval config.Duration `validate:"-"`
+
 		// DisableCertService causes the CertificateAuthority gRPC service to not
 		// start, preventing any certificates or precertificates from being issued.
 		DisableCertService bool
@@ -115,7 +128,7 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
-func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []issuance.IssuerConfig, ignoredLints []string) ([]*issuance.Issuer, error) {
+func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []issuance.IssuerConfig, ignoredLints []string, stats prometheus.Registerer) ([]*issuance.Issuer, error) {
 	issuers := make([]*issuance.Issuer, 0, len(issuerConfigs))
 	for _, issuerConfig := range issuerConfigs {
 		profile, err := issuance.NewProfile(profileConfig, issuerConfig)
@@ -123,7 +136,7 @@ func loadBoulderIssuers(profileConfig issuance.ProfileConfig, issuerConfigs []is
 			return nil, err
 		}
 
-		cert, signer, err := issuance.LoadIssuer(issuerConfig.Location)
+		cert, signer, err := issuance.LoadIssuer(issuerConfig.Location, stats)
 		if err != nil {
 			return nil, err
 		}
@@ -196,6 +209,12 @@ func main() {
 
 	pa, err := policy.New(c.PA.Challenges, logger)
 	cmd.FailOnError(err, "Couldn't create PA")
+	if c.PA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(c.PA.PublicSuffixListFile, scope, logger)
+		cmd.FailOnError(err, "Couldn't load public suffix list file")
+	}
+	err = c.PA.IANARegistries.Load()
+	cmd.FailOnError(err, "Couldn't load IANA registries")
 
 	if c.CA.HostnamePolicyFile == "" {
 		cmd.Fail("HostnamePolicyFile was empty")
@@ -211,7 +230,7 @@ func main() {
 	}
 
 	var boulderIssuers []*issuance.Issuer
-	boulderIssuers, err = loadBoulderIssuers(c.CA.Issuance.Profile, c.CA.Issuance.Issuers, c.CA.Issuance.IgnoredLints)
+	boulderIssuers, err = loadBoulderIssuers(c.CA.Issuance.Profile, c.CA.Issuance.Issuers, c.CA.Issuance.IgnoredLints, scope)
 	cmd.FailOnError(err, "Couldn't load issuers")
 
 	tlsConfig, err := c.CA.TLS.Load(scope)
@@ -250,7 +269,19 @@ func main() {
 		logger.Infof("Created a reloadable allow list, it was initialized with %d entries", entries)
 	}
 
-	srv := bgrpc.NewServer(c.CA.GRPCCA, logger)
+	srv := bgrpc.NewServer(c.CA.GRPCCA, logger).WithCheckInterval(c.CA.HealthCheckInterval.Duration)
+
+	if c.CA.HSMHealthCheckInterval.Duration > 0 {
+		keys := make(map[string]pkcs11helpers.HealthChecker, len(boulderIssuers))
+		for _, issuer := range boulderIssuers {
+			checker, ok := issuer.Signer.(pkcs11helpers.HealthChecker)
+			if ok {
+				keys[issuer.Cert.Subject.CommonName] = checker
+			}
+		}
+		keyHealth := pkcs11helpers.NewKeyHealth(keys, c.CA.HSMHealthCheckInterval.Duration, scope, clk)
+		srv = srv.WithHealthCheck(keyHealth.Health)
+	}
 
 	if !c.CA.DisableOCSPService {
 		ocspi, err := ca.NewOCSPImpl(
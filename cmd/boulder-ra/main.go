@@ -17,6 +17,7 @@ import (
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/goodkey/sagoodkey"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/policy"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
@@ -70,6 +71,18 @@ type Config struct {
 		// complete.
 		FinalizeTimeout config.Duration `validate:"-"`
 
+		// BlockedNamesRefreshInterval is how often hostname blocklist entries
+		// are loaded from the database, in addition to those in the
+		// HostnamePolicyFile. If zero, no entries are loaded from the database.
+		BlockedNamesRefreshInterval config.Duration `validate:"-"`
+
+		// ConfusableNameAction is what to do with names in new orders which
+		// are visually confusable with a protected name in the hostname
+		// policy: "reject" refuses them, and "review" holds their orders for
+		// manual review by an operator (or, without the OrderReviews feature,
+		// only audit logs them). If empty, names aren't checked.
+		ConfusableNameAction string `validate:"omitempty,oneof=reject review"`
+
 		// CTLogs contains groupings of CT logs organized by what organization
 		// operates them. When we submit precerts to logs in order to get SCTs, we
 		// will submit the cert to one randomly-chosen log from each group, and use
@@ -133,12 +146,22 @@ func main() {
 
 	pa, err := policy.New(c.PA.Challenges, logger)
 	cmd.FailOnError(err, "Couldn't create PA")
+	if c.PA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(c.PA.PublicSuffixListFile, scope, logger)
+		cmd.FailOnError(err, "Couldn't load public suffix list file")
+	}
+	err = c.PA.IANARegistries.Load()
+	cmd.FailOnError(err, "Couldn't load IANA registries")
 
 	if c.RA.HostnamePolicyFile == "" {
 		cmd.Fail("HostnamePolicyFile must be provided.")
 	}
 	err = pa.SetHostnamePolicyFile(c.RA.HostnamePolicyFile)
 	cmd.FailOnError(err, "Couldn't load hostname policy file")
+	if c.PA.ChallengeRulesFile != "" {
+		err = pa.SetChallengeRulesFile(c.PA.ChallengeRulesFile)
+		cmd.FailOnError(err, "Couldn't load challenge rules file")
+	}
 
 	tlsConfig, err := c.RA.TLS.Load(scope)
 	cmd.FailOnError(err, "TLS config")
@@ -162,6 +185,12 @@ func main() {
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
 	sac := sapb.NewStorageAuthorityClient(saConn)
 
+	if c.RA.BlockedNamesRefreshInterval.Duration > 0 {
+		stop, err := pa.SetBlockedNamesSource(sac, c.RA.BlockedNamesRefreshInterval.Duration)
+		cmd.FailOnError(err, "Couldn't load blocked names from database")
+		defer stop()
+	}
+
 	conn, err := bgrpc.ClientSetup(c.RA.PublisherService, tlsConfig, scope, clk)
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to Publisher")
 	pubc := pubpb.NewPublisherClient(conn)
@@ -251,6 +280,8 @@ func main() {
 	policyErr := rai.SetRateLimitPoliciesFile(c.RA.RateLimitPoliciesFilename)
 	cmd.FailOnError(policyErr, "Couldn't load rate limit policies file")
 	rai.PA = pa
+	err = rai.SetConfusableNameAction(c.RA.ConfusableNameAction)
+	cmd.FailOnError(err, "Invalid confusable name action")
 
 	rai.VA = vac
 	rai.CA = cac
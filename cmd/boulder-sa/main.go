@@ -9,6 +9,7 @@ import (
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
@@ -27,6 +28,12 @@ type Config struct {
 		// LagFactor is how long to sleep before retrying a read request that may
 		// have failed solely due to replication lag.
 		LagFactor config.Duration `validate:"-"`
+
+		// PublicSuffixListFile is the path to a public_suffix_list.dat file to
+		// use in place of the Public Suffix List compiled into Boulder when
+		// counting certificates per registered domain. It should be the same
+		// as the RA's. It's reloaded when it changes. Optional.
+		PublicSuffixListFile string `validate:"-"`
 	}
 
 	Syslog        cmd.SyslogConfig
@@ -62,6 +69,11 @@ func main() {
 	defer logger.AuditPanic()
 	logger.Info(cmd.VersionString())
 
+	if c.SA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(c.SA.PublicSuffixListFile, scope, logger)
+		cmd.FailOnError(err, "Couldn't load public suffix list file")
+	}
+
 	dbMap, err := sa.InitWrappedDb(c.SA.DB, scope, logger)
 	cmd.FailOnError(err, "While initializing dbMap")
 
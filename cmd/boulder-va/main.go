@@ -2,16 +2,28 @@ package notmain
 
 import (
 	"context"
+	"crypto/x509"
 	"flag"
+	"fmt"
+	"net/http"
+	netmail "net/mail"
 	"os"
 	"time"
 
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
 	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
+	bmail "github.com/letsencrypt/boulder/mail"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/va"
+	"github.com/letsencrypt/boulder/va/evidence"
+	"github.com/letsencrypt/boulder/va/iodef"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 )
 
@@ -23,6 +35,12 @@ type Config struct {
 
 		IssuerDomain string
 
+		// Perspective names this VA in the per-perspective results it
+		// returns, and RIR is the Regional Internet Registry or region in
+		// which it's located. Perspective defaults to the hostname.
+		Perspective string `validate:"omitempty,hostname"`
+		RIR         string
+
 		// DNSTries is the number of times to try a DNS query (that has a temporary error)
 		// before giving up. May be short-circuited by deadlines. A zero value
 		// will be turned into 1.
@@ -31,9 +49,127 @@ type Config struct {
 		DNSProvider               *cmd.DNSProvider `validate:"required_without=DNSResolver,excluded_with=DNSResolver,omitempty"`
 		DNSTimeout                config.Duration  `validate:"required"`
 		DNSAllowLoopbackAddresses bool
+		// IANARegistries are loaded in place of the special-purpose registry
+		// data compiled into Boulder, and determine the IP addresses the VA
+		// won't connect to and the special-use domain names it won't follow
+		// redirects to. They should be the same as the RA's.
+		IANARegistries cmd.IANARegistriesConfig
+		// PublicSuffixListFile is the path to a public_suffix_list.dat file to
+		// use in place of the Public Suffix List compiled into Boulder, when
+		// finding the registered domains of CNAME targets and of names for
+		// validation rate limits. It should be the same as the RA's. It's
+		// reloaded when it changes. Optional.
+		PublicSuffixListFile string `validate:"-"`
+		// DNSSEC configures validation of DNSSEC by the VA itself, instead of
+		// relying on the upstream resolvers to validate responses.
+		DNSSEC struct {
+			Enabled bool
+			// TrustAnchors are DS records for the root zone, in presentation
+			// format. If empty, the IANA root KSK-2017 trust anchor is used.
+			TrustAnchors []string
+		}
+		// DNSServerHealth configures outlier detection for the DNS servers
+		// given by DNSProvider or DNSResolver. When enabled, queries prefer the
+		// fastest servers, and servers whose rate of timeouts and SERVFAIL
+		// responses exceeds MaxFailureRate are ejected for a time. The state of
+		// each server is published on the debug server at /debug/vars. Zero
+		// values are replaced by defaults.
+		DNSServerHealth struct {
+			Enabled           bool
+			MaxFailureRate    float64         `validate:"min=0,max=1"`
+			MinQueries        int             `validate:"min=0"`
+			EjectionTime      config.Duration `validate:"-"`
+			MaxEjectionTime   config.Duration `validate:"-"`
+			MaxEjectedPercent int             `validate:"min=0,max=100"`
+		}
+		// DNSCache configures an in-memory cache of DNS answers, shared by all
+		// validations. Answers are cached for their TTL, capped at MaxTTL, or
+		// for empty answers at MaxNegativeTTL. DNS-01 challenge records are
+		// never cached. The cache is disabled if MaxEntries is zero.
+		DNSCache struct {
+			MaxEntries     int             `validate:"min=0"`
+			MaxTTL         config.Duration `validate:"-"`
+			MaxNegativeTTL config.Duration `validate:"-"`
+		}
 
 		RemoteVAs                   []cmd.GRPCClientConfig `validate:"omitempty,dive"`
 		MaxRemoteValidationFailures int
+		// MaxRemoteCAAFailures is the number of remote VAs whose CAA checks may
+		// fail before the primary VA considers the overall CAA check a failure.
+		// It only takes effect when the EnforceMultiCAA feature is enabled.
+		MaxRemoteCAAFailures int
+		// SAService, if set, is used to store the results of remote VAs which
+		// only report after a validation's outcome has been decided, so that
+		// every perspective's result is recorded. Only used by a primary VA,
+		// with remoteVAs.
+		SAService *cmd.GRPCClientConfig
+
+		// CNAMEPolicies restrict the CNAME and DNAME chains which DNS-01
+		// validations for selected accounts may follow from the challenge
+		// record.
+		CNAMEPolicies []va.CNAMEPolicy `validate:"omitempty,dive"`
+
+		// ConcurrencyLimits bound the number of HTTP-01 and TLS-ALPN-01
+		// validations performed at once against a single IP address or
+		// registered domain. Validations over a limit wait for a free slot
+		// until their deadline. If unset, there are no limits.
+		ConcurrencyLimits va.ConcurrencyLimits
+
+		// EgressProxy, if set, is the URL of a proxy through which HTTP-01
+		// and TLS-ALPN-01 validation connections are made, of the form
+		// socks5://host:port or http://host:port (for HTTP CONNECT), with
+		// optional credentials. The proxy is always given the IP address
+		// resolved by the VA, never a hostname.
+		EgressProxy string `validate:"omitempty,url"`
+
+		// IODEF configures incident reports, sent when CAA forbids issuance
+		// to the iodef URLs published with the CAA records. Reports are
+		// emailed to mailto: URLs if SMTP is configured, and POSTed to
+		// https: URLs. Reports about the records under any one registered
+		// domain, and reports to any one email address or HTTPS host, are
+		// each sent at most once per MinInterval (default 24h). Reports can
+		// only be enabled on a primary VA, i.e. one with RemoteVAs, since
+		// remote VAs would each send their own.
+		IODEF struct {
+			Enabled bool
+			SMTP    *cmd.SMTPConfig
+			// From is an RFC 5322 formatted "From" address for emailed
+			// reports, e.g. "Example <example@test.org>".
+			From string `validate:"required_with=SMTP"`
+			// SMTPTrustedRootFile is a path to a PEM file of roots trusted
+			// for the SMTP connection. If unset, the system roots are used.
+			SMTPTrustedRootFile string
+			MinInterval         config.Duration `validate:"-"`
+			HTTPTimeout         config.Duration `validate:"-"`
+			QueueSize           int             `validate:"min=0"`
+			Workers             int             `validate:"min=0"`
+		}
+
+		// Evidence configures archiving of the raw DNS responses, HTTP
+		// responses and TLS-ALPN-01 certificates seen during each validation,
+		// for audits and incident investigations. Evidence is written either
+		// to files under Directory or to objects in S3Bucket, keyed by
+		// authorization ID and perspective. If neither is set, no evidence is
+		// archived.
+		Evidence struct {
+			// Perspective names this VA in the evidence it archives. Defaults
+			// to the VA's Perspective.
+			Perspective string `validate:"omitempty,hostname"`
+			Directory   string `validate:"excluded_with=S3Bucket"`
+			// S3Endpoint is the URL at which the S3-API-compatible object
+			// storage service can be reached. It should be left blank to use
+			// Amazon S3.
+			S3Endpoint string
+			S3Bucket   string
+			// S3Prefix is prepended to the key of each object.
+			S3Prefix string
+			// AWSConfigFile and AWSCredsFile are paths to files on disk
+			// containing an AWS config and credentials respectively, in the
+			// format specified at
+			// https://docs.aws.amazon.com/sdkref/latest/guide/file-format.html.
+			AWSConfigFile string
+			AWSCredsFile  string
+		}
 
 		Features map[string]bool
 
@@ -73,6 +209,14 @@ func main() {
 	defer logger.AuditPanic()
 	logger.Info(cmd.VersionString())
 
+	err = c.VA.IANARegistries.Load()
+	cmd.FailOnError(err, "Couldn't load IANA registries")
+
+	if c.VA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(c.VA.PublicSuffixListFile, scope, logger)
+		cmd.FailOnError(err, "Couldn't load public suffix list file")
+	}
+
 	if c.VA.DNSTimeout.Duration == 0 {
 		cmd.Fail("'dnsTimeout' is required")
 	}
@@ -105,6 +249,9 @@ func main() {
 	cmd.FailOnError(err, "Couldn't start dynamic DNS server resolver")
 	defer servers.Stop()
 
+	resolverTLSConfig, err := c.VA.DNSProvider.LoadTLSConfig()
+	cmd.FailOnError(err, "Couldn't load DNS provider TLS config")
+
 	var resolver bdns.Client
 	if !c.VA.DNSAllowLoopbackAddresses {
 		resolver = bdns.New(
@@ -113,7 +260,8 @@ func main() {
 			scope,
 			clk,
 			dnsTries,
-			logger)
+			logger,
+			resolverTLSConfig)
 	} else {
 		resolver = bdns.NewTest(
 			c.VA.DNSTimeout.Duration,
@@ -121,7 +269,33 @@ func main() {
 			scope,
 			clk,
 			dnsTries,
-			logger)
+			logger,
+			resolverTLSConfig)
+	}
+	if c.VA.DNSSEC.Enabled {
+		err = bdns.EnableDNSSEC(resolver, c.VA.DNSSEC.TrustAnchors)
+		cmd.FailOnError(err, "Couldn't enable DNSSEC validation")
+	}
+	if c.VA.DNSServerHealth.Enabled {
+		err = bdns.EnableServerHealth(resolver, bdns.HealthConfig{
+			MaxFailureRate:    c.VA.DNSServerHealth.MaxFailureRate,
+			MinQueries:        c.VA.DNSServerHealth.MinQueries,
+			EjectionTime:      c.VA.DNSServerHealth.EjectionTime.Duration,
+			MaxEjectionTime:   c.VA.DNSServerHealth.MaxEjectionTime.Duration,
+			MaxEjectedPercent: c.VA.DNSServerHealth.MaxEjectedPercent,
+		})
+		cmd.FailOnError(err, "Couldn't enable DNS server health tracking")
+		err = bdns.PublishServerHealth(resolver, "dnsServers")
+		cmd.FailOnError(err, "Couldn't publish DNS server health")
+	}
+	if c.VA.DNSCache.MaxEntries > 0 {
+		resolver = bdns.NewCache(
+			resolver,
+			c.VA.DNSCache.MaxEntries,
+			c.VA.DNSCache.MaxTTL.Duration,
+			c.VA.DNSCache.MaxNegativeTTL.Duration,
+			clk,
+			scope)
 	}
 
 	tlsConfig, err := c.VA.TLS.Load(scope)
@@ -136,23 +310,139 @@ func main() {
 			remotes = append(
 				remotes,
 				va.RemoteVA{
-					VAClient: vapb.NewVAClient(vaConn),
-					Address:  rva.ServerAddress,
+					RemoteClients: va.RemoteClients{
+						VAClient:  vapb.NewVAClient(vaConn),
+						CAAClient: vapb.NewCAAClient(vaConn),
+					},
+					Address: rva.ServerAddress,
 				},
 			)
 		}
 	}
 
+	var sac sapb.StorageAuthorityClient
+	if c.VA.SAService != nil {
+		if len(c.VA.RemoteVAs) == 0 {
+			cmd.Fail("'saService' can only be configured on a primary VA, with 'remoteVAs'")
+		}
+		saConn, err := bgrpc.ClientSetup(c.VA.SAService, tlsConfig, scope, clk)
+		cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+		sac = sapb.NewStorageAuthorityClient(saConn)
+	}
+
+	perspective := c.VA.Perspective
+	if perspective == "" {
+		perspective, err = os.Hostname()
+		cmd.FailOnError(err, "Unable to get hostname for perspective")
+	}
+
+	var evidenceArchive *evidence.Archive
+	if c.VA.Evidence.Directory != "" || c.VA.Evidence.S3Bucket != "" {
+		var store evidence.Store
+		if c.VA.Evidence.Directory != "" {
+			store, err = evidence.NewFileStore(c.VA.Evidence.Directory)
+			cmd.FailOnError(err, "Unable to create evidence store")
+		} else {
+			// Load the "default" AWS configuration, but override the set of
+			// config and credential files it reads from to just those
+			// specified in our JSON config, to ensure that it's not
+			// accidentally reading anything from the homedir or its other
+			// default config locations.
+			awsConfig, err := awsconfig.LoadDefaultConfig(
+				context.Background(),
+				awsconfig.WithSharedConfigFiles([]string{c.VA.Evidence.AWSConfigFile}),
+				awsconfig.WithSharedCredentialsFiles([]string{c.VA.Evidence.AWSCredsFile}),
+				awsconfig.WithHTTPClient(new(http.Client)),
+			)
+			cmd.FailOnError(err, "Failed to load AWS config")
+			s3opts := make([]func(*s3.Options), 0)
+			if c.VA.Evidence.S3Endpoint != "" {
+				s3opts = append(
+					s3opts,
+					s3.WithEndpointResolver(s3.EndpointResolverFromURL(c.VA.Evidence.S3Endpoint)),
+					func(o *s3.Options) { o.UsePathStyle = true },
+				)
+			}
+			store = evidence.NewS3Store(s3.NewFromConfig(awsConfig, s3opts...), c.VA.Evidence.S3Bucket, c.VA.Evidence.S3Prefix)
+		}
+		evidencePerspective := c.VA.Evidence.Perspective
+		if evidencePerspective == "" {
+			evidencePerspective = perspective
+		}
+		evidenceArchive = evidence.NewArchive(store, evidencePerspective, clk, scope, logger)
+	}
+
+	var iodefReporter *iodef.Reporter
+	if c.VA.IODEF.Enabled {
+		if len(c.VA.RemoteVAs) == 0 {
+			cmd.Fail("'iodef' can only be enabled on a primary VA, with 'remoteVAs'")
+		}
+		var mailer bmail.Mailer
+		if c.VA.IODEF.SMTP != nil {
+			var smtpRoots *x509.CertPool
+			if c.VA.IODEF.SMTPTrustedRootFile != "" {
+				pem, err := os.ReadFile(c.VA.IODEF.SMTPTrustedRootFile)
+				cmd.FailOnError(err, "Loading trusted roots file")
+				smtpRoots = x509.NewCertPool()
+				if !smtpRoots.AppendCertsFromPEM(pem) {
+					cmd.FailOnError(nil, "Failed to parse root certs PEM")
+				}
+			}
+			fromAddress, err := netmail.ParseAddress(c.VA.IODEF.From)
+			cmd.FailOnError(err, fmt.Sprintf("Could not parse from address: %s", c.VA.IODEF.From))
+			smtpPassword, err := c.VA.IODEF.SMTP.PasswordConfig.Pass()
+			cmd.FailOnError(err, "Failed to load SMTP password")
+			mailer = bmail.New(
+				c.VA.IODEF.SMTP.Server,
+				c.VA.IODEF.SMTP.Port,
+				c.VA.IODEF.SMTP.Username,
+				smtpPassword,
+				smtpRoots,
+				*fromAddress,
+				logger,
+				scope,
+				time.Second,
+				time.Minute)
+		}
+		minInterval := c.VA.IODEF.MinInterval.Duration
+		if minInterval == 0 {
+			minInterval = 24 * time.Hour
+		}
+		httpTimeout := c.VA.IODEF.HTTPTimeout.Duration
+		if httpTimeout == 0 {
+			httpTimeout = 10 * time.Second
+		}
+		queueSize := c.VA.IODEF.QueueSize
+		if queueSize == 0 {
+			queueSize = 100
+		}
+		workers := c.VA.IODEF.Workers
+		if workers == 0 {
+			workers = 1
+		}
+		iodefReporter = iodef.New(c.VA.IssuerDomain, mailer, iodef.NewHTTPClient(httpTimeout), minInterval,
+			queueSize, workers, clk, scope, logger)
+	}
+
 	vai, err := va.NewValidationAuthorityImpl(
 		resolver,
 		remotes,
 		c.VA.MaxRemoteValidationFailures,
+		c.VA.MaxRemoteCAAFailures,
 		c.VA.UserAgent,
 		c.VA.IssuerDomain,
 		scope,
 		clk,
 		logger,
-		c.VA.AccountURIPrefixes)
+		c.VA.AccountURIPrefixes,
+		perspective,
+		c.VA.RIR,
+		c.VA.CNAMEPolicies,
+		evidenceArchive,
+		c.VA.ConcurrencyLimits,
+		iodefReporter,
+		c.VA.EgressProxy,
+		sac)
 	cmd.FailOnError(err, "Unable to create VA server")
 
 	start, err := bgrpc.NewServer(c.VA.GRPC, logger).Add(
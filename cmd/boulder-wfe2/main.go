@@ -55,6 +55,20 @@ type Config struct {
 
 		SubscriberAgreementURL string
 
+		// TermsOfServiceVersion is the date of the subscriber agreement at
+		// SubscriberAgreementURL, in YYYY-MM-DD format. It's recorded for each
+		// account which agrees to the subscriber agreement.
+		TermsOfServiceVersion string `validate:"omitempty,datetime=2006-01-02"`
+
+		// MinimumTermsOfServiceVersion is the date, in YYYY-MM-DD format, of
+		// the oldest subscriber agreement to which an account may have agreed
+		// and still create new orders. Accounts which haven't agreed to at
+		// least this version get a userActionRequired error, and must agree
+		// to the current version by updating their account. If empty, any
+		// account may create new orders. It requires the TrackTermsAgreements
+		// feature.
+		MinimumTermsOfServiceVersion string `validate:"omitempty,datetime=2006-01-02"`
+
 		TLS cmd.TLSConfig
 
 		RAService *cmd.GRPCClientConfig
@@ -350,7 +364,15 @@ func main() {
 	)
 	cmd.FailOnError(err, "Unable to create WFE")
 
+	if c.WFE.MinimumTermsOfServiceVersion > c.WFE.TermsOfServiceVersion {
+		cmd.Fail("MinimumTermsOfServiceVersion must not be later than TermsOfServiceVersion")
+	}
+	if c.WFE.MinimumTermsOfServiceVersion != "" && !features.Enabled(features.TrackTermsAgreements) {
+		cmd.Fail("MinimumTermsOfServiceVersion requires the TrackTermsAgreements feature")
+	}
 	wfe.SubscriberAgreementURL = c.WFE.SubscriberAgreementURL
+	wfe.TermsOfServiceVersion = c.WFE.TermsOfServiceVersion
+	wfe.MinimumTermsOfServiceVersion = c.WFE.MinimumTermsOfServiceVersion
 	wfe.AllowOrigins = c.WFE.AllowOrigins
 	wfe.DirectoryCAAIdentity = c.WFE.DirectoryCAAIdentity
 	wfe.DirectoryWebsite = c.WFE.DirectoryWebsite
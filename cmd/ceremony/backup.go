@@ -0,0 +1,386 @@
+package notmain
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/letsencrypt/boulder/pkcs11helpers"
+)
+
+// Mechanisms which can be used to wrap a private key in a key-backup ceremony.
+const (
+	// wrapRSAOAEP wraps the private key directly under the backup token's RSA
+	// wrapping key using RSA-OAEP. The size of key which can be wrapped is
+	// limited by the size of the wrapping key, which in practice means this can
+	// only be used for ECDSA keys.
+	wrapRSAOAEP = "rsa-oaep"
+	// wrapAESKeyWrapPad wraps the private key under a single use AES-256
+	// key-encryption key using CKM_AES_KEY_WRAP_PAD (RFC 5649), and wraps that
+	// key-encryption key under the backup token's RSA wrapping key using
+	// RSA-OAEP. This can be used for keys of any type and size.
+	wrapAESKeyWrapPad = "aes-key-wrap-pad"
+)
+
+// wrapCtx is implemented by *pkcs11.Ctx, but not by the PKCtx interface, which
+// only covers what is needed to generate and use signing keys.
+type wrapCtx interface {
+	CreateObject(pkcs11.SessionHandle, []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+	GenerateKey(pkcs11.SessionHandle, []*pkcs11.Mechanism, []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+	WrapKey(pkcs11.SessionHandle, []*pkcs11.Mechanism, pkcs11.ObjectHandle, pkcs11.ObjectHandle) ([]byte, error)
+	UnwrapKey(pkcs11.SessionHandle, []*pkcs11.Mechanism, pkcs11.ObjectHandle, []byte, []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+}
+
+func wrapCtxOf(session *pkcs11helpers.Session) (wrapCtx, error) {
+	ctx, ok := session.Module.(wrapCtx)
+	if !ok {
+		return nil, fmt.Errorf("PKCS#11 module of type %T doesn't support key wrapping", session.Module)
+	}
+	return ctx, nil
+}
+
+// keyBackup is the output of a key-backup ceremony, and the input to a
+// key-restore ceremony.
+type keyBackup struct {
+	Mechanism string `json:"mechanism"`
+	OAEPHash  string `json:"oaepHash"`
+	// WrappedKEK is the AES key-encryption key, wrapped under the RSA wrapping
+	// key. It is only present for the aes-key-wrap-pad mechanism.
+	WrappedKEK []byte `json:"wrappedKEK,omitempty"`
+	WrappedKey []byte `json:"wrappedKey"`
+}
+
+// oaepMechanism returns the RSA-OAEP mechanism using the named hash for both
+// the label hash and MGF1. Some HSMs, including SoftHSM, only support SHA-1.
+func oaepMechanism(hash string) ([]*pkcs11.Mechanism, error) {
+	var hashAlg, mgf uint
+	switch hash {
+	case "sha1":
+		hashAlg, mgf = pkcs11.CKM_SHA_1, pkcs11.CKG_MGF1_SHA1
+	case "sha256":
+		hashAlg, mgf = pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256
+	default:
+		return nil, fmt.Errorf("unsupported OAEP hash %q", hash)
+	}
+	return []*pkcs11.Mechanism{
+		pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_OAEP, pkcs11.NewOAEPParams(hashAlg, mgf, pkcs11.CKZ_DATA_SPECIFIED, nil)),
+	}, nil
+}
+
+var aesKeyWrapPadMechanism = []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP_PAD, nil)}
+
+// newKeyID returns a random key ID, generated by the HSM, in the same format
+// used when generating keys.
+func newKeyID(session *pkcs11helpers.Session) ([]byte, error) {
+	keyID := make([]byte, 4)
+	_, err := newRandReader(session).Read(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return keyID, nil
+}
+
+// generateWrappingKey generates an RSA key pair which can be used to unwrap keys
+// into the token, and returns its public key.
+func generateWrappingKey(session *pkcs11helpers.Session, label string, modulusLen uint) (*rsa.PublicKey, error) {
+	_, err := session.FindObject([]*pkcs11.Attribute{
+		{Type: pkcs11.CKA_LABEL, Value: []byte(label)},
+	})
+	if err != pkcs11helpers.ErrNoObject {
+		return nil, fmt.Errorf("expected no preexisting objects with label %q in slot for wrapping key storage. got error: %s", label, err)
+	}
+	keyID, err := newKeyID(session)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Generating RSA wrapping key with %d bit modulus and ID %x\n", modulusLen, keyID)
+	pub, _, err := session.GenerateKeyPair(
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+			pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, modulusLen),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, big.NewInt(rsaExp).Bytes()),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			// Allow the key to unwrap backed up keys, and nothing else
+			pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, false),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.Println("Wrapping key generated")
+	return rsaPub(session, pub, modulusLen, rsaExp)
+}
+
+// importWrappingKey creates a session object for the given RSA public key,
+// which can be used to wrap keys for export from the token. Since it is a
+// session object it is destroyed when the session is closed.
+func importWrappingKey(session *pkcs11helpers.Session, ctx wrapCtx, pub *rsa.PublicKey) (pkcs11.ObjectHandle, error) {
+	return ctx.CreateObject(session.Session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS, pub.N.Bytes()),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, big.NewInt(int64(pub.E)).Bytes()),
+	})
+}
+
+// backupKey wraps the private key associated with label and pub under the RSA
+// wrapping key wrappingPub, using the named mechanism.
+func backupKey(session *pkcs11helpers.Session, label string, pub crypto.PublicKey, wrappingPub *rsa.PublicKey, mechanism, oaepHash string) (*keyBackup, error) {
+	ctx, err := wrapCtxOf(session)
+	if err != nil {
+		return nil, err
+	}
+	oaep, err := oaepMechanism(oaepHash)
+	if err != nil {
+		return nil, err
+	}
+	keyHandle, err := session.FindPrivateKey(label, pub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find private key to back up: %s", err)
+	}
+	log.Println("Retrieved private key handle")
+	wrappingKey, err := importWrappingKey(session, ctx, wrappingPub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import wrapping key: %s", err)
+	}
+	log.Println("Imported wrapping key")
+
+	backup := &keyBackup{Mechanism: mechanism, OAEPHash: oaepHash}
+	switch mechanism {
+	case wrapRSAOAEP:
+		backup.WrappedKey, err = ctx.WrapKey(session.Session, oaep, wrappingKey, keyHandle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap private key: %s", err)
+		}
+	case wrapAESKeyWrapPad:
+		kek, err := ctx.GenerateKey(session.Session,
+			[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+				pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+				pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, 32),
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+				pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+				pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+				pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate key-encryption key: %s", err)
+		}
+		log.Println("Generated AES-256 key-encryption key")
+		backup.WrappedKEK, err = ctx.WrapKey(session.Session, oaep, wrappingKey, kek)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap key-encryption key: %s", err)
+		}
+		backup.WrappedKey, err = ctx.WrapKey(session.Session, aesKeyWrapPadMechanism, kek, keyHandle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap private key: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported wrapping mechanism %q", mechanism)
+	}
+	log.Printf("Wrapped private key using %s\n", mechanism)
+	return backup, nil
+}
+
+// keyTemplates returns the attributes of the private and public key objects
+// for a restored copy of the key with the given public key. The private key is
+// never extractable, even if the original was, so that a backup token can't be
+// used to make further copies.
+func keyTemplates(pub crypto.PublicKey, label string, keyID []byte) ([]*pkcs11.Attribute, []*pkcs11.Attribute, error) {
+	privateAttrs := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+	}
+	publicAttrs := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+	}
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		privateAttrs = append(privateAttrs, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA))
+		publicAttrs = append(publicAttrs,
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, key.N.Bytes()),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, big.NewInt(int64(key.E)).Bytes()),
+		)
+	case *ecdsa.PublicKey:
+		curveOID, ok := curveToOIDDER[key.Curve.Params().Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported curve %q", key.Curve.Params().Name)
+		}
+		// CKA_EC_POINT is a DER encoded OCTET STRING, see the comment in
+		// pkcs11helpers.Session.GetECDSAPublicKey.
+		point, err := asn1.Marshal(elliptic.Marshal(key.Curve, key.X, key.Y))
+		if err != nil {
+			return nil, nil, err
+		}
+		privateAttrs = append(privateAttrs, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC))
+		publicAttrs = append(publicAttrs,
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, curveOID),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, point),
+		)
+	default:
+		return nil, nil, fmt.Errorf("unsupported public key of type %T", pub)
+	}
+	return privateAttrs, publicAttrs, nil
+}
+
+// restoreKey unwraps the backed up private key, whose public key is pub, into
+// the token using the RSA wrapping key with the label unwrappingLabel. The
+// restored private key and a matching public key object are stored with the
+// given label.
+func restoreKey(session *pkcs11helpers.Session, unwrappingLabel, label string, pub crypto.PublicKey, backup *keyBackup) error {
+	ctx, err := wrapCtxOf(session)
+	if err != nil {
+		return err
+	}
+	oaep, err := oaepMechanism(backup.OAEPHash)
+	if err != nil {
+		return err
+	}
+	_, err = session.FindObject([]*pkcs11.Attribute{
+		{Type: pkcs11.CKA_LABEL, Value: []byte(label)},
+	})
+	if err != pkcs11helpers.ErrNoObject {
+		return fmt.Errorf("expected no preexisting objects with label %q in slot for key storage. got error: %s", label, err)
+	}
+	unwrappingKey, err := session.FindObject([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, unwrappingLabel),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find unwrapping key %q: %s", unwrappingLabel, err)
+	}
+	log.Println("Retrieved unwrapping key handle")
+
+	keyID, err := newKeyID(session)
+	if err != nil {
+		return err
+	}
+	privateAttrs, publicAttrs, err := keyTemplates(pub, label, keyID)
+	if err != nil {
+		return err
+	}
+
+	switch backup.Mechanism {
+	case wrapRSAOAEP:
+		_, err = ctx.UnwrapKey(session.Session, oaep, unwrappingKey, backup.WrappedKey, privateAttrs)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap private key: %s", err)
+		}
+	case wrapAESKeyWrapPad:
+		kek, err := ctx.UnwrapKey(session.Session, oaep, unwrappingKey, backup.WrappedKEK, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to unwrap key-encryption key: %s", err)
+		}
+		log.Println("Unwrapped AES-256 key-encryption key")
+		_, err = ctx.UnwrapKey(session.Session, aesKeyWrapPadMechanism, kek, backup.WrappedKey, privateAttrs)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap private key: %s", err)
+		}
+	default:
+		return fmt.Errorf("unsupported wrapping mechanism %q", backup.Mechanism)
+	}
+	log.Printf("Unwrapped private key with label %q and ID %x\n", label, keyID)
+
+	_, err = ctx.CreateObject(session.Session, publicAttrs)
+	if err != nil {
+		return fmt.Errorf("failed to create public key object: %s", err)
+	}
+	log.Printf("Created public key object with label %q and ID %x\n", label, keyID)
+	return nil
+}
+
+// verifySignature checks a signature over a SHA-256 digest made by an RSA
+// (PKCS#1 v1.5) or ECDSA key.
+func verifySignature(pub crypto.PublicKey, digest, signature []byte) error {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, signature)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest, signature) {
+			return errors.New("ECDSA signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key of type %T", pub)
+	}
+}
+
+// compareSigners signs the same random digest with both signers, and checks
+// that both signatures are valid for pub. Since RSA PKCS#1 v1.5 signatures are
+// deterministic, RSA signatures must also be identical.
+func compareSigners(original, backup crypto.Signer, pub crypto.PublicKey) error {
+	digest := make([]byte, 32)
+	_, err := rand.Read(digest)
+	if err != nil {
+		return err
+	}
+	log.Printf("Signing digest %x with both keys\n", digest)
+	originalSig, err := original.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to sign with original key: %s", err)
+	}
+	backupSig, err := backup.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to sign with backup key: %s", err)
+	}
+	err = verifySignature(pub, digest, originalSig)
+	if err != nil {
+		return fmt.Errorf("original key signature invalid: %s", err)
+	}
+	log.Println("Original key signature is valid")
+	err = verifySignature(pub, digest, backupSig)
+	if err != nil {
+		return fmt.Errorf("backup key signature invalid: %s", err)
+	}
+	log.Println("Backup key signature is valid")
+	if _, ok := pub.(*rsa.PublicKey); ok && !bytes.Equal(originalSig, backupSig) {
+		return errors.New("original and backup keys produced different RSA signatures")
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package notmain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/letsencrypt/boulder/pkcs11helpers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestOAEPMechanism(t *testing.T) {
+	for _, hash := range []string{"sha1", "sha256"} {
+		mech, err := oaepMechanism(hash)
+		test.AssertNotError(t, err, "oaepMechanism failed")
+		test.AssertEquals(t, len(mech), 1)
+		test.AssertEquals(t, mech[0].Mechanism, uint(pkcs11.CKM_RSA_PKCS_OAEP))
+	}
+	_, err := oaepMechanism("md5")
+	test.AssertError(t, err, "oaepMechanism didn't fail for an unsupported hash")
+}
+
+func attrValue(attrs []*pkcs11.Attribute, attrType uint) []byte {
+	for _, attr := range attrs {
+		if attr.Type == attrType {
+			return attr.Value
+		}
+	}
+	return nil
+}
+
+func TestBackupKey(t *testing.T) {
+	wrappingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "failed to generate wrapping key")
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate key")
+
+	ctx := setupCtx()
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		return []pkcs11.ObjectHandle{1}, false, nil
+	}
+	ctx.GetAttributeValueFunc = func(pkcs11.SessionHandle, pkcs11.ObjectHandle, []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+		return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, []byte{1})}, nil
+	}
+	var imported []*pkcs11.Attribute
+	ctx.CreateObjectFunc = func(_ pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+		imported = temp
+		return 2, nil
+	}
+	ctx.GenerateKeyFunc = func(pkcs11.SessionHandle, []*pkcs11.Mechanism, []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+		return 3, nil
+	}
+	type wrapCall struct {
+		mechanism   uint
+		wrappingKey pkcs11.ObjectHandle
+		key         pkcs11.ObjectHandle
+	}
+	var calls []wrapCall
+	ctx.WrapKeyFunc = func(_ pkcs11.SessionHandle, m []*pkcs11.Mechanism, wrappingKey, key pkcs11.ObjectHandle) ([]byte, error) {
+		calls = append(calls, wrapCall{m[0].Mechanism, wrappingKey, key})
+		return []byte{byte(wrappingKey), byte(key)}, nil
+	}
+	session := &pkcs11helpers.Session{Module: &ctx, Session: 0}
+
+	backup, err := backupKey(session, "label", key.Public(), &wrappingKey.PublicKey, wrapRSAOAEP, "sha256")
+	test.AssertNotError(t, err, "backupKey failed")
+	test.AssertByteEquals(t, attrValue(imported, pkcs11.CKA_MODULUS), wrappingKey.N.Bytes())
+	test.AssertDeepEquals(t, calls, []wrapCall{{pkcs11.CKM_RSA_PKCS_OAEP, 2, 1}})
+	test.AssertDeepEquals(t, backup, &keyBackup{
+		Mechanism:  wrapRSAOAEP,
+		OAEPHash:   "sha256",
+		WrappedKey: []byte{2, 1},
+	})
+
+	calls = nil
+	backup, err = backupKey(session, "label", key.Public(), &wrappingKey.PublicKey, wrapAESKeyWrapPad, "sha1")
+	test.AssertNotError(t, err, "backupKey failed")
+	// The AES key-encryption key is wrapped under the RSA wrapping key, and the
+	// private key under the key-encryption key.
+	test.AssertDeepEquals(t, calls, []wrapCall{
+		{pkcs11.CKM_RSA_PKCS_OAEP, 2, 3},
+		{pkcs11.CKM_AES_KEY_WRAP_PAD, 3, 1},
+	})
+	test.AssertDeepEquals(t, backup, &keyBackup{
+		Mechanism:  wrapAESKeyWrapPad,
+		OAEPHash:   "sha1",
+		WrappedKEK: []byte{2, 3},
+		WrappedKey: []byte{3, 1},
+	})
+
+	_, err = backupKey(session, "label", key.Public(), &wrappingKey.PublicKey, "des", "sha1")
+	test.AssertError(t, err, "backupKey didn't fail for an unsupported mechanism")
+}
+
+func TestRestoreKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate key")
+
+	ctx := setupCtx()
+	var found []pkcs11.ObjectHandle
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		return found, false, nil
+	}
+	findCalls := 0
+	ctx.FindObjectsInitFunc = func(pkcs11.SessionHandle, []*pkcs11.Attribute) error {
+		// The first lookup checks that the label is unused, the second finds
+		// the unwrapping key.
+		findCalls++
+		if findCalls == 2 {
+			found = []pkcs11.ObjectHandle{1}
+		}
+		return nil
+	}
+	type unwrapCall struct {
+		mechanism     uint
+		unwrappingKey pkcs11.ObjectHandle
+		wrappedKey    []byte
+		temp          []*pkcs11.Attribute
+	}
+	var calls []unwrapCall
+	ctx.UnwrapKeyFunc = func(_ pkcs11.SessionHandle, m []*pkcs11.Mechanism, unwrappingKey pkcs11.ObjectHandle, wrappedKey []byte, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+		calls = append(calls, unwrapCall{m[0].Mechanism, unwrappingKey, wrappedKey, temp})
+		return pkcs11.ObjectHandle(len(calls) + 1), nil
+	}
+	var created []*pkcs11.Attribute
+	ctx.CreateObjectFunc = func(_ pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+		created = temp
+		return 10, nil
+	}
+	session := &pkcs11helpers.Session{Module: &ctx, Session: 0}
+
+	err = restoreKey(session, "wrapping key", "label", key.Public(), &keyBackup{
+		Mechanism:  wrapAESKeyWrapPad,
+		OAEPHash:   "sha1",
+		WrappedKEK: []byte{1},
+		WrappedKey: []byte{2},
+	})
+	test.AssertNotError(t, err, "restoreKey failed")
+	test.AssertEquals(t, len(calls), 2)
+	test.AssertEquals(t, calls[0].mechanism, uint(pkcs11.CKM_RSA_PKCS_OAEP))
+	test.AssertEquals(t, calls[0].unwrappingKey, pkcs11.ObjectHandle(1))
+	test.AssertByteEquals(t, calls[0].wrappedKey, []byte{1})
+	test.AssertEquals(t, calls[1].mechanism, uint(pkcs11.CKM_AES_KEY_WRAP_PAD))
+	test.AssertEquals(t, calls[1].unwrappingKey, pkcs11.ObjectHandle(2))
+	test.AssertByteEquals(t, calls[1].wrappedKey, []byte{2})
+
+	// The restored private key must not be extractable, and must share its
+	// label and ID with the public key object.
+	privateTemplate := calls[1].temp
+	test.AssertByteEquals(t, attrValue(privateTemplate, pkcs11.CKA_EXTRACTABLE), []byte{0})
+	test.AssertByteEquals(t, attrValue(privateTemplate, pkcs11.CKA_LABEL), []byte("label"))
+	test.AssertByteEquals(t, attrValue(created, pkcs11.CKA_LABEL), []byte("label"))
+	test.AssertByteEquals(t, attrValue(created, pkcs11.CKA_ID), attrValue(privateTemplate, pkcs11.CKA_ID))
+
+	var point []byte
+	_, err = asn1.Unmarshal(attrValue(created, pkcs11.CKA_EC_POINT), &point)
+	test.AssertNotError(t, err, "failed to unmarshal CKA_EC_POINT")
+	test.AssertByteEquals(t, point, elliptic.Marshal(key.Curve, key.X, key.Y))
+
+	// A preexisting object with the same label must cause restoration to fail.
+	found = []pkcs11.ObjectHandle{1}
+	findCalls = 0
+	err = restoreKey(session, "wrapping key", "label", key.Public(), &keyBackup{
+		Mechanism:  wrapRSAOAEP,
+		OAEPHash:   "sha1",
+		WrappedKey: []byte{2},
+	})
+	test.AssertError(t, err, "restoreKey didn't fail with a preexisting key")
+}
+
+func TestCompareSigners(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "failed to generate RSA key")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate ECDSA key")
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate ECDSA key")
+
+	for _, key := range []crypto.Signer{rsaKey, ecKey} {
+		err = compareSigners(key, key, key.Public())
+		test.AssertNotError(t, err, "compareSigners failed for matching keys")
+	}
+
+	err = compareSigners(ecKey, otherKey, ecKey.Public())
+	test.AssertError(t, err, "compareSigners didn't fail for different keys")
+}
@@ -3,20 +3,24 @@ package notmain
 import (
 	"bytes"
 	"crypto"
+	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/linter"
 	"github.com/letsencrypt/boulder/pkcs11helpers"
+	"github.com/letsencrypt/boulder/privatekey"
 	"github.com/letsencrypt/boulder/strictyaml"
 	"golang.org/x/crypto/ocsp"
 	"gopkg.in/yaml.v3"
@@ -26,6 +30,9 @@ type keyGenConfig struct {
 	Type         string `yaml:"type"`
 	RSAModLength uint   `yaml:"rsa-mod-length"`
 	ECDSACurve   string `yaml:"ecdsa-curve"`
+	// Extractable allows the private key to be wrapped and exported from the
+	// HSM by a key-backup ceremony. Keys are not extractable by default.
+	Extractable bool `yaml:"extractable"`
 }
 
 var allowedCurves = map[string]bool{
@@ -380,6 +387,155 @@ func (cc crlConfig) validate() error {
 	return nil
 }
 
+// wrappingKeyConfig is the config for a wrapping-key ceremony, which
+// generates an RSA key pair in the HSM for other HSMs' keys to be wrapped
+// under in key-backup ceremonies, and writes out its public key.
+type wrappingKeyConfig struct {
+	CeremonyType string             `yaml:"ceremony-type"`
+	PKCS11       PKCS11KeyGenConfig `yaml:"pkcs11"`
+	Key          struct {
+		RSAModLength uint `yaml:"rsa-mod-length"`
+	} `yaml:"key"`
+	Outputs struct {
+		PublicKeyPath string `yaml:"public-key-path"`
+	} `yaml:"outputs"`
+}
+
+func (wkc wrappingKeyConfig) validate() error {
+	err := wkc.PKCS11.validate()
+	if err != nil {
+		return err
+	}
+
+	// Key gen fields
+	if wkc.Key.RSAModLength != 2048 && wkc.Key.RSAModLength != 4096 {
+		return errors.New("key.rsa-mod-length can only be 2048 or 4096")
+	}
+
+	// Output fields
+	err = checkOutputFile(wkc.Outputs.PublicKeyPath, "public-key-path")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type keyWrappingConfig struct {
+	Mechanism string `yaml:"mechanism"`
+	OAEPHash  string `yaml:"oaep-hash"`
+}
+
+func (kwc keyWrappingConfig) validate() error {
+	if kwc.Mechanism != wrapRSAOAEP && kwc.Mechanism != wrapAESKeyWrapPad {
+		return fmt.Errorf("wrapping.mechanism can only be '%s' or '%s'", wrapRSAOAEP, wrapAESKeyWrapPad)
+	}
+	if kwc.OAEPHash != "sha1" && kwc.OAEPHash != "sha256" {
+		return errors.New("wrapping.oaep-hash can only be 'sha1' or 'sha256'")
+	}
+	return nil
+}
+
+type keyBackupConfig struct {
+	CeremonyType string              `yaml:"ceremony-type"`
+	PKCS11       PKCS11SigningConfig `yaml:"pkcs11"`
+	Inputs       struct {
+		PublicKeyPath         string `yaml:"public-key-path"`
+		WrappingPublicKeyPath string `yaml:"wrapping-public-key-path"`
+	} `yaml:"inputs"`
+	Wrapping keyWrappingConfig `yaml:"wrapping"`
+	Outputs  struct {
+		BackupPath string `yaml:"backup-path"`
+	} `yaml:"outputs"`
+}
+
+func (kbc keyBackupConfig) validate() error {
+	err := kbc.PKCS11.validate()
+	if err != nil {
+		return err
+	}
+
+	// Input fields
+	if kbc.Inputs.PublicKeyPath == "" {
+		return errors.New("inputs.public-key-path is required")
+	}
+	if kbc.Inputs.WrappingPublicKeyPath == "" {
+		return errors.New("inputs.wrapping-public-key-path is required")
+	}
+
+	err = kbc.Wrapping.validate()
+	if err != nil {
+		return err
+	}
+
+	// Output fields
+	err = checkOutputFile(kbc.Outputs.BackupPath, "backup-path")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type keyRestoreConfig struct {
+	CeremonyType string `yaml:"ceremony-type"`
+	PKCS11       struct {
+		PKCS11KeyGenConfig `yaml:",inline"`
+		UnwrappingLabel    string `yaml:"unwrapping-key-label"`
+	} `yaml:"pkcs11"`
+	Inputs struct {
+		PublicKeyPath string `yaml:"public-key-path"`
+		BackupPath    string `yaml:"backup-path"`
+	} `yaml:"inputs"`
+}
+
+func (krc keyRestoreConfig) validate() error {
+	err := krc.PKCS11.validate()
+	if err != nil {
+		return err
+	}
+	if krc.PKCS11.UnwrappingLabel == "" {
+		return errors.New("pkcs11.unwrapping-key-label is required")
+	}
+
+	// Input fields
+	if krc.Inputs.PublicKeyPath == "" {
+		return errors.New("inputs.public-key-path is required")
+	}
+	if krc.Inputs.BackupPath == "" {
+		return errors.New("inputs.backup-path is required")
+	}
+
+	return nil
+}
+
+type keyCompareConfig struct {
+	CeremonyType string              `yaml:"ceremony-type"`
+	PKCS11       PKCS11SigningConfig `yaml:"pkcs11"`
+	BackupPKCS11 PKCS11SigningConfig `yaml:"backup-pkcs11"`
+	Inputs       struct {
+		PublicKeyPath string `yaml:"public-key-path"`
+	} `yaml:"inputs"`
+}
+
+func (kcc keyCompareConfig) validate() error {
+	err := kcc.PKCS11.validate()
+	if err != nil {
+		return err
+	}
+	err = kcc.BackupPKCS11.validate()
+	if err != nil {
+		return fmt.Errorf("backup-%s", err)
+	}
+
+	// Input fields
+	if kcc.Inputs.PublicKeyPath == "" {
+		return errors.New("inputs.public-key-path is required")
+	}
+
+	return nil
+}
+
 // loadCert loads a PEM certificate specified by filename or returns an error
 func loadCert(filename string) (cert *x509.Certificate, err error) {
 	certPEM, err := os.ReadFile(filename)
@@ -406,7 +562,7 @@ func equalPubKeys(a, b interface{}) bool {
 	return bytes.Equal(aBytes, bBytes)
 }
 
-func openSigner(cfg PKCS11SigningConfig, pubKey crypto.PublicKey) (crypto.Signer, *hsmRandReader, error) {
+func openSigner(cfg PKCS11SigningConfig, pubKey crypto.PublicKey, t *transcript) (crypto.Signer, *hsmRandReader, error) {
 	session, err := pkcs11helpers.Initialize(cfg.Module, cfg.SigningSlot, cfg.PIN)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s",
@@ -421,6 +577,14 @@ func openSigner(cfg PKCS11SigningConfig, pubKey crypto.PublicKey) (crypto.Signer
 		return nil, nil, fmt.Errorf("signer pubkey did not match issuer pubkey")
 	}
 	log.Println("Retrieved private key handle")
+	err = t.recordHSM(session, cfg.Module, cfg.SigningSlot)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = t.recordKey(session, cfg.SigningLabel, pubKey)
+	if err != nil {
+		return nil, nil, err
+	}
 	return signer, newRandReader(session), nil
 }
 
@@ -463,7 +627,7 @@ func signAndWriteCert(tbs, issuer *x509.Certificate, subjectPubKey crypto.Public
 	return nil
 }
 
-func rootCeremony(configBytes []byte) error {
+func rootCeremony(configBytes []byte, t *transcript) error {
 	var config rootConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -486,6 +650,14 @@ func rootCeremony(configBytes []byte) error {
 	if err != nil {
 		return fmt.Errorf("failed to retrieve signer: %s", err)
 	}
+	err = t.recordHSM(session, config.PKCS11.Module, config.PKCS11.StoreSlot)
+	if err != nil {
+		return err
+	}
+	err = t.recordKey(session, config.PKCS11.StoreLabel, keyInfo.key)
+	if err != nil {
+		return err
+	}
 	template, err := makeTemplate(newRandReader(session), &config.CertProfile, keyInfo.der, rootCert)
 	if err != nil {
 		return fmt.Errorf("failed to create certificate profile: %s", err)
@@ -496,10 +668,10 @@ func rootCeremony(configBytes []byte) error {
 		return err
 	}
 
-	return nil
+	return t.recordOutputs(config.Outputs.PublicKeyPath, config.Outputs.CertificatePath)
 }
 
-func intermediateCeremony(configBytes []byte, ct certType) error {
+func intermediateCeremony(configBytes []byte, ct certType, t *transcript) error {
 	var config intermediateConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -509,6 +681,10 @@ func intermediateCeremony(configBytes []byte, ct certType) error {
 	if err != nil {
 		return fmt.Errorf("failed to validate config: %s", err)
 	}
+	err = t.recordInputs(config.Inputs.PublicKeyPath, config.Inputs.IssuerCertificatePath)
+	if err != nil {
+		return err
+	}
 
 	pubPEMBytes, err := os.ReadFile(config.Inputs.PublicKeyPath)
 	if err != nil {
@@ -527,7 +703,7 @@ func intermediateCeremony(configBytes []byte, ct certType) error {
 		return fmt.Errorf("failed to load issuer certificate %q: %s", config.Inputs.IssuerCertificatePath, err)
 	}
 
-	signer, randReader, err := openSigner(config.PKCS11, issuer.PublicKey)
+	signer, randReader, err := openSigner(config.PKCS11, issuer.PublicKey, t)
 	if err != nil {
 		return err
 	}
@@ -543,10 +719,10 @@ func intermediateCeremony(configBytes []byte, ct certType) error {
 		return err
 	}
 
-	return nil
+	return t.recordOutputs(config.Outputs.CertificatePath)
 }
 
-func csrCeremony(configBytes []byte) error {
+func csrCeremony(configBytes []byte, t *transcript) error {
 	var config csrConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -556,6 +732,10 @@ func csrCeremony(configBytes []byte) error {
 	if err != nil {
 		return fmt.Errorf("failed to validate config: %s", err)
 	}
+	err = t.recordInputs(config.Inputs.PublicKeyPath)
+	if err != nil {
+		return err
+	}
 
 	pubPEMBytes, err := os.ReadFile(config.Inputs.PublicKeyPath)
 	if err != nil {
@@ -570,7 +750,7 @@ func csrCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to parse public key: %s", err)
 	}
 
-	signer, _, err := openSigner(config.PKCS11, pub)
+	signer, _, err := openSigner(config.PKCS11, pub, t)
 	if err != nil {
 		return err
 	}
@@ -586,10 +766,10 @@ func csrCeremony(configBytes []byte) error {
 	}
 	log.Printf("CSR written to %q\n", config.Outputs.CSRPath)
 
-	return nil
+	return t.recordOutputs(config.Outputs.CSRPath)
 }
 
-func keyCeremony(configBytes []byte) error {
+func keyCeremony(configBytes []byte, t *transcript) error {
 	var config keyConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -604,7 +784,16 @@ func keyCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s", config.PKCS11.StoreSlot, err)
 	}
 	log.Printf("Opened PKCS#11 session for slot %d\n", config.PKCS11.StoreSlot)
-	if _, err = generateKey(session, config.PKCS11.StoreLabel, config.Outputs.PublicKeyPath, config.Key); err != nil {
+	keyInfo, err := generateKey(session, config.PKCS11.StoreLabel, config.Outputs.PublicKeyPath, config.Key)
+	if err != nil {
+		return err
+	}
+	err = t.recordHSM(session, config.PKCS11.Module, config.PKCS11.StoreSlot)
+	if err != nil {
+		return err
+	}
+	err = t.recordKey(session, config.PKCS11.StoreLabel, keyInfo.key)
+	if err != nil {
 		return err
 	}
 
@@ -619,10 +808,10 @@ func keyCeremony(configBytes []byte) error {
 		}
 	}
 
-	return nil
+	return t.recordOutputs(config.Outputs.PublicKeyPath, config.Outputs.PKCS11ConfigPath)
 }
 
-func ocspRespCeremony(configBytes []byte) error {
+func ocspRespCeremony(configBytes []byte, t *transcript) error {
 	var config ocspRespConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -633,6 +822,11 @@ func ocspRespCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to validate config: %s", err)
 	}
 
+	err = t.recordInputs(config.Inputs.CertificatePath, config.Inputs.IssuerCertificatePath, config.Inputs.DelegatedIssuerCertificatePath)
+	if err != nil {
+		return err
+	}
+
 	cert, err := loadCert(config.Inputs.CertificatePath)
 	if err != nil {
 		return fmt.Errorf("failed to load certificate %q: %s", config.Inputs.CertificatePath, err)
@@ -649,12 +843,12 @@ func ocspRespCeremony(configBytes []byte) error {
 			return fmt.Errorf("failed to load delegated issuer certificate %q: %s", config.Inputs.DelegatedIssuerCertificatePath, err)
 		}
 
-		signer, _, err = openSigner(config.PKCS11, delegatedIssuer.PublicKey)
+		signer, _, err = openSigner(config.PKCS11, delegatedIssuer.PublicKey, t)
 		if err != nil {
 			return err
 		}
 	} else {
-		signer, _, err = openSigner(config.PKCS11, issuer.PublicKey)
+		signer, _, err = openSigner(config.PKCS11, issuer.PublicKey, t)
 		if err != nil {
 			return err
 		}
@@ -689,10 +883,10 @@ func ocspRespCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to write OCSP response to %q: %s", config.Outputs.ResponsePath, err)
 	}
 
-	return nil
+	return t.recordOutputs(config.Outputs.ResponsePath)
 }
 
-func crlCeremony(configBytes []byte) error {
+func crlCeremony(configBytes []byte, t *transcript) error {
 	var config crlConfig
 	err := strictyaml.Unmarshal(configBytes, &config)
 	if err != nil {
@@ -703,11 +897,22 @@ func crlCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to validate config: %s", err)
 	}
 
+	err = t.recordInputs(config.Inputs.IssuerCertificatePath)
+	if err != nil {
+		return err
+	}
+	for _, rc := range config.CRLProfile.RevokedCertificates {
+		err = t.recordInputs(rc.CertificatePath)
+		if err != nil {
+			return err
+		}
+	}
+
 	issuer, err := loadCert(config.Inputs.IssuerCertificatePath)
 	if err != nil {
 		return fmt.Errorf("failed to load issuer certificate %q: %s", config.Inputs.IssuerCertificatePath, err)
 	}
-	signer, _, err := openSigner(config.PKCS11, issuer.PublicKey)
+	signer, _, err := openSigner(config.PKCS11, issuer.PublicKey, t)
 	if err != nil {
 		return err
 	}
@@ -758,16 +963,260 @@ func crlCeremony(configBytes []byte) error {
 		return fmt.Errorf("failed to write CRL to %q: %s", config.Outputs.CRLPath, err)
 	}
 
+	return t.recordOutputs(config.Outputs.CRLPath)
+}
+
+func wrappingKeyCeremony(configBytes []byte, t *transcript) error {
+	var config wrappingKeyConfig
+	err := strictyaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %s", err)
+	}
+	err = config.validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate config: %s", err)
+	}
+	session, err := pkcs11helpers.Initialize(config.PKCS11.Module, config.PKCS11.StoreSlot, config.PKCS11.PIN)
+	if err != nil {
+		return fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s", config.PKCS11.StoreSlot, err)
+	}
+	log.Printf("Opened PKCS#11 session for slot %d\n", config.PKCS11.StoreSlot)
+	err = t.recordHSM(session, config.PKCS11.Module, config.PKCS11.StoreSlot)
+	if err != nil {
+		return err
+	}
+
+	pub, err := generateWrappingKey(session, config.PKCS11.StoreLabel, config.Key.RSAModLength)
+	if err != nil {
+		return fmt.Errorf("failed to generate wrapping key: %s", err)
+	}
+	err = t.recordKey(session, config.PKCS11.StoreLabel, pub)
+	if err != nil {
+		return err
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return fmt.Errorf("failed to marshal wrapping public key: %s", err)
+	}
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	log.Printf("Wrapping public key PEM:\n%s\n", pemBytes)
+	err = writeFile(config.Outputs.PublicKeyPath, pemBytes)
+	if err != nil {
+		return fmt.Errorf("failed to write wrapping public key to %q: %s", config.Outputs.PublicKeyPath, err)
+	}
+	log.Printf("Wrapping public key written to %q\n", config.Outputs.PublicKeyPath)
+
+	return t.recordOutputs(config.Outputs.PublicKeyPath)
+}
+
+func keyBackupCeremony(configBytes []byte, t *transcript) error {
+	var config keyBackupConfig
+	err := strictyaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %s", err)
+	}
+	err = config.validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate config: %s", err)
+	}
+	err = t.recordInputs(config.Inputs.PublicKeyPath, config.Inputs.WrappingPublicKeyPath)
+	if err != nil {
+		return err
+	}
+
+	pub, err := loadPublicKey(config.Inputs.PublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load public key %q: %s", config.Inputs.PublicKeyPath, err)
+	}
+	wrappingPub, err := loadPublicKey(config.Inputs.WrappingPublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load wrapping public key %q: %s", config.Inputs.WrappingPublicKeyPath, err)
+	}
+	wrappingRSAPub, ok := wrappingPub.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("wrapping public key must be an RSA key, got %T", wrappingPub)
+	}
+
+	session, err := pkcs11helpers.Initialize(config.PKCS11.Module, config.PKCS11.SigningSlot, config.PKCS11.PIN)
+	if err != nil {
+		return fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s", config.PKCS11.SigningSlot, err)
+	}
+	log.Printf("Opened PKCS#11 session for slot %d\n", config.PKCS11.SigningSlot)
+	err = t.recordHSM(session, config.PKCS11.Module, config.PKCS11.SigningSlot)
+	if err != nil {
+		return err
+	}
+	err = t.recordKey(session, config.PKCS11.SigningLabel, pub)
+	if err != nil {
+		return err
+	}
+
+	backup, err := backupKey(session, config.PKCS11.SigningLabel, pub, wrappingRSAPub, config.Wrapping.Mechanism, config.Wrapping.OAEPHash)
+	if err != nil {
+		return err
+	}
+	backupBytes, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal key backup: %s", err)
+	}
+	err = writeFile(config.Outputs.BackupPath, backupBytes)
+	if err != nil {
+		return fmt.Errorf("failed to write key backup to %q: %s", config.Outputs.BackupPath, err)
+	}
+	log.Printf("Key backup written to %q\n", config.Outputs.BackupPath)
+
+	return t.recordOutputs(config.Outputs.BackupPath)
+}
+
+func keyRestoreCeremony(configBytes []byte, t *transcript) error {
+	var config keyRestoreConfig
+	err := strictyaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %s", err)
+	}
+	err = config.validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate config: %s", err)
+	}
+	err = t.recordInputs(config.Inputs.PublicKeyPath, config.Inputs.BackupPath)
+	if err != nil {
+		return err
+	}
+
+	pub, err := loadPublicKey(config.Inputs.PublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load public key %q: %s", config.Inputs.PublicKeyPath, err)
+	}
+	backupBytes, err := os.ReadFile(config.Inputs.BackupPath)
+	if err != nil {
+		return fmt.Errorf("failed to read key backup %q: %s", config.Inputs.BackupPath, err)
+	}
+	var backup keyBackup
+	err = json.Unmarshal(backupBytes, &backup)
+	if err != nil {
+		return fmt.Errorf("failed to parse key backup %q: %s", config.Inputs.BackupPath, err)
+	}
+
+	session, err := pkcs11helpers.Initialize(config.PKCS11.Module, config.PKCS11.StoreSlot, config.PKCS11.PIN)
+	if err != nil {
+		return fmt.Errorf("failed to setup session and PKCS#11 context for slot %d: %s", config.PKCS11.StoreSlot, err)
+	}
+	log.Printf("Opened PKCS#11 session for slot %d\n", config.PKCS11.StoreSlot)
+	err = t.recordHSM(session, config.PKCS11.Module, config.PKCS11.StoreSlot)
+	if err != nil {
+		return err
+	}
+
+	err = restoreKey(session, config.PKCS11.UnwrappingLabel, config.PKCS11.StoreLabel, pub, &backup)
+	if err != nil {
+		return err
+	}
+	signer, err := session.NewSigner(config.PKCS11.StoreLabel, pub)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve restored key: %s", err)
+	}
+	err = pkcs11helpers.SelfTest(signer)
+	if err != nil {
+		return fmt.Errorf("restored key failed self-test: %s", err)
+	}
+	log.Println("Restored key passed self-test")
+
+	return t.recordKey(session, config.PKCS11.StoreLabel, pub)
+}
+
+func keyCompareCeremony(configBytes []byte, t *transcript) error {
+	var config keyCompareConfig
+	err := strictyaml.Unmarshal(configBytes, &config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %s", err)
+	}
+	err = config.validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate config: %s", err)
+	}
+	err = t.recordInputs(config.Inputs.PublicKeyPath)
+	if err != nil {
+		return err
+	}
+
+	pub, err := loadPublicKey(config.Inputs.PublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load public key %q: %s", config.Inputs.PublicKeyPath, err)
+	}
+	original, _, err := openSigner(config.PKCS11, pub, t)
+	if err != nil {
+		return err
+	}
+	backup, _, err := openSigner(config.BackupPKCS11, pub, t)
+	if err != nil {
+		return err
+	}
+
+	err = compareSigners(original, backup, pub)
+	if err != nil {
+		return err
+	}
+	log.Println("Original and backup keys produce matching signatures")
+
 	return nil
 }
 
+// verifyTranscriptMain implements the verify-transcript subcommand, which
+// checks the signature on a ceremony transcript and, optionally, that the files
+// it records are unchanged.
+func verifyTranscriptMain(args []string) {
+	fs := flag.NewFlagSet("verify-transcript", flag.ExitOnError)
+	transcriptPath := fs.String("transcript", "", "Path to signed ceremony transcript")
+	publicKeyPath := fs.String("public-key", "", "Path to PEM public key or certificate expected to have signed the transcript")
+	checkFiles := fs.Bool("check-files", false, "Check that files recorded in the transcript are present and unchanged")
+	_ = fs.Parse(args)
+
+	if *transcriptPath == "" || *publicKeyPath == "" {
+		log.Fatal("--transcript and --public-key are required")
+	}
+	bundle, err := os.ReadFile(*transcriptPath)
+	if err != nil {
+		log.Fatalf("Failed to read transcript: %s", err)
+	}
+	pub, err := loadPublicKey(*publicKeyPath)
+	if err != nil {
+		log.Fatalf("Failed to load public key: %s", err)
+	}
+	t, err := verifyTranscript(bundle, pub)
+	if err != nil {
+		log.Fatalf("Transcript verification failed: %s", err)
+	}
+	log.Printf("Transcript signature is valid for %s ceremony started at %s, witnessed by %s\n",
+		t.CeremonyType, t.Started.Format(time.RFC3339), strings.Join(t.Witnesses, ", "))
+	if t.Error != "" {
+		log.Printf("Transcript records that the ceremony failed: %s\n", t.Error)
+	}
+	if *checkFiles {
+		err = t.checkFiles()
+		if err != nil {
+			log.Fatalf("Transcript file check failed: %s", err)
+		}
+		log.Println("All files recorded in transcript are unchanged")
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "verify-transcript" {
+		verifyTranscriptMain(os.Args[2:])
+		return
+	}
+
 	configPath := flag.String("config", "", "Path to ceremony configuration file")
+	transcriptPath := flag.String("transcript", "", "Path to write a signed transcript of the ceremony to")
+	transcriptKeyPath := flag.String("transcript-signing-key", "", "Path to PEM private key used to sign the ceremony transcript")
 	flag.Parse()
 
 	if *configPath == "" {
 		log.Fatal("--config is required")
 	}
+	if (*transcriptPath == "") != (*transcriptKeyPath == "") {
+		log.Fatal("--transcript and --transcript-signing-key must be used together")
+	}
 	configBytes, err := os.ReadFile(*configPath)
 	if err != nil {
 		log.Fatalf("Failed to read config file: %s", err)
@@ -785,54 +1234,112 @@ func main() {
 		log.Fatalf("Failed to parse config: %s", err)
 	}
 
+	var t *transcript
+	var transcriptSigner crypto.Signer
+	if *transcriptPath != "" {
+		if _, err := os.Stat(*transcriptPath); !os.IsNotExist(err) {
+			log.Fatalf("--transcript is %q, which already exists", *transcriptPath)
+		}
+		transcriptSigner, _, err = privatekey.Load(*transcriptKeyPath)
+		if err != nil {
+			log.Fatalf("Failed to load transcript signing key: %s", err)
+		}
+		witnesses, err := readWitnesses(os.Stdin, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		t = newTranscript(*configPath, configBytes, ct.CeremonyType, witnesses)
+	}
+
 	switch ct.CeremonyType {
 	case "root":
-		err = rootCeremony(configBytes)
+		err = rootCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("root ceremony failed: %s", err)
+			err = fmt.Errorf("root ceremony failed: %s", err)
 		}
 	case "cross-certificate":
-		err = intermediateCeremony(configBytes, crossCert)
+		err = intermediateCeremony(configBytes, crossCert, t)
 		if err != nil {
-			log.Fatalf("cross-certificate ceremony failed: %s", err)
+			err = fmt.Errorf("cross-certificate ceremony failed: %s", err)
 		}
 	case "intermediate":
-		err = intermediateCeremony(configBytes, intermediateCert)
+		err = intermediateCeremony(configBytes, intermediateCert, t)
 		if err != nil {
-			log.Fatalf("intermediate ceremony failed: %s", err)
+			err = fmt.Errorf("intermediate ceremony failed: %s", err)
 		}
 	case "cross-csr":
-		err = csrCeremony(configBytes)
+		err = csrCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("cross-csr ceremony failed: %s", err)
+			err = fmt.Errorf("cross-csr ceremony failed: %s", err)
 		}
 	case "ocsp-signer":
-		err = intermediateCeremony(configBytes, ocspCert)
+		err = intermediateCeremony(configBytes, ocspCert, t)
 		if err != nil {
-			log.Fatalf("ocsp signer ceremony failed: %s", err)
+			err = fmt.Errorf("ocsp signer ceremony failed: %s", err)
 		}
 	case "key":
-		err = keyCeremony(configBytes)
+		err = keyCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("key ceremony failed: %s", err)
+			err = fmt.Errorf("key ceremony failed: %s", err)
 		}
 	case "ocsp-response":
-		err = ocspRespCeremony(configBytes)
+		err = ocspRespCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("ocsp response ceremony failed: %s", err)
+			err = fmt.Errorf("ocsp response ceremony failed: %s", err)
 		}
 	case "crl":
-		err = crlCeremony(configBytes)
+		err = crlCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("crl ceremony failed: %s", err)
+			err = fmt.Errorf("crl ceremony failed: %s", err)
 		}
 	case "crl-signer":
-		err = intermediateCeremony(configBytes, crlCert)
+		err = intermediateCeremony(configBytes, crlCert, t)
+		if err != nil {
+			err = fmt.Errorf("crl signer ceremony failed: %s", err)
+		}
+	case "wrapping-key":
+		err = wrappingKeyCeremony(configBytes, t)
 		if err != nil {
-			log.Fatalf("crl signer ceremony failed: %s", err)
+			err = fmt.Errorf("wrapping key ceremony failed: %s", err)
+		}
+	case "key-backup":
+		err = keyBackupCeremony(configBytes, t)
+		if err != nil {
+			err = fmt.Errorf("key backup ceremony failed: %s", err)
+		}
+	case "key-restore":
+		err = keyRestoreCeremony(configBytes, t)
+		if err != nil {
+			err = fmt.Errorf("key restore ceremony failed: %s", err)
+		}
+	case "key-compare":
+		err = keyCompareCeremony(configBytes, t)
+		if err != nil {
+			err = fmt.Errorf("key compare ceremony failed: %s", err)
 		}
 	default:
-		log.Fatalf("unknown ceremony-type, must be one of: root, intermediate, ocsp-signer, crl-signer, key, ocsp-response")
+		log.Fatalf("unknown ceremony-type, must be one of: root, intermediate, ocsp-signer, crl-signer, key, ocsp-response, wrapping-key, key-backup, key-restore, key-compare")
+	}
+
+	// The transcript is written even if the ceremony failed, so that auditors
+	// have a record of the attempt.
+	if t != nil {
+		t.Finished = time.Now().UTC()
+		if err != nil {
+			t.Error = err.Error()
+		}
+		signed, signErr := t.sign(transcriptSigner)
+		if signErr != nil {
+			log.Fatalf("Failed to sign transcript: %s", signErr)
+		}
+		writeErr := writeFile(*transcriptPath, signed)
+		if writeErr != nil {
+			log.Fatalf("Failed to write transcript to %q: %s", *transcriptPath, writeErr)
+		}
+		log.Printf("Signed transcript written to %q\n", *transcriptPath)
+	}
+	if err != nil {
+		log.Fatal(err)
 	}
 }
 
@@ -3,6 +3,8 @@ package notmain
 import (
 	"strings"
 	"testing"
+
+	"github.com/letsencrypt/boulder/strictyaml"
 )
 
 func TestCheckOutputFileSucceeds(t *testing.T) {
@@ -1064,3 +1066,178 @@ func TestCRLConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyBackupConfigs(t *testing.T) {
+	cases := []struct {
+		name          string
+		config        string
+		expectedError string
+	}{
+		{
+			name: "wrapping-key: bad rsa-mod-length",
+			config: `
+ceremony-type: wrapping-key
+pkcs11:
+    module: module
+    store-key-with-label: wrapping key
+key:
+    rsa-mod-length: 1024
+outputs:
+    public-key-path: wrapping.pem`,
+			expectedError: "key.rsa-mod-length can only be 2048 or 4096",
+		},
+		{
+			name: "wrapping-key: good config",
+			config: `
+ceremony-type: wrapping-key
+pkcs11:
+    module: module
+    store-key-with-label: wrapping key
+key:
+    rsa-mod-length: 4096
+outputs:
+    public-key-path: wrapping.pem`,
+		},
+		{
+			name: "key-backup: no inputs.wrapping-public-key-path",
+			config: `
+ceremony-type: key-backup
+pkcs11:
+    module: module
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem
+wrapping:
+    mechanism: rsa-oaep
+    oaep-hash: sha256
+outputs:
+    backup-path: backup.json`,
+			expectedError: "inputs.wrapping-public-key-path is required",
+		},
+		{
+			name: "key-backup: bad wrapping.mechanism",
+			config: `
+ceremony-type: key-backup
+pkcs11:
+    module: module
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem
+    wrapping-public-key-path: wrapping.pem
+wrapping:
+    mechanism: des
+    oaep-hash: sha256
+outputs:
+    backup-path: backup.json`,
+			expectedError: "wrapping.mechanism can only be 'rsa-oaep' or 'aes-key-wrap-pad'",
+		},
+		{
+			name: "key-backup: bad wrapping.oaep-hash",
+			config: `
+ceremony-type: key-backup
+pkcs11:
+    module: module
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem
+    wrapping-public-key-path: wrapping.pem
+wrapping:
+    mechanism: aes-key-wrap-pad
+    oaep-hash: md5
+outputs:
+    backup-path: backup.json`,
+			expectedError: "wrapping.oaep-hash can only be 'sha1' or 'sha256'",
+		},
+		{
+			name: "key-backup: good config",
+			config: `
+ceremony-type: key-backup
+pkcs11:
+    module: module
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem
+    wrapping-public-key-path: wrapping.pem
+wrapping:
+    mechanism: aes-key-wrap-pad
+    oaep-hash: sha1
+outputs:
+    backup-path: backup.json`,
+		},
+		{
+			name: "key-restore: no pkcs11.unwrapping-key-label",
+			config: `
+ceremony-type: key-restore
+pkcs11:
+    module: module
+    store-key-with-label: key
+inputs:
+    public-key-path: key.pem
+    backup-path: backup.json`,
+			expectedError: "pkcs11.unwrapping-key-label is required",
+		},
+		{
+			name: "key-restore: good config",
+			config: `
+ceremony-type: key-restore
+pkcs11:
+    module: module
+    store-key-in-slot: 1
+    store-key-with-label: key
+    unwrapping-key-label: wrapping key
+inputs:
+    public-key-path: key.pem
+    backup-path: backup.json`,
+		},
+		{
+			name: "key-compare: no backup-pkcs11.module",
+			config: `
+ceremony-type: key-compare
+pkcs11:
+    module: module
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem`,
+			expectedError: "backup-pkcs11.module is required",
+		},
+		{
+			name: "key-compare: good config",
+			config: `
+ceremony-type: key-compare
+pkcs11:
+    module: module
+    signing-key-label: key
+backup-pkcs11:
+    module: module
+    signing-key-slot: 1
+    signing-key-label: key
+inputs:
+    public-key-path: key.pem`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var config interface{ validate() error }
+			switch {
+			case strings.HasPrefix(tc.name, "wrapping-key"):
+				config = &wrappingKeyConfig{}
+			case strings.HasPrefix(tc.name, "key-backup"):
+				config = &keyBackupConfig{}
+			case strings.HasPrefix(tc.name, "key-restore"):
+				config = &keyRestoreConfig{}
+			case strings.HasPrefix(tc.name, "key-compare"):
+				config = &keyCompareConfig{}
+			}
+			err := strictyaml.Unmarshal([]byte(tc.config), config)
+			if err != nil {
+				t.Fatalf("Failed to parse config: %s", err)
+			}
+			err = config.validate()
+			if err != nil && err.Error() != tc.expectedError {
+				t.Fatalf("Unexpected error, wanted: %q, got: %q", tc.expectedError, err)
+			} else if err == nil && tc.expectedError != "" {
+				t.Fatalf("validate didn't fail, wanted: %q", tc.expectedError)
+			}
+		})
+	}
+}
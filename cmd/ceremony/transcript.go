@@ -0,0 +1,372 @@
+package notmain
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/letsencrypt/boulder/pkcs11helpers"
+)
+
+// transcript is a machine-readable record of a single ceremony run, intended
+// for auditors. It records what went into the ceremony, what came out of it,
+// which HSM and keys were used, and who witnessed it. All of the recording
+// methods are no-ops on a nil *transcript, so ceremonies can record
+// unconditionally.
+type transcript struct {
+	CeremonyType string          `json:"ceremonyType"`
+	Started      time.Time       `json:"started"`
+	Finished     time.Time       `json:"finished"`
+	Witnesses    []string        `json:"witnesses"`
+	Config       fileDigest      `json:"config"`
+	Inputs       []fileDigest    `json:"inputs,omitempty"`
+	Outputs      []fileDigest    `json:"outputs,omitempty"`
+	HSMs         []hsmInfo       `json:"hsms,omitempty"`
+	Keys         []keyAttributes `json:"keys,omitempty"`
+	// Error is the error which caused the ceremony to fail, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// fileDigest identifies a file read or written during a ceremony.
+type fileDigest struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// hsmInfo describes the HSM slot and token used during a ceremony.
+type hsmInfo struct {
+	Module            string `json:"module"`
+	Slot              uint   `json:"slot"`
+	SlotDescription   string `json:"slotDescription"`
+	TokenLabel        string `json:"tokenLabel"`
+	TokenManufacturer string `json:"tokenManufacturer"`
+	TokenModel        string `json:"tokenModel"`
+	TokenSerial       string `json:"tokenSerial"`
+	FirmwareVersion   string `json:"firmwareVersion"`
+}
+
+// keyAttributes records the attributes of a private key object, as read back
+// from the token during a ceremony.
+type keyAttributes struct {
+	Label            string `json:"label"`
+	ID               string `json:"id"`
+	PublicKeySHA256  string `json:"publicKeySHA256"`
+	Token            bool   `json:"token"`
+	Private          bool   `json:"private"`
+	Sensitive        bool   `json:"sensitive"`
+	AlwaysSensitive  bool   `json:"alwaysSensitive"`
+	Extractable      bool   `json:"extractable"`
+	NeverExtractable bool   `json:"neverExtractable"`
+	Local            bool   `json:"local"`
+	Sign             bool   `json:"sign"`
+	Modifiable       bool   `json:"modifiable"`
+}
+
+// newTranscript starts a transcript for a ceremony run with the given config
+// file and witnesses.
+func newTranscript(configPath string, configBytes []byte, ceremonyType string, witnesses []string) *transcript {
+	return &transcript{
+		CeremonyType: ceremonyType,
+		Started:      time.Now().UTC(),
+		Witnesses:    witnesses,
+		Config:       fileDigest{Path: configPath, SHA256: sha256Hex(configBytes)},
+	}
+}
+
+func sha256Hex(contents []byte) string {
+	digest := sha256.Sum256(contents)
+	return hex.EncodeToString(digest[:])
+}
+
+func digestFile(path string) (fileDigest, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return fileDigest{}, fmt.Errorf("failed to read %q for transcript: %s", path, err)
+	}
+	return fileDigest{Path: path, SHA256: sha256Hex(contents)}, nil
+}
+
+// recordInputs hashes the given input files, skipping any empty paths, which
+// correspond to optional inputs which were not provided.
+func (t *transcript) recordInputs(paths ...string) error {
+	if t == nil {
+		return nil
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		digest, err := digestFile(path)
+		if err != nil {
+			return err
+		}
+		t.Inputs = append(t.Inputs, digest)
+	}
+	return nil
+}
+
+// recordOutputs hashes the given output files, skipping any empty paths, which
+// correspond to optional outputs which were not requested.
+func (t *transcript) recordOutputs(paths ...string) error {
+	if t == nil {
+		return nil
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		digest, err := digestFile(path)
+		if err != nil {
+			return err
+		}
+		t.Outputs = append(t.Outputs, digest)
+	}
+	return nil
+}
+
+// tokenInfoCtx is implemented by *pkcs11.Ctx, but not by the PKCtx interface,
+// which only covers what is needed to use keys.
+type tokenInfoCtx interface {
+	GetSlotInfo(slotID uint) (pkcs11.SlotInfo, error)
+	GetTokenInfo(slotID uint) (pkcs11.TokenInfo, error)
+}
+
+// recordHSM records information about the given slot of the PKCS#11 module
+// used by session.
+func (t *transcript) recordHSM(session *pkcs11helpers.Session, module string, slot uint) error {
+	if t == nil {
+		return nil
+	}
+	ctx, ok := session.Module.(tokenInfoCtx)
+	if !ok {
+		return fmt.Errorf("PKCS#11 module of type %T doesn't provide slot and token information", session.Module)
+	}
+	slotInfo, err := ctx.GetSlotInfo(slot)
+	if err != nil {
+		return fmt.Errorf("failed to get info for slot %d: %s", slot, err)
+	}
+	tokenInfo, err := ctx.GetTokenInfo(slot)
+	if err != nil {
+		return fmt.Errorf("failed to get info for token in slot %d: %s", slot, err)
+	}
+	t.HSMs = append(t.HSMs, hsmInfo{
+		Module:            module,
+		Slot:              slot,
+		SlotDescription:   strings.TrimSpace(slotInfo.SlotDescription),
+		TokenLabel:        strings.TrimSpace(tokenInfo.Label),
+		TokenManufacturer: strings.TrimSpace(tokenInfo.ManufacturerID),
+		TokenModel:        strings.TrimSpace(tokenInfo.Model),
+		TokenSerial:       strings.TrimSpace(tokenInfo.SerialNumber),
+		FirmwareVersion:   fmt.Sprintf("%d.%d", tokenInfo.FirmwareVersion.Major, tokenInfo.FirmwareVersion.Minor),
+	})
+	return nil
+}
+
+// keyAttributeTypes are the boolean attributes of a private key which are
+// recorded in transcripts, in the order of the fields of keyAttributes.
+var keyAttributeTypes = []uint{
+	pkcs11.CKA_TOKEN,
+	pkcs11.CKA_PRIVATE,
+	pkcs11.CKA_SENSITIVE,
+	pkcs11.CKA_ALWAYS_SENSITIVE,
+	pkcs11.CKA_EXTRACTABLE,
+	pkcs11.CKA_NEVER_EXTRACTABLE,
+	pkcs11.CKA_LOCAL,
+	pkcs11.CKA_SIGN,
+	pkcs11.CKA_MODIFIABLE,
+}
+
+// recordKey reads back the attributes of the private key associated with the
+// given label and public key, and records them.
+func (t *transcript) recordKey(session *pkcs11helpers.Session, label string, pub crypto.PublicKey) error {
+	if t == nil {
+		return nil
+	}
+	handle, err := session.FindPrivateKey(label, pub)
+	if err != nil {
+		return err
+	}
+	template := []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, nil)}
+	for _, attrType := range keyAttributeTypes {
+		template = append(template, pkcs11.NewAttribute(attrType, nil))
+	}
+	attrs, err := session.GetAttributeValue(handle, template)
+	if err != nil {
+		return fmt.Errorf("failed to read private key attributes: %s", err)
+	}
+	values := make(map[uint][]byte, len(attrs))
+	for _, attr := range attrs {
+		values[attr.Type] = attr.Value
+	}
+	isTrue := func(attrType uint) bool {
+		return len(values[attrType]) == 1 && values[attrType][0] == 1
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return fmt.Errorf("failed to marshal public key: %s", err)
+	}
+	t.Keys = append(t.Keys, keyAttributes{
+		Label:            label,
+		ID:               hex.EncodeToString(values[pkcs11.CKA_ID]),
+		PublicKeySHA256:  sha256Hex(der),
+		Token:            isTrue(pkcs11.CKA_TOKEN),
+		Private:          isTrue(pkcs11.CKA_PRIVATE),
+		Sensitive:        isTrue(pkcs11.CKA_SENSITIVE),
+		AlwaysSensitive:  isTrue(pkcs11.CKA_ALWAYS_SENSITIVE),
+		Extractable:      isTrue(pkcs11.CKA_EXTRACTABLE),
+		NeverExtractable: isTrue(pkcs11.CKA_NEVER_EXTRACTABLE),
+		Local:            isTrue(pkcs11.CKA_LOCAL),
+		Sign:             isTrue(pkcs11.CKA_SIGN),
+		Modifiable:       isTrue(pkcs11.CKA_MODIFIABLE),
+	})
+	return nil
+}
+
+// readWitnesses prompts for the names of the ceremony's witnesses, one per
+// line, until an empty line is entered. At least one witness is required.
+func readWitnesses(in io.Reader, out io.Writer) ([]string, error) {
+	fmt.Fprintln(out, "Enter the name of each witness to this ceremony on its own line, followed by an empty line:")
+	var witnesses []string
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" {
+			break
+		}
+		witnesses = append(witnesses, name)
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read witness names: %s", err)
+	}
+	if len(witnesses) == 0 {
+		return nil, errors.New("at least one witness is required")
+	}
+	return witnesses, nil
+}
+
+// signedTranscript is the attestation bundle written at the end of a ceremony.
+// Signature is over the SHA-256 hash of the compact JSON encoding of
+// Transcript, which is what json.Compact yields however the bundle has been
+// reformatted since.
+type signedTranscript struct {
+	Transcript json.RawMessage `json:"transcript"`
+	// PublicKey is the DER encoded SubjectPublicKeyInfo of the signing key. It
+	// is included for convenience only: verifiers must check the signature
+	// against a public key obtained independently.
+	PublicKey []byte `json:"publicKey"`
+	Signature []byte `json:"signature"`
+}
+
+// sign returns the transcript, signed by the given signer, as an indented
+// JSON attestation bundle.
+func (t *transcript) sign(signer crypto.Signer) ([]byte, error) {
+	transcriptJSON, err := json.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transcript: %s", err)
+	}
+	digest := sha256.Sum256(transcriptJSON)
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transcript: %s", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transcript signing key: %s", err)
+	}
+	return json.MarshalIndent(signedTranscript{
+		Transcript: transcriptJSON,
+		PublicKey:  pubDER,
+		Signature:  signature,
+	}, "", "  ")
+}
+
+// verifyTranscript checks that the given attestation bundle was signed by the
+// given public key, and returns the transcript it contains.
+func verifyTranscript(bundle []byte, pub crypto.PublicKey) (*transcript, error) {
+	var signed signedTranscript
+	err := json.Unmarshal(bundle, &signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transcript bundle: %s", err)
+	}
+
+	var compacted bytes.Buffer
+	err = json.Compact(&compacted, signed.Transcript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compact transcript: %s", err)
+	}
+	digest := sha256.Sum256(compacted.Bytes())
+
+	err = verifySignature(pub, digest[:], signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transcript signature: %s", err)
+	}
+
+	var t transcript
+	err = json.Unmarshal(signed.Transcript, &t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transcript: %s", err)
+	}
+	return &t, nil
+}
+
+// checkFiles re-hashes every file recorded in the transcript, and returns an
+// error describing each one which is missing or no longer matches.
+func (t *transcript) checkFiles() error {
+	var problems []string
+	files := append([]fileDigest{t.Config}, t.Inputs...)
+	files = append(files, t.Outputs...)
+	for _, recorded := range files {
+		current, err := digestFile(recorded.Path)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if current.SHA256 != recorded.SHA256 {
+			problems = append(problems, fmt.Sprintf("%q has SHA-256 %s, but transcript records %s", recorded.Path, current.SHA256, recorded.SHA256))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// loadPublicKey loads a PEM encoded public key or certificate.
+func loadPublicKey(filename string) (crypto.PublicKey, error) {
+	pemBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %q", filename)
+	}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unexpected PEM block type %q in %q", block.Type, filename)
+	}
+}
@@ -0,0 +1,161 @@
+package notmain
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/letsencrypt/boulder/pkcs11helpers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestReadWitnesses(t *testing.T) {
+	var out bytes.Buffer
+	witnesses, err := readWitnesses(strings.NewReader("Alice Example\n  Bob Example  \n\nignored\n"), &out)
+	test.AssertNotError(t, err, "readWitnesses failed")
+	test.AssertDeepEquals(t, witnesses, []string{"Alice Example", "Bob Example"})
+	test.Assert(t, out.Len() > 0, "expected a prompt to be written")
+
+	_, err = readWitnesses(strings.NewReader("\n"), &out)
+	test.AssertError(t, err, "readWitnesses didn't fail with no witnesses")
+
+	_, err = readWitnesses(strings.NewReader(""), &out)
+	test.AssertError(t, err, "readWitnesses didn't fail with empty input")
+}
+
+func TestTranscriptFiles(t *testing.T) {
+	tmp := t.TempDir()
+	configPath := path.Join(tmp, "config.yaml")
+	inputPath := path.Join(tmp, "input.pem")
+	outputPath := path.Join(tmp, "output.pem")
+	test.AssertNotError(t, os.WriteFile(configPath, []byte("config"), 0644), "failed to write config")
+	test.AssertNotError(t, os.WriteFile(inputPath, []byte("input"), 0644), "failed to write input")
+	test.AssertNotError(t, os.WriteFile(outputPath, []byte("output"), 0644), "failed to write output")
+
+	tr := newTranscript(configPath, []byte("config"), "root", []string{"Alice"})
+	test.AssertNotError(t, tr.recordInputs(inputPath, ""), "recordInputs failed")
+	test.AssertNotError(t, tr.recordOutputs(outputPath), "recordOutputs failed")
+	test.AssertEquals(t, len(tr.Inputs), 1)
+	test.AssertEquals(t, len(tr.Outputs), 1)
+	// SHA-256 of "input"
+	test.AssertEquals(t, tr.Inputs[0].SHA256, "c96c6d5be8d08a12e7b5cdc1b207fa6b2430974c86803d8891675e76fd992c20")
+
+	err := tr.recordInputs(path.Join(tmp, "missing"))
+	test.AssertError(t, err, "recordInputs didn't fail for a missing file")
+
+	test.AssertNotError(t, tr.checkFiles(), "checkFiles failed for unchanged files")
+
+	test.AssertNotError(t, os.WriteFile(outputPath, []byte("modified"), 0644), "failed to modify output")
+	test.AssertNotError(t, os.Remove(inputPath), "failed to remove input")
+	err = tr.checkFiles()
+	test.AssertError(t, err, "checkFiles didn't fail for changed files")
+	test.AssertContains(t, err.Error(), outputPath)
+	test.AssertContains(t, err.Error(), inputPath)
+}
+
+func TestNilTranscript(t *testing.T) {
+	var tr *transcript
+	test.AssertNotError(t, tr.recordInputs("/does/not/exist"), "recordInputs failed on nil transcript")
+	test.AssertNotError(t, tr.recordOutputs("/does/not/exist"), "recordOutputs failed on nil transcript")
+	test.AssertNotError(t, tr.recordHSM(nil, "module", 1), "recordHSM failed on nil transcript")
+	test.AssertNotError(t, tr.recordKey(nil, "label", nil), "recordKey failed on nil transcript")
+}
+
+func TestRecordKey(t *testing.T) {
+	ctx := setupCtx()
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		return []pkcs11.ObjectHandle{1}, false, nil
+	}
+	values := map[uint][]byte{
+		pkcs11.CKA_ID:                {1, 2, 3},
+		pkcs11.CKA_TOKEN:             {1},
+		pkcs11.CKA_PRIVATE:           {1},
+		pkcs11.CKA_SENSITIVE:         {1},
+		pkcs11.CKA_ALWAYS_SENSITIVE:  {1},
+		pkcs11.CKA_EXTRACTABLE:       {0},
+		pkcs11.CKA_NEVER_EXTRACTABLE: {1},
+		pkcs11.CKA_LOCAL:             {1},
+		pkcs11.CKA_SIGN:              {1},
+		pkcs11.CKA_MODIFIABLE:        {0},
+	}
+	ctx.GetAttributeValueFunc = func(_ pkcs11.SessionHandle, _ pkcs11.ObjectHandle, template []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+		var attrs []*pkcs11.Attribute
+		for _, attr := range template {
+			attrs = append(attrs, pkcs11.NewAttribute(attr.Type, values[attr.Type]))
+		}
+		return attrs, nil
+	}
+	session := &pkcs11helpers.Session{Module: &ctx, Session: 0}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+
+	tr := newTranscript("config.yaml", nil, "key", []string{"Alice"})
+	err = tr.recordKey(session, "label", priv.Public())
+	test.AssertNotError(t, err, "recordKey failed")
+	test.AssertDeepEquals(t, tr.Keys, []keyAttributes{{
+		Label:            "label",
+		ID:               "010203",
+		PublicKeySHA256:  tr.Keys[0].PublicKeySHA256,
+		Token:            true,
+		Private:          true,
+		Sensitive:        true,
+		AlwaysSensitive:  true,
+		Extractable:      false,
+		NeverExtractable: true,
+		Local:            true,
+		Sign:             true,
+		Modifiable:       false,
+	}})
+	test.AssertEquals(t, len(tr.Keys[0].PublicKeySHA256), 64)
+
+	// MockCtx doesn't provide slot and token information.
+	err = tr.recordHSM(session, "module", 1)
+	test.AssertError(t, err, "recordHSM didn't fail for a module without token information")
+}
+
+func TestSignAndVerifyTranscript(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "failed to generate RSA key")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate ECDSA key")
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate ECDSA key")
+
+	for _, signer := range []crypto.Signer{rsaKey, ecKey} {
+		tr := newTranscript("config.yaml", []byte("config"), "root", []string{"Alice", "Bob"})
+		tr.Error = "something went wrong"
+		bundle, err := tr.sign(signer)
+		test.AssertNotError(t, err, "failed to sign transcript")
+
+		verified, err := verifyTranscript(bundle, signer.Public())
+		test.AssertNotError(t, err, "failed to verify transcript")
+		test.AssertEquals(t, verified.CeremonyType, "root")
+		test.AssertDeepEquals(t, verified.Witnesses, []string{"Alice", "Bob"})
+		test.AssertEquals(t, verified.Error, "something went wrong")
+
+		_, err = verifyTranscript(bundle, otherKey.Public())
+		test.AssertError(t, err, "verified transcript with the wrong key")
+
+		// Reformatting the bundle must not invalidate the signature.
+		var reformatted bytes.Buffer
+		test.AssertNotError(t, json.Indent(&reformatted, bundle, "    ", "\t"), "failed to reformat bundle")
+		_, err = verifyTranscript(reformatted.Bytes(), signer.Public())
+		test.AssertNotError(t, err, "failed to verify reformatted transcript")
+
+		// Modifying the transcript must.
+		tampered := bytes.Replace(bundle, []byte("Alice"), []byte("Mallory"), 1)
+		_, err = verifyTranscript(tampered, signer.Public())
+		test.AssertError(t, err, "verified tampered transcript")
+	}
+}
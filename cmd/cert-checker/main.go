@@ -31,6 +31,7 @@ import (
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/goodkey/sagoodkey"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 	_ "github.com/letsencrypt/boulder/linter"
 	blog "github.com/letsencrypt/boulder/log"
@@ -503,6 +504,13 @@ func main() {
 	pa, err := policy.New(config.PA.Challenges, logger)
 	cmd.FailOnError(err, "Failed to create PA")
 
+	if config.PA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(config.PA.PublicSuffixListFile, prometheus.DefaultRegisterer, logger)
+		cmd.FailOnError(err, "Failed to load public suffix list file")
+	}
+	err = config.PA.IANARegistries.Load()
+	cmd.FailOnError(err, "Failed to load IANA registries")
+
 	err = pa.SetHostnamePolicyFile(config.CertChecker.HostnamePolicyFile)
 	cmd.FailOnError(err, "Failed to load HostnamePolicyFile")
 
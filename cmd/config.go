@@ -16,6 +16,7 @@ import (
 
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/iana"
 )
 
 // PasswordConfig contains a path to a file containing a password.
@@ -115,6 +116,17 @@ type SMTPConfig struct {
 type PAConfig struct {
 	DBConfig   `validate:"-"`
 	Challenges map[core.AcmeChallenge]bool `validate:"omitempty,dive,keys,oneof=http-01 dns-01 tls-alpn-01,endkeys"`
+	// ChallengeRulesFile is the path to a YAML file of rules which restrict
+	// or extend the enabled challenge types for particular suffixes, TLDs,
+	// or accounts. It's reloaded when it changes. Optional.
+	ChallengeRulesFile string `validate:"-"`
+	// PublicSuffixListFile is the path to a public_suffix_list.dat file to use
+	// in place of the Public Suffix List compiled into Boulder, for policy
+	// checks and rate limiting. It's reloaded when it changes. Optional.
+	PublicSuffixListFile string `validate:"-"`
+	// IANARegistries are loaded in place of the special-purpose registry data
+	// compiled into Boulder.
+	IANARegistries IANARegistriesConfig
 }
 
 // CheckChallenges checks whether the list of challenges in the PA config
@@ -137,6 +149,47 @@ type HostnamePolicyConfig struct {
 	HostnamePolicyFile string `validate:"required"`
 }
 
+// IANARegistriesConfig specifies the CSV files, as published by IANA, from
+// which to load the special-purpose registries used to decide which IP
+// addresses are reserved and which domain names are special-use. Each registry
+// which isn't configured falls back to the data compiled into Boulder, which
+// has no special-use domain names.
+type IANARegistriesConfig struct {
+	// IPv4SpecialRegistryFile is the IPv4 Special-Purpose Address Registry,
+	// iana-ipv4-special-registry-1.csv.
+	IPv4SpecialRegistryFile string `validate:"-"`
+	// IPv6SpecialRegistryFile is the IPv6 Special-Purpose Address Registry,
+	// iana-ipv6-special-registry-1.csv.
+	IPv6SpecialRegistryFile string `validate:"-"`
+	// SpecialUseDomainsFile is the Special-Use Domain Names registry,
+	// special-use-domain.csv.
+	SpecialUseDomainsFile string `validate:"-"`
+}
+
+// Load loads each of the configured registries. It returns an error if any of
+// them can't be parsed.
+func (c IANARegistriesConfig) Load() error {
+	if c.IPv4SpecialRegistryFile != "" {
+		err := iana.LoadIPv4SpecialRegistry(c.IPv4SpecialRegistryFile)
+		if err != nil {
+			return fmt.Errorf("loading IPv4 special-purpose registry: %w", err)
+		}
+	}
+	if c.IPv6SpecialRegistryFile != "" {
+		err := iana.LoadIPv6SpecialRegistry(c.IPv6SpecialRegistryFile)
+		if err != nil {
+			return fmt.Errorf("loading IPv6 special-purpose registry: %w", err)
+		}
+	}
+	if c.SpecialUseDomainsFile != "" {
+		err := iana.LoadSpecialUseDomainsRegistry(c.SpecialUseDomainsFile)
+		if err != nil {
+			return fmt.Errorf("loading special-use domain names registry: %w", err)
+		}
+	}
+	return nil
+}
+
 // TLSConfig represents certificates and a key for authenticated TLS.
 type TLSConfig struct {
 	CertFile   string `validate:"required"`
@@ -564,4 +617,44 @@ type DNSProvider struct {
 	// 1 1 53 0a585858.addr.dc1.consul.
 	// 1 1 53 0a4d4d4d.addr.dc1.consul.
 	SRVLookup ServiceDomain `validate:"required"`
+
+	// Transport selects how queries are sent to the DNS backends: "udp" (the
+	// default) for plain DNS, "tls" for DNS-over-TLS (RFC 7858), or "https" for
+	// DNS-over-HTTPS (RFC 8484). For "tls" and "https" the backends' certificates
+	// are verified, and connections to them are reused across queries.
+	Transport string `validate:"omitempty,oneof=udp tls https"`
+
+	// TLSServerName is the name expected in the DNS backends' certificates when
+	// Transport is "tls" or "https". If unspecified, the Target of each SRV
+	// record is expected.
+	TLSServerName string `validate:"omitempty,hostname"`
+
+	// DoHPath is the URL path of the DNS-over-HTTPS endpoint when Transport is
+	// "https". If unspecified, "/dns-query" is used.
+	DoHPath string `validate:"omitempty,startswith=/"`
+
+	// TLSCACertFile is a PEM file of the CA certificates which the DNS
+	// backends' certificates are verified against when Transport is "tls" or
+	// "https". If unspecified, the system roots are used.
+	TLSCACertFile string `validate:"omitempty"`
+}
+
+// LoadTLSConfig returns the *tls.Config used to verify DNS-over-TLS and
+// DNS-over-HTTPS backends. It has no client certificate: the backends are
+// public or shared resolvers, not Boulder services, so they are never sent a
+// certificate meant for authenticating to other Boulder components.
+func (p *DNSProvider) LoadTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if p.TLSCACertFile == "" {
+		return tlsConfig, nil
+	}
+	caCertBytes, err := os.ReadFile(p.TLSCACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading DNS backend CA certs from %q: %s", p.TLSCACertFile, err)
+	}
+	tlsConfig.RootCAs = x509.NewCertPool()
+	if ok := tlsConfig.RootCAs.AppendCertsFromPEM(caCertBytes); !ok {
+		return nil, fmt.Errorf("parsing DNS backend CA certs from %s failed", p.TLSCACertFile)
+	}
+	return tlsConfig, nil
 }
@@ -98,3 +98,22 @@ func TestTLSConfigLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSProviderLoadTLSConfig(t *testing.T) {
+	// Without a CA cert file, the system roots are used, and there is never
+	// a client certificate.
+	tlsConfig, err := (&DNSProvider{}).LoadTLSConfig()
+	test.AssertNotError(t, err, "loading TLS config without CA certs")
+	test.Assert(t, tlsConfig.RootCAs == nil, "expected system roots")
+	test.AssertEquals(t, len(tlsConfig.Certificates), 0)
+
+	tlsConfig, err = (&DNSProvider{TLSCACertFile: "testdata/minica.pem"}).LoadTLSConfig()
+	test.AssertNotError(t, err, "loading TLS config with CA certs")
+	test.Assert(t, tlsConfig.RootCAs != nil, "expected configured roots")
+	test.AssertEquals(t, len(tlsConfig.Certificates), 0)
+
+	_, err = (&DNSProvider{TLSCACertFile: "[nonexistent]"}).LoadTLSConfig()
+	test.AssertError(t, err, "loaded TLS config with nonexistent CA certs")
+	_, err = (&DNSProvider{TLSCACertFile: "/dev/null"}).LoadTLSConfig()
+	test.AssertError(t, err, "loaded TLS config with empty CA certs")
+}
@@ -0,0 +1,208 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	blog "github.com/letsencrypt/boulder/log"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+type Config struct {
+	IssuanceResumer struct {
+		DebugAddr string
+
+		// TLS client certificate, private key, and trusted root bundle.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+		RAService *cmd.GRPCClientConfig
+
+		// Frequency controls how often the resumer looks for stalled issuances.
+		Frequency config.Duration `validate:"-"`
+
+		// StallThreshold is how long an issuance must have gone without making
+		// progress before it is considered stalled and resumed. This MUST be
+		// longer than the RA's finalizeTimeout, or the resumer may race with an
+		// RA which is still working on the issuance and cause a second final
+		// certificate to be issued for the same precertificate.
+		StallThreshold config.Duration `validate:"-"`
+
+		// BatchSize is the maximum number of stalled issuances resumed per run.
+		BatchSize int64 `validate:"min=0"`
+
+		Features map[string]bool
+	}
+
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+// resumer periodically asks the SA for issuances which have stalled, and asks
+// the RA to either finish or abandon each of them.
+type resumer struct {
+	sa             sapb.StorageAuthorityReadOnlyClient
+	ra             rapb.RegistrationAuthorityClient
+	stallThreshold time.Duration
+	batchSize      int64
+	resumed        *prometheus.CounterVec
+	log            blog.Logger
+	clk            clock.Clock
+}
+
+func newResumer(
+	sa sapb.StorageAuthorityReadOnlyClient,
+	ra rapb.RegistrationAuthorityClient,
+	stallThreshold time.Duration,
+	batchSize int64,
+	stats prometheus.Registerer,
+	log blog.Logger,
+	clk clock.Clock,
+) *resumer {
+	resumed := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "issuance_resumer_resumed",
+		Help: "A counter of stalled issuances resumed, labelled by order status after resumption, or 'error'",
+	}, []string{"result"})
+	stats.MustRegister(resumed)
+
+	return &resumer{
+		sa:             sa,
+		ra:             ra,
+		stallThreshold: stallThreshold,
+		batchSize:      batchSize,
+		resumed:        resumed,
+		log:            log,
+		clk:            clk,
+	}
+}
+
+// tick resumes a single batch of stalled issuances. Failing to resume one
+// issuance does not prevent the rest of the batch from being resumed; the
+// failed issuance will be retried on the next tick.
+func (r *resumer) tick(ctx context.Context) error {
+	stalled, err := r.sa.GetStalledIssuances(ctx, &sapb.GetStalledIssuancesRequest{
+		UpdatedBefore: timestamppb.New(r.clk.Now().Add(-r.stallThreshold)),
+		Limit:         r.batchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, state := range stalled.States {
+		order, err := r.ra.ResumeIssuance(ctx, &rapb.ResumeIssuanceRequest{OrderID: state.OrderID})
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return err
+			}
+			r.log.Errf("resuming issuance for order %d: %s", state.OrderID, err)
+			r.resumed.WithLabelValues("error").Inc()
+			continue
+		}
+		r.log.Infof("resumed issuance for order %d in state %q: order is now %q", state.OrderID, state.State, order.Status)
+		r.resumed.WithLabelValues(order.Status).Inc()
+	}
+	return nil
+}
+
+func (r *resumer) run(ctx context.Context, frequency time.Duration) error {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		err := r.tick(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			r.log.AuditErrf("finding stalled issuances: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	runOnce := flag.Bool("runOnce", false, "If true, run once immediately and then exit")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	if *debugAddr != "" {
+		c.IssuanceResumer.DebugAddr = *debugAddr
+	}
+
+	err = features.Set(c.IssuanceResumer.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	scope, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.IssuanceResumer.DebugAddr)
+	defer oTelShutdown(context.Background())
+	defer logger.AuditPanic()
+	logger.Info(cmd.VersionString())
+	clk := cmd.Clock()
+
+	tlsConfig, err := c.IssuanceResumer.TLS.Load(scope)
+	cmd.FailOnError(err, "TLS config")
+
+	if c.IssuanceResumer.Frequency.Duration == 0 {
+		c.IssuanceResumer.Frequency.Duration = time.Minute
+	}
+	if c.IssuanceResumer.StallThreshold.Duration == 0 {
+		c.IssuanceResumer.StallThreshold.Duration = time.Hour
+	}
+	if c.IssuanceResumer.BatchSize == 0 {
+		c.IssuanceResumer.BatchSize = 100
+	}
+
+	saConn, err := bgrpc.ClientSetup(c.IssuanceResumer.SAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityReadOnlyClient(saConn)
+
+	raConn, err := bgrpc.ClientSetup(c.IssuanceResumer.RAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to RA")
+	rac := rapb.NewRegistrationAuthorityClient(raConn)
+
+	r := newResumer(
+		sac,
+		rac,
+		c.IssuanceResumer.StallThreshold.Duration,
+		c.IssuanceResumer.BatchSize,
+		scope,
+		logger,
+		clk,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cmd.CatchSignals(cancel)
+
+	if *runOnce {
+		err = r.tick(ctx)
+	} else {
+		err = r.run(ctx, c.IssuanceResumer.Frequency.Duration)
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		cmd.FailOnError(err, "")
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("issuance-resumer", main, &cmd.ConfigValidator{Config: &Config{}})
+}
@@ -0,0 +1,72 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type mockSA struct {
+	sapb.StorageAuthorityReadOnlyClient
+	states []*sapb.IssuanceState
+	req    *sapb.GetStalledIssuancesRequest
+}
+
+func (m *mockSA) GetStalledIssuances(_ context.Context, req *sapb.GetStalledIssuancesRequest, _ ...grpc.CallOption) (*sapb.IssuanceStates, error) {
+	m.req = req
+	return &sapb.IssuanceStates{States: m.states}, nil
+}
+
+type mockRA struct {
+	rapb.RegistrationAuthorityClient
+	resumed []int64
+}
+
+func (m *mockRA) ResumeIssuance(_ context.Context, req *rapb.ResumeIssuanceRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	m.resumed = append(m.resumed, req.OrderID)
+	switch req.OrderID {
+	case 1:
+		return &corepb.Order{Id: 1, Status: string(core.StatusValid)}, nil
+	case 2:
+		return &corepb.Order{Id: 2, Status: string(core.StatusInvalid)}, nil
+	default:
+		return nil, errors.New("oops")
+	}
+}
+
+func TestTick(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC))
+	sa := &mockSA{states: []*sapb.IssuanceState{
+		{OrderID: 1, State: string(core.IssuancePrecertificateIssued)},
+		{OrderID: 2, State: string(core.IssuanceStarted)},
+		{OrderID: 3, State: string(core.IssuanceStarted)},
+	}}
+	ra := &mockRA{}
+	r := newResumer(sa, ra, time.Hour, 10, metrics.NoopRegisterer, blog.NewMock(), clk)
+
+	err := r.tick(context.Background())
+	test.AssertNotError(t, err, "tick failed")
+
+	test.AssertEquals(t, sa.req.UpdatedBefore.AsTime(), clk.Now().Add(-time.Hour))
+	test.AssertEquals(t, sa.req.Limit, int64(10))
+
+	// A failure to resume one issuance shouldn't stop the others being resumed.
+	test.AssertDeepEquals(t, ra.resumed, []int64{1, 2, 3})
+	test.AssertMetricWithLabelsEquals(t, r.resumed, prometheus.Labels{"result": "valid"}, 1)
+	test.AssertMetricWithLabelsEquals(t, r.resumed, prometheus.Labels{"result": "invalid"}, 1)
+	test.AssertMetricWithLabelsEquals(t, r.resumed, prometheus.Labels{"result": "error"}, 1)
+}
@@ -0,0 +1,241 @@
+package notmain
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/goodkey/sagoodkey"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/ra"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+const usageString = `
+usage:
+  would-issue -config <path> -account <account-id> [-csr <csr-path>] [<name> ...]
+
+Reports whether the RA would issue a certificate for the given names to the
+given account right now, and if not why, without creating an order. For each
+name it reports the result of the hostname policy and the challenge types which
+would be offered, and for the order it reports the usage of each applicable
+rate limit, read from the SA. If a CSR is given it's checked as it would be at
+finalization, and the names default to those in the CSR. The report is written
+to stdout as JSON.
+
+The config should use the same hostname policy, rate limit policies, challenge
+types and key policy as the RA's. Its syslog stdoutlevel should be low enough
+to keep log lines out of the report.
+`
+
+type Config struct {
+	WouldIssue struct {
+		cmd.HostnamePolicyConfig
+
+		RateLimitPoliciesFilename string `validate:"required"`
+
+		// TLS client certificate, private key, and trusted root bundle.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		MaxNames int `validate:"required,min=1"`
+
+		// PendingAuthorizationLifetimeDays is the RA's, which determines the
+		// window of the invalid authorizations rate limit.
+		PendingAuthorizationLifetimeDays int `validate:"required,min=1,max=29"`
+
+		// GoodKey is an embedded config stanza for the goodkey library.
+		GoodKey goodkey.Config
+
+		// LoadBlockedNames, if true, loads the hostname blocklist entries from
+		// the database as well as those in the HostnamePolicyFile. It should
+		// be set if the RA's BlockedNamesRefreshInterval is.
+		LoadBlockedNames bool
+
+		// ConfusableNameAction is the RA's, which see.
+		ConfusableNameAction string `validate:"omitempty,oneof=reject review"`
+
+		Features map[string]bool
+	}
+
+	PA cmd.PAConfig
+
+	Syslog cmd.SyslogConfig
+}
+
+// simulatedLogger logs the audit events of the PA and RA, such as blocked
+// issuance, as ordinary info or warning lines marked as simulated, since
+// nothing they describe actually happened. Error lines are always audit lines,
+// so audit errors become warnings.
+type simulatedLogger struct {
+	blog.Logger
+}
+
+func (l simulatedLogger) AuditInfo(msg string) {
+	l.Info("Simulated: " + msg)
+}
+
+func (l simulatedLogger) AuditInfof(format string, a ...interface{}) {
+	l.Info("Simulated: " + fmt.Sprintf(format, a...))
+}
+
+func (l simulatedLogger) AuditObject(msg string, obj interface{}) {
+	l.InfoObject("Simulated: "+msg, obj)
+}
+
+func (l simulatedLogger) AuditErr(msg string) {
+	l.Warning("Simulated: " + msg)
+}
+
+func (l simulatedLogger) AuditErrf(format string, a ...interface{}) {
+	l.Warning("Simulated: " + fmt.Sprintf(format, a...))
+}
+
+// loadCSR reads a PEM or DER encoded CSR from path.
+func loadCSR(path string) (*x509.CertificateRequest, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(contents)
+	if block != nil {
+		if block.Type != "CERTIFICATE REQUEST" {
+			return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		contents = block.Bytes
+	}
+	return x509.ParseCertificateRequest(contents)
+}
+
+// writeReport writes sim to w as indented JSON.
+func writeReport(w io.Writer, sim *ra.IssuanceSimulation) error {
+	out, err := json.MarshalIndent(sim, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+func main() {
+	flagSet := flag.NewFlagSet("would-issue", flag.ContinueOnError)
+	flagSet.Usage = func() {
+		fmt.Fprint(os.Stderr, usageString)
+	}
+	configFile := flagSet.String("config", "", "File path to the configuration file for this service")
+	accountID := flagSet.Int64("account", 0, "ID of the account to simulate issuance for")
+	csrPath := flagSet.String("csr", "", "Path to a PEM or DER CSR to check as at finalization")
+	err := flagSet.Parse(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(1)
+	}
+	cmd.FailOnError(err, "parsing flags")
+	names := flagSet.Args()
+	if *configFile == "" || *accountID == 0 || (len(names) == 0 && *csrPath == "") {
+		flagSet.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err = cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	err = features.Set(c.WouldIssue.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	logger := simulatedLogger{cmd.NewLogger(c.Syslog)}
+	scope := metrics.NoopRegisterer
+	clk := cmd.Clock()
+
+	cmd.FailOnError(c.PA.CheckChallenges(), "Invalid PA configuration")
+	pa, err := policy.New(c.PA.Challenges, logger)
+	cmd.FailOnError(err, "Couldn't create PA")
+	if c.PA.PublicSuffixListFile != "" {
+		err = iana.SetPublicSuffixListFile(c.PA.PublicSuffixListFile, scope, logger)
+		cmd.FailOnError(err, "Couldn't load public suffix list file")
+	}
+	err = c.PA.IANARegistries.Load()
+	cmd.FailOnError(err, "Couldn't load IANA registries")
+	err = pa.SetHostnamePolicyFile(c.WouldIssue.HostnamePolicyFile)
+	cmd.FailOnError(err, "Couldn't load hostname policy file")
+	if c.PA.ChallengeRulesFile != "" {
+		err = pa.SetChallengeRulesFile(c.PA.ChallengeRulesFile)
+		cmd.FailOnError(err, "Couldn't load challenge rules file")
+	}
+
+	tlsConfig, err := c.WouldIssue.TLS.Load(scope)
+	cmd.FailOnError(err, "TLS config")
+
+	saConn, err := bgrpc.ClientSetup(c.WouldIssue.SAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(saConn)
+
+	if c.WouldIssue.LoadBlockedNames {
+		timeout := c.WouldIssue.SAService.Timeout.Duration
+		if timeout == 0 {
+			timeout = 10 * time.Second
+		}
+		err = pa.LoadBlockedNames(sac, timeout)
+		cmd.FailOnError(err, "Couldn't load blocked names from database")
+	}
+
+	kp, err := sagoodkey.NewKeyPolicy(&c.WouldIssue.GoodKey, sac.KeyBlocked)
+	cmd.FailOnError(err, "Unable to create key policy")
+
+	rai := ra.NewRegistrationAuthorityImpl(
+		clk,
+		logger,
+		scope,
+		0,
+		kp,
+		c.WouldIssue.MaxNames,
+		0,
+		time.Duration(c.WouldIssue.PendingAuthorizationLifetimeDays)*24*time.Hour,
+		nil,
+		nil,
+		0,
+		0,
+		nil,
+		nil,
+		nil,
+	)
+	err = rai.SetRateLimitPoliciesFile(c.WouldIssue.RateLimitPoliciesFilename)
+	cmd.FailOnError(err, "Couldn't load rate limit policies file")
+	err = rai.SetConfusableNameAction(c.WouldIssue.ConfusableNameAction)
+	cmd.FailOnError(err, "Invalid confusable name action")
+	rai.PA = pa
+	rai.SA = sac
+
+	var csr *x509.CertificateRequest
+	if *csrPath != "" {
+		csr, err = loadCSR(*csrPath)
+		cmd.FailOnError(err, "Couldn't load CSR")
+	}
+
+	sim, err := rai.SimulateIssuance(context.Background(), *accountID, names, csr)
+	cmd.FailOnError(err, "Couldn't simulate issuance")
+	err = writeReport(os.Stdout, sim)
+	cmd.FailOnError(err, "Couldn't write report")
+	if !sim.WouldIssue {
+		os.Exit(2)
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("would-issue", main, &cmd.ConfigValidator{Config: &Config{}})
+}
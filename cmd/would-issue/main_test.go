@@ -0,0 +1,81 @@
+package notmain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/ra"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestLoadCSR(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: []string{"example.com"},
+	}, key)
+	test.AssertNotError(t, err, "creating CSR")
+
+	dir := t.TempDir()
+	derPath := filepath.Join(dir, "csr.der")
+	err = os.WriteFile(derPath, der, 0600)
+	test.AssertNotError(t, err, "writing DER CSR")
+	pemPath := filepath.Join(dir, "csr.pem")
+	err = os.WriteFile(pemPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), 0600)
+	test.AssertNotError(t, err, "writing PEM CSR")
+	certPath := filepath.Join(dir, "cert.pem")
+	err = os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	test.AssertNotError(t, err, "writing PEM certificate")
+
+	for _, path := range []string{derPath, pemPath} {
+		csr, err := loadCSR(path)
+		test.AssertNotError(t, err, "loading CSR")
+		test.AssertDeepEquals(t, csr.DNSNames, []string{"example.com"})
+	}
+	_, err = loadCSR(certPath)
+	test.AssertError(t, err, "loaded a certificate as a CSR")
+}
+
+func TestWriteReport(t *testing.T) {
+	var out strings.Builder
+	err := writeReport(&out, &ra.IssuanceSimulation{
+		Problems:    []string{"too many new orders recently"},
+		Identifiers: []ra.IdentifierResult{{Identifier: "example.com", ChallengeTypes: []core.AcmeChallenge{"http-01"}}},
+	})
+	test.AssertNotError(t, err, "writing report")
+	test.AssertEquals(t, out.String(), `{
+  "wouldIssue": false,
+  "problems": [
+    "too many new orders recently"
+  ],
+  "identifiers": [
+    {
+      "identifier": "example.com",
+      "challengeTypes": [
+        "http-01"
+      ]
+    }
+  ]
+}
+`)
+}
+
+func TestSimulatedLogger(t *testing.T) {
+	mock := blog.NewMock()
+	log := simulatedLogger{mock}
+	log.AuditInfof("Blocked issuance for %s: rule=[%s]", "example.com", "ExactBlockedNames:example.com")
+	log.AuditErr("oops")
+	test.AssertDeepEquals(t, mock.GetAll(), []string{
+		"INFO: Simulated: Blocked issuance for example.com: rule=[ExactBlockedNames:example.com]",
+		"WARNING: Simulated: oops",
+	})
+}
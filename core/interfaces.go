@@ -8,7 +8,10 @@ import (
 // TODO(#5891): Move this interface to a more appropriate location.
 type PolicyAuthority interface {
 	WillingToIssueWildcards([]identifier.ACMEIdentifier) error
-	ChallengesFor(identifier.ACMEIdentifier) ([]Challenge, error)
+	ChallengesFor(identifier.ACMEIdentifier, int64) ([]Challenge, error)
 	ChallengeTypeEnabled(AcmeChallenge) bool
+	ChallengeTypeAllowed(identifier.ACMEIdentifier, int64, AcmeChallenge) bool
 	CheckAuthz(*Authorization) error
+	ConfusableMatch(domain string) (label, target, rule string)
+	ReviewRequired(domain string) string
 }
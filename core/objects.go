@@ -23,14 +23,15 @@ type AcmeStatus string
 
 // These statuses are the states of authorizations, challenges, and registrations
 const (
-	StatusUnknown     = AcmeStatus("unknown")     // Unknown status; the default
-	StatusPending     = AcmeStatus("pending")     // In process; client has next action
-	StatusProcessing  = AcmeStatus("processing")  // In process; server has next action
-	StatusReady       = AcmeStatus("ready")       // Order is ready for finalization
-	StatusValid       = AcmeStatus("valid")       // Object is valid
-	StatusInvalid     = AcmeStatus("invalid")     // Validation failed
-	StatusRevoked     = AcmeStatus("revoked")     // Object no longer valid
-	StatusDeactivated = AcmeStatus("deactivated") // Object has been deactivated
+	StatusUnknown       = AcmeStatus("unknown")       // Unknown status; the default
+	StatusPending       = AcmeStatus("pending")       // In process; client has next action
+	StatusProcessing    = AcmeStatus("processing")    // In process; server has next action
+	StatusReady         = AcmeStatus("ready")         // Order is ready for finalization
+	StatusValid         = AcmeStatus("valid")         // Object is valid
+	StatusInvalid       = AcmeStatus("invalid")       // Validation failed
+	StatusRevoked       = AcmeStatus("revoked")       // Object no longer valid
+	StatusDeactivated   = AcmeStatus("deactivated")   // Object has been deactivated
+	StatusPendingReview = AcmeStatus("pendingReview") // Order awaits manual review by an operator
 )
 
 // AcmeResource values identify different types of ACME resources
@@ -87,11 +88,28 @@ var OCSPStatusToInt = map[OCSPStatus]int{
 	OCSPStatusNotReady: -1,
 }
 
+// IssuanceState defines how far the RA has progressed in issuing the
+// certificate for a given order.
+type IssuanceState string
+
+// These states are the steps of issuing a certificate for an order. An order
+// moves from IssuanceStarted to IssuancePrecertificateIssued to
+// IssuanceComplete, or to IssuanceAbandoned from either of the first two.
+const (
+	IssuanceStarted              = IssuanceState("started")        // Issuance begun; no precertificate yet
+	IssuancePrecertificateIssued = IssuanceState("precertificate") // Precertificate issued; no final certificate yet
+	IssuanceComplete             = IssuanceState("complete")       // Final certificate issued and order finalized
+	IssuanceAbandoned            = IssuanceState("abandoned")      // Issuance given up on; order failed
+)
+
 // DNSPrefix is attached to DNS names in DNS challenges
 const DNSPrefix = "_acme-challenge"
 
 type RawCertificateRequest struct {
 	CSR JSONBuffer `json:"csr"` // The encoded CSR
+	// AlternateCSR is an optional second encoded CSR, with a different key
+	// type, for which a second certificate is issued under the same order.
+	AlternateCSR JSONBuffer `json:"alternateCSR,omitempty"`
 }
 
 // Registration objects represent non-public metadata attached
@@ -144,6 +162,13 @@ type ValidationRecord struct {
 	//   ...
 	// }
 	AddressesTried []net.IP `json:"addressesTried,omitempty"`
+	// DNSSEC is the DNSSEC status ("secure" or "insecure") of the DNS lookup
+	// made for this record, if the VA validated DNSSEC itself.
+	DNSSEC string `json:"dnssec,omitempty"`
+	// CNAMEChain is the sequence of names which CNAME and DNAME records
+	// redirected the DNS-01 TXT lookup to, in the order they were followed,
+	// e.g. when _acme-challenge is delegated to a separate validation zone.
+	CNAMEChain []string `json:"cnameChain,omitempty"`
 }
 
 func looksLikeKeyAuthorization(str string) error {
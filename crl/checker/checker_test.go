@@ -12,6 +12,7 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/crl/crl_x509"
 	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/test"
 )
 
@@ -49,7 +50,7 @@ func TestDiff(t *testing.T) {
 	issuer, signer, err := issuance.LoadIssuer(issuance.IssuerLoc{
 		File:     "../../test/hierarchy/int-e1.key.pem",
 		CertFile: "../../test/hierarchy/int-e1.cert.pem",
-	})
+	}, metrics.NoopRegisterer)
 	test.AssertNotError(t, err, "loading test issuer")
 
 	now := time.Now()
@@ -58,7 +58,7 @@ func setupTestUploadCRL(t *testing.T) (*crlStorer, *issuance.Issuer) {
 	e1, e1Signer, err := issuance.LoadIssuer(issuance.IssuerLoc{
 		File:     "../../test/hierarchy/int-e1.key.pem",
 		CertFile: "../../test/hierarchy/int-e1.cert.pem",
-	})
+	}, metrics.NoopRegisterer)
 	test.AssertNotError(t, err, "loading fake ECDSA issuer cert")
 
 	storer, err := New(
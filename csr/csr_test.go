@@ -28,7 +28,7 @@ var testingPolicy = &goodkey.KeyPolicy{
 
 type mockPA struct{}
 
-func (pa *mockPA) ChallengesFor(identifier identifier.ACMEIdentifier) (challenges []core.Challenge, err error) {
+func (pa *mockPA) ChallengesFor(identifier identifier.ACMEIdentifier, regID int64) (challenges []core.Challenge, err error) {
 	return
 }
 
@@ -45,10 +45,22 @@ func (pa *mockPA) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	return true
 }
 
+func (pa *mockPA) ChallengeTypeAllowed(identifier identifier.ACMEIdentifier, regID int64, t core.AcmeChallenge) bool {
+	return true
+}
+
 func (pa *mockPA) CheckAuthz(a *core.Authorization) error {
 	return nil
 }
 
+func (pa *mockPA) ConfusableMatch(domain string) (string, string, string) {
+	return "", "", ""
+}
+
+func (pa *mockPA) ReviewRequired(domain string) string {
+	return ""
+}
+
 func TestVerifyCSR(t *testing.T) {
 	private, err := rsa.GenerateKey(rand.Reader, 2048)
 	test.AssertNotError(t, err, "error generating test key")
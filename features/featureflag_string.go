@@ -25,15 +25,24 @@ func _() {
 	_ = x[AsyncFinalize-14]
 	_ = x[RequireCommonName-15]
 	_ = x[StoreLintingCertificateInsteadOfPrecertificate-16]
+	_ = x[TrackIssuanceState-17]
+	_ = x[DualCertificateOrders-18]
+	_ = x[EnforceMultiCAA-19]
+	_ = x[MultiCAAFullResults-20]
+	_ = x[StoreValidationPerspectives-21]
+	_ = x[DeduplicateValidations-22]
+	_ = x[OrderReviews-23]
+	_ = x[TrackTermsAgreements-24]
 }
 
-const _FeatureFlag_name = "unusedStoreRevokerInfoROCSPStage6ROCSPStage7CAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsECDSAForAllServeRenewalInfoAllowUnrecognizedFeaturesExpirationMailerUsesJoinCertCheckerChecksValidationsCertCheckerRequiresValidationsAsyncFinalizeRequireCommonNameStoreLintingCertificateInsteadOfPrecertificate"
+const _FeatureFlag_name = "unusedStoreRevokerInfoROCSPStage6ROCSPStage7CAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsECDSAForAllServeRenewalInfoAllowUnrecognizedFeaturesExpirationMailerUsesJoinCertCheckerChecksValidationsCertCheckerRequiresValidationsAsyncFinalizeRequireCommonNameStoreLintingCertificateInsteadOfPrecertificateTrackIssuanceStateDualCertificateOrdersEnforceMultiCAAMultiCAAFullResultsStoreValidationPerspectivesDeduplicateValidationsOrderReviewsTrackTermsAgreements"
 
-var _FeatureFlag_index = [...]uint16{0, 6, 22, 33, 44, 64, 77, 91, 109, 120, 136, 161, 185, 213, 243, 256, 273, 319}
+var _FeatureFlag_index = [...]uint16{0, 6, 22, 33, 44, 64, 77, 91, 109, 120, 136, 161, 185, 213, 243, 256, 273, 319, 337, 358, 373, 392, 419, 441, 453, 473}
 
 func (i FeatureFlag) String() string {
-	if i < 0 || i >= FeatureFlag(len(_FeatureFlag_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_FeatureFlag_index)-1 {
 		return "FeatureFlag(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _FeatureFlag_name[_FeatureFlag_index[i]:_FeatureFlag_index[i+1]]
+	return _FeatureFlag_name[_FeatureFlag_index[idx]:_FeatureFlag_index[idx+1]]
 }
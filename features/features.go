@@ -75,6 +75,51 @@ const (
 	// was issued even if there is a power outage or other error between
 	// signing the precertificate and writing it to the database.
 	StoreLintingCertificateInsteadOfPrecertificate
+
+	// TrackIssuanceState causes the RA to durably record the progress of each
+	// order's issuance in the SA's issuanceStates table. This allows the
+	// issuance-resumer to find issuances which were interrupted (e.g. by an RA
+	// crash) between issuing a precertificate and issuing the corresponding
+	// final certificate, and to either complete or explicitly abandon them.
+	TrackIssuanceState
+
+	// DualCertificateOrders allows a finalize request to include a second CSR
+	// with a different key type from the first. The RA then issues one
+	// certificate for each CSR under the same order, and the SA stores the
+	// serial of the second (alternate) certificate alongside the order. Rate
+	// limits are counted only once, for the primary certificate.
+	DualCertificateOrders
+
+	// EnforceMultiCAA causes the VA to ask each remote VA to check CAA as well
+	// whenever it checks CAA itself, both during validation and for the RA's
+	// CAA rechecks at finalization, and to block on the results in order to
+	// make a decision with them.
+	EnforceMultiCAA
+	// MultiCAAFullResults will cause the main VA to send remote CAA checks and
+	// wait for all of the results, not just the threshold required to make a
+	// decision, so that differentials can be logged.
+	MultiCAAFullResults
+
+	// StoreValidationPerspectives causes the SA to store the result of each
+	// validation from each network perspective, as reported by the VA, in the
+	// validationPerspectives table when an authorization is finalized.
+	StoreValidationPerspectives
+
+	// DeduplicateValidations causes the VA to share a single network attempt
+	// and result between concurrent validations of the same identifier,
+	// challenge type, token and key authorization.
+	DeduplicateValidations
+
+	// OrderReviews causes the RA to queue orders for names which require
+	// manual review, and the SA to record them in the orderReviews table and
+	// keep them in the pendingReview status until an operator approves them.
+	OrderReviews
+
+	// TrackTermsAgreements causes the SA to record each account's agreements
+	// to versions of the terms of service in the termsAgreements table, the
+	// RA to record them when accounts agree to a new version, and the WFE to
+	// enforce the MinimumTermsOfServiceVersion.
+	TrackTermsAgreements
 )
 
 // List of features and their default value, protected by fMu
@@ -97,6 +142,14 @@ var features = map[FeatureFlag]bool{
 	RequireCommonName:              true,
 
 	StoreLintingCertificateInsteadOfPrecertificate: false,
+	TrackIssuanceState:          false,
+	DualCertificateOrders:       false,
+	EnforceMultiCAA:             false,
+	MultiCAAFullResults:         false,
+	StoreValidationPerspectives: false,
+	DeduplicateValidations:      false,
+	OrderReviews:                false,
+	TrackTermsAgreements:        false,
 }
 
 var fMu = new(sync.RWMutex)
@@ -144,6 +144,8 @@ func ValidationRecordToPB(record core.ValidationRecord) (*corepb.ValidationRecor
 		AddressUsed:       addrUsed,
 		Url:               record.URL,
 		AddressesTried:    addrsTried,
+		Dnssec:            record.DNSSEC,
+		CnameChain:        record.CNAMEChain,
 	}, nil
 }
 
@@ -171,6 +173,8 @@ func PBToValidationRecord(in *corepb.ValidationRecord) (record core.ValidationRe
 		AddressUsed:       addrUsed,
 		URL:               in.Url,
 		AddressesTried:    addrsTried,
+		DNSSEC:            in.Dnssec,
+		CNAMEChain:        in.CnameChain,
 	}, nil
 }
 
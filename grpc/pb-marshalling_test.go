@@ -106,6 +106,8 @@ func TestValidationRecord(t *testing.T) {
 		AddressUsed:       ip,
 		URL:               "http://exampleA.com",
 		AddressesTried:    []net.IP{ip},
+		DNSSEC:            "secure",
+		CNAMEChain:        []string{"exampleA.validation.example.net"},
 	}
 
 	pb, err := ValidationRecordToPB(vr)
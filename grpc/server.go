@@ -53,6 +53,7 @@ type serverBuilder struct {
 	services      map[string]service
 	healthSrv     *health.Server
 	checkInterval time.Duration
+	sharedChecks  []func(context.Context) error
 	logger        blog.Logger
 	err           error
 }
@@ -72,6 +73,15 @@ func (sb *serverBuilder) WithCheckInterval(i time.Duration) *serverBuilder {
 	return sb
 }
 
+// WithHealthCheck adds a deep health check which applies to every service
+// exposed by this server, in addition to any health check implemented by the
+// service itself. It is intended for dependencies shared by all of a server's
+// services, such as an HSM.
+func (sb *serverBuilder) WithHealthCheck(check func(context.Context) error) *serverBuilder {
+	sb.sharedChecks = append(sb.sharedChecks, check)
+	return sb
+}
+
 // Add registers a new service (consisting of its description and its
 // implementation) to the set of services which will be exposed by this server.
 // It returns the modified-in-place serverBuilder so that calls can be chained.
@@ -193,17 +203,24 @@ func (sb *serverBuilder) Build(tlsConfig *tls.Config, statsRegistry prometheus.R
 	}
 
 	// Initialize long-running health checks of all services which implement the
-	// checker interface.
+	// checker interface, or which are subject to shared health checks.
 	if sb.checkInterval <= 0 {
 		sb.checkInterval = 5 * time.Second
 	}
 	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
 	for _, s := range sb.services {
+		if s.desc == &healthpb.Health_ServiceDesc {
+			continue
+		}
+		checks := append([]func(context.Context) error{}, sb.sharedChecks...)
 		check, ok := s.impl.(checker)
-		if !ok {
+		if ok {
+			checks = append(checks, check.Health)
+		}
+		if len(checks) == 0 {
 			continue
 		}
-		sb.initLongRunningCheck(healthCtx, s.desc.ServiceName, check.Health)
+		sb.initLongRunningCheck(healthCtx, s.desc.ServiceName, allChecks(checks))
 	}
 
 	// Start a goroutine which listens for a termination signal, and then
@@ -218,6 +235,20 @@ func (sb *serverBuilder) Build(tlsConfig *tls.Config, statsRegistry prometheus.R
 	return start, nil
 }
 
+// allChecks returns a health check which passes only if all of the given
+// health checks pass.
+func allChecks(checks []func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for _, check := range checks {
+			err := check(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // initLongRunningCheck initializes a goroutine which will periodically check
 // the health of the provided service and update the health server accordingly.
 func (sb *serverBuilder) initLongRunningCheck(shutdownCtx context.Context, service string, checkImpl func(context.Context) error) {
@@ -70,3 +70,15 @@ func Test_serverBuilder_initLongRunningCheck(t *testing.T) {
 	test.Assert(t, len(serving) == 2, "expected two serving log lines")
 	test.Assert(t, len(notServing) == 1, "expected one not serving log line")
 }
+
+func Test_allChecks(t *testing.T) {
+	t.Parallel()
+	pass := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return errors.New("oops") }
+
+	err := allChecks([]func(context.Context) error{pass, pass})(context.Background())
+	test.AssertNotError(t, err, "expected all checks to pass")
+
+	err = allChecks([]func(context.Context) error{pass, fail})(context.Background())
+	test.AssertError(t, err, "expected a check to fail")
+}
@@ -7,7 +7,7 @@ import (
 )
 
 // ExtractSuffix returns the public suffix of the domain using only the "ICANN"
-// section of the Public Suffix List database.
+// section of the Public Suffix List currently in use.
 // If the domain does not end in a suffix that belongs to an IANA-assigned
 // domain, ExtractSuffix returns an error.
 func ExtractSuffix(name string) (string, error) {
@@ -15,7 +15,7 @@ func ExtractSuffix(name string) (string, error) {
 		return "", fmt.Errorf("Blank name argument passed to ExtractSuffix")
 	}
 
-	rule := publicsuffix.DefaultList.Find(name, &publicsuffix.FindOptions{IgnorePrivate: true, DefaultRule: nil})
+	rule := currentList().Find(name, &publicsuffix.FindOptions{IgnorePrivate: true, DefaultRule: nil})
 	if rule == nil {
 		return "", fmt.Errorf("Domain %s has no IANA TLD", name)
 	}
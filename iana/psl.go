@@ -0,0 +1,115 @@
+package iana
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weppos/publicsuffix-go/publicsuffix"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/reloader"
+)
+
+// psl is the Public Suffix List used by ExtractSuffix and Domain. It's the
+// list compiled into publicsuffix-go unless SetPublicSuffixListFile has been
+// called.
+var psl = struct {
+	sync.RWMutex
+	list    *publicsuffix.List
+	version string
+}{
+	list:    publicsuffix.DefaultList,
+	version: publicsuffix.ListVersion,
+}
+
+// currentList returns the Public Suffix List currently in use.
+func currentList() *publicsuffix.List {
+	psl.RLock()
+	defer psl.RUnlock()
+	return psl.list
+}
+
+// PublicSuffixListVersion returns the version of the Public Suffix List
+// currently in use.
+func PublicSuffixListVersion() string {
+	psl.RLock()
+	defer psl.RUnlock()
+	return psl.version
+}
+
+// SetPublicSuffixListFile loads the Public Suffix List in the given file, in
+// the format of https://publicsuffix.org/list/public_suffix_list.dat, and uses
+// it in place of the compiled-in list from then on. It returns an error if the
+// first load fails, and starts a reloader in case the file changes. The version
+// of the list in use is exported as the public_suffix_list_version metric.
+func SetPublicSuffixListFile(f string, stats prometheus.Registerer, logger blog.Logger) error {
+	versionGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "public_suffix_list_version",
+		Help: "A gauge with value 1 labelled with the version of the Public Suffix List in use",
+	}, []string{"version"})
+	stats.MustRegister(versionGauge)
+
+	_, err := reloader.New(f, func(contents []byte) error {
+		list, err := publicsuffix.NewListFromString(string(contents), nil)
+		if err != nil {
+			return err
+		}
+		if list.Size() == 0 {
+			return errors.New("public suffix list has no rules")
+		}
+		version := pslVersion(contents)
+		logger.Infof("loaded public suffix list, version: %s", version)
+
+		psl.Lock()
+		psl.list = list
+		psl.version = version
+		psl.Unlock()
+
+		versionGauge.Reset()
+		versionGauge.WithLabelValues(version).Set(1)
+		return nil
+	}, logger)
+	return err
+}
+
+// pslVersion returns the version of the Public Suffix List in contents, from
+// its "// VERSION:" and "// COMMIT:" header comments if it has them, or else
+// the SHA-256 hash of contents.
+func pslVersion(contents []byte) string {
+	var version, commit string
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "//") {
+			// The header comments end at the first rule.
+			break
+		}
+		if v, ok := strings.CutPrefix(line, "// VERSION:"); ok {
+			version = strings.TrimSpace(v)
+		}
+		if c, ok := strings.CutPrefix(line, "// COMMIT:"); ok {
+			commit = strings.TrimSpace(c)
+		}
+	}
+	if version == "" {
+		hash := sha256.Sum256(contents)
+		return "sha256:" + hex.EncodeToString(hash[:])
+	}
+	if commit != "" {
+		version += " (" + commit + ")"
+	}
+	return version
+}
+
+// Domain returns the registered domain, or eTLD+1, of name according to the
+// Public Suffix List currently in use, including its private section. It
+// returns an error if name is itself a public suffix.
+func Domain(name string) (string, error) {
+	return publicsuffix.DomainFromListWithOptions(currentList(), name, publicsuffix.DefaultFindOptions)
+}
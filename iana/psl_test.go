@@ -0,0 +1,68 @@
+package iana
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestSetPublicSuffixListFile(t *testing.T) {
+	defaultList, defaultVersion := currentList(), PublicSuffixListVersion()
+	t.Cleanup(func() {
+		psl.Lock()
+		psl.list, psl.version = defaultList, defaultVersion
+		psl.Unlock()
+	})
+
+	_, err := ExtractSuffix("www.example")
+	test.AssertError(t, err, "compiled-in list has the example TLD")
+	domain, err := Domain("www.pages.example")
+	test.AssertNotError(t, err, "Domain failed")
+	test.AssertEquals(t, domain, "pages.example")
+
+	err = SetPublicSuffixListFile("testdata/does-not-exist.dat", prometheus.NewRegistry(), blog.NewMock())
+	test.AssertError(t, err, "loaded a missing public suffix list")
+
+	stats := prometheus.NewRegistry()
+	err = SetPublicSuffixListFile("testdata/public_suffix_list.dat", stats, blog.NewMock())
+	test.AssertNotError(t, err, "loading public suffix list")
+	version := "2023-06-01_12-00-00_UTC (0123456789abcdef0123456789abcdef01234567)"
+	test.AssertEquals(t, PublicSuffixListVersion(), version)
+
+	families, err := stats.Gather()
+	test.AssertNotError(t, err, "gathering metrics")
+	test.AssertEquals(t, len(families), 1)
+	test.AssertEquals(t, families[0].GetName(), "public_suffix_list_version")
+	test.AssertEquals(t, families[0].Metric[0].Label[0].GetValue(), version)
+
+	for _, tc := range []struct {
+		name, suffix, domain string
+	}{
+		{"www.example", "example", "www.example"},
+		{"a.b.co.example", "co.example", "b.co.example"},
+		// Private suffixes are used by Domain but not by ExtractSuffix.
+		{"a.b.pages.example", "example", "b.pages.example"},
+		{"www.example.com", "com", "example.com"},
+	} {
+		suffix, err := ExtractSuffix(tc.name)
+		test.AssertNotError(t, err, "ExtractSuffix failed")
+		test.AssertEquals(t, suffix, tc.suffix)
+		domain, err := Domain(tc.name)
+		test.AssertNotError(t, err, "Domain failed")
+		test.AssertEquals(t, domain, tc.domain)
+	}
+
+	// Suffixes which aren't in the loaded list are no longer known.
+	_, err = ExtractSuffix("example.org")
+	test.AssertError(t, err, "ExtractSuffix succeeded for a TLD not in the loaded list")
+	_, err = Domain("co.example")
+	test.AssertError(t, err, "Domain succeeded for a public suffix")
+}
+
+func TestPSLVersion(t *testing.T) {
+	test.AssertEquals(t, pslVersion([]byte("// VERSION: 1\ncom\n// VERSION: 2\n")), "1")
+	test.AssertEquals(t, pslVersion([]byte("com\n")), "sha256:03b795529d1bb07b5b27bbc3e1ffc9bbbf7f9832688d4f5d7840faf8b57dfecd")
+}
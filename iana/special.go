@@ -0,0 +1,278 @@
+package iana
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+func parseCidr(network string, comment string) net.IPNet {
+	_, net, err := net.ParseCIDR(network)
+	if err != nil {
+		panic(fmt.Sprintf("error parsing %s (%s): %s", network, comment, err))
+	}
+	return *net
+}
+
+var (
+	// Sourced from https://www.iana.org/assignments/iana-ipv4-special-registry/iana-ipv4-special-registry.xhtml
+	// where Source, Destination, or Globally Reachable is False. These are used
+	// unless LoadIPv4SpecialRegistry is called.
+	defaultReservedV4Networks = []net.IPNet{
+		parseCidr("10.0.0.0/8", "RFC 1918: Private-Use"),
+		parseCidr("172.16.0.0/12", "RFC 1918: Private-Use"),
+		parseCidr("192.168.0.0/16", "RFC 1918: Private-Use"),
+		parseCidr("127.0.0.0/8", "RFC 5735: Loopback"),
+		parseCidr("0.0.0.0/8", "RFC 1122 Section 3.2.1.3: This network"),
+		parseCidr("169.254.0.0/16", "RFC 3927: Link Local"),
+		parseCidr("192.0.0.0/24", "RFC 5736: IETF Protocol Assignments"),
+		parseCidr("192.0.2.0/24", "RFC 5737: Documentation (TEST-NET-1)"),
+		parseCidr("198.51.100.0/24", "RFC 5737: Documentation (TEST-NET-2)"),
+		parseCidr("203.0.113.0/24", "RFC 5737: Documentation (TEST-NET-3)"),
+		parseCidr("198.18.0.0/15", "RFC 2544, Errata 423: Benchmarking"),
+		parseCidr("240.0.0.0/4", "RFC 1112: Reserved"),
+		parseCidr("255.255.255.255/32", "RFC 919 Section 7: Limited Broadcast"),
+		parseCidr("100.64.0.0/10", "RFC 6598: Shared Address Space"),
+	}
+	// Sourced from https://www.iana.org/assignments/iana-ipv6-special-registry/iana-ipv6-special-registry.xhtml
+	// where Source, Destination, or Globally Reachable is False. These are used
+	// unless LoadIPv6SpecialRegistry is called.
+	defaultReservedV6Networks = []net.IPNet{
+		parseCidr("::/128", "RFC 4291: Unspecified Address"),
+		parseCidr("::1/128", "RFC 4291: Loopback Address"),
+		parseCidr("::ffff:0:0/96", "RFC 4291: IPv4-mapped Address"),
+		parseCidr("100::/64", "RFC 6666: Discard Address Block"),
+		parseCidr("2001::/23", "RFC 2928: IETF Protocol Assignments"),
+		parseCidr("2001:2::/48", "RFC 5180: Benchmarking"),
+		parseCidr("2001:db8::/32", "RFC 3849: Documentation"),
+		parseCidr("2001::/32", "RFC 4380: TEREDO"),
+		parseCidr("fc00::/7", "RFC 4193: Unique-Local"),
+		parseCidr("fe80::/10", "RFC 4291: Section 2.5.6 Link-Scoped Unicast"),
+	}
+
+	// Networks which aren't in the special-purpose registries, or aren't
+	// marked as reserved there, but which are always treated as reserved.
+	additionalReservedV4Networks = []net.IPNet{
+		parseCidr("224.0.0.0/4", "RFC 3171: Multicast"),
+		// See 2002::/16 below.
+		parseCidr("192.88.99.0/24", "RFC 7526: 6to4 Relay Anycast deprecated"),
+	}
+	additionalReservedV6Networks = []net.IPNet{
+		parseCidr("ff00::/8", "RFC 4291: Section 2.7 Multicast"),
+		// We disable validations to IPs under the 6to4 anycase prefix because
+		// there's too much risk of a malicious actor advertising the prefix and
+		// answering validations for a 6to4 host they do not control.
+		// https://community.letsencrypt.org/t/problems-validating-ipv6-against-host-running-6to4/18312/9
+		parseCidr("2002::/16", "RFC 7526: 6to4 anycast prefix deprecated"),
+	}
+)
+
+// special holds the reserved IP networks and special-use domain names used by
+// IsReservedIPv4, IsReservedIPv6, and SpecialUseDomain.
+var special = struct {
+	sync.RWMutex
+	v4Networks []net.IPNet
+	v6Networks []net.IPNet
+	// domains are lowercase and have no trailing dot.
+	domains []string
+}{
+	v4Networks: append(defaultReservedV4Networks, additionalReservedV4Networks...),
+	v6Networks: append(defaultReservedV6Networks, additionalReservedV6Networks...),
+}
+
+// IsReservedIPv4 returns true if ip is in a reserved IPv4 network, to which
+// Boulder won't connect for validation.
+func IsReservedIPv4(ip net.IP) bool {
+	special.RLock()
+	defer special.RUnlock()
+	for _, network := range special.v4Networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReservedIPv6 returns true if ip is in a reserved IPv6 network, to which
+// Boulder won't connect for validation.
+func IsReservedIPv6(ip net.IP) bool {
+	special.RLock()
+	defer special.RUnlock()
+	for _, network := range special.v6Networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// SpecialUseDomain returns the special-use domain name which name is equal to
+// or a subdomain of, and true, or else false. There are none unless
+// LoadSpecialUseDomainsRegistry has been called.
+func SpecialUseDomain(name string) (string, bool) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	special.RLock()
+	defer special.RUnlock()
+	for _, domain := range special.domains {
+		if name == domain || strings.HasSuffix(name, "."+domain) {
+			return domain, true
+		}
+	}
+	return "", false
+}
+
+// LoadIPv4SpecialRegistry loads the IANA IPv4 Special-Purpose Address Registry
+// from the CSV file at path, as published at
+// https://www.iana.org/assignments/iana-ipv4-special-registry/iana-ipv4-special-registry-1.csv,
+// and uses it in place of the compiled-in reserved IPv4 networks. It returns
+// an error, leaving the networks in use unchanged, if the file can't be parsed.
+func LoadIPv4SpecialRegistry(path string) error {
+	networks, err := loadSpecialRegistry(path, net.IPv4len)
+	if err != nil {
+		return err
+	}
+	special.Lock()
+	special.v4Networks = append(networks, additionalReservedV4Networks...)
+	special.Unlock()
+	return nil
+}
+
+// LoadIPv6SpecialRegistry loads the IANA IPv6 Special-Purpose Address Registry
+// from the CSV file at path, as published at
+// https://www.iana.org/assignments/iana-ipv6-special-registry/iana-ipv6-special-registry-1.csv,
+// and uses it in place of the compiled-in reserved IPv6 networks. It returns
+// an error, leaving the networks in use unchanged, if the file can't be parsed.
+func LoadIPv6SpecialRegistry(path string) error {
+	networks, err := loadSpecialRegistry(path, net.IPv6len)
+	if err != nil {
+		return err
+	}
+	special.Lock()
+	special.v6Networks = append(networks, additionalReservedV6Networks...)
+	special.Unlock()
+	return nil
+}
+
+// LoadSpecialUseDomainsRegistry loads the IANA Special-Use Domain Names
+// registry from the CSV file at path, as published at
+// https://www.iana.org/assignments/special-use-domain-names/special-use-domain.csv.
+// It returns an error, leaving the special-use domain names in use unchanged,
+// if the file can't be parsed.
+func LoadSpecialUseDomainsRegistry(path string) error {
+	records, err := readRegistry(path, "Name")
+	if err != nil {
+		return err
+	}
+	var domains []string
+	for _, record := range records {
+		name := record["Name"]
+		domain, ok := strings.CutSuffix(name, ".")
+		if !ok || domain == "" || !specialUseDomainRegexp.MatchString(domain) {
+			return fmt.Errorf("%s: invalid special-use domain name %q", path, name)
+		}
+		domains = append(domains, strings.ToLower(domain))
+	}
+	special.Lock()
+	special.domains = domains
+	special.Unlock()
+	return nil
+}
+
+var (
+	// specialUseDomainRegexp matches the names in the Special-Use Domain Names
+	// registry, without their trailing dot.
+	specialUseDomainRegexp = regexp.MustCompile(`^([a-zA-Z0-9-]+\.)*[a-zA-Z0-9-]+$`)
+	// footnoteRegexp matches the footnote references which follow some values
+	// in the registries, e.g. "False [1]".
+	footnoteRegexp = regexp.MustCompile(`\s*\[\d+\]`)
+)
+
+// loadSpecialRegistry returns the networks in the Special-Purpose Address
+// Registry CSV file at path for which Source, Destination, or Globally
+// Reachable is False. Every address block must be a network of addresses
+// ipLen bytes long.
+func loadSpecialRegistry(path string, ipLen int) ([]net.IPNet, error) {
+	records, err := readRegistry(path, "Address Block", "Source", "Destination", "Globally Reachable")
+	if err != nil {
+		return nil, err
+	}
+	var networks []net.IPNet
+	for _, record := range records {
+		reserved := false
+		for _, column := range []string{"Source", "Destination", "Globally Reachable"} {
+			switch value := footnoteRegexp.ReplaceAllString(record[column], ""); value {
+			case "False":
+				reserved = true
+			case "True", "N/A", "":
+			default:
+				return nil, fmt.Errorf("%s: invalid %s value %q", path, column, record[column])
+			}
+		}
+		for _, block := range strings.Split(footnoteRegexp.ReplaceAllString(record["Address Block"], ""), ",") {
+			_, network, err := net.ParseCIDR(strings.TrimSpace(block))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			if len(network.Mask) != ipLen {
+				return nil, fmt.Errorf("%s: address block %q is the wrong IP version", path, block)
+			}
+			if reserved {
+				networks = append(networks, *network)
+			}
+		}
+	}
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("%s: no reserved address blocks", path)
+	}
+	return networks, nil
+}
+
+// readRegistry reads the IANA registry CSV file at path, which must have a
+// header row including each of the given columns and at least one record. It
+// returns each record as a map from column to value.
+func readRegistry(path string, columns ...string) ([]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: reading header: %w", path, err)
+	}
+	for _, column := range columns {
+		if !slices.Contains(header, column) {
+			return nil, fmt.Errorf("%s: missing %q column", path, column)
+		}
+	}
+
+	var records []map[string]string
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		record := make(map[string]string, len(header))
+		for i, h := range header {
+			record[h] = strings.TrimSpace(row[i])
+		}
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: no records", path)
+	}
+	return records, nil
+}
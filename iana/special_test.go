@@ -0,0 +1,171 @@
+package iana
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// restoreSpecialRegistries restores the compiled-in special-purpose registry
+// data when the test finishes.
+func restoreSpecialRegistries(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		special.Lock()
+		special.v4Networks = append(defaultReservedV4Networks, additionalReservedV4Networks...)
+		special.v6Networks = append(defaultReservedV6Networks, additionalReservedV6Networks...)
+		special.domains = nil
+		special.Unlock()
+	})
+}
+
+func TestReservedIPDefaults(t *testing.T) {
+	for _, tc := range []struct {
+		ip       string
+		reserved bool
+	}{
+		{"127.0.0.1", true},
+		{"192.168.254.254", true},
+		{"10.255.0.3", true},
+		{"172.16.255.255", true},
+		{"172.31.255.255", true},
+		{"128.0.0.1", false},
+		{"192.169.255.255", false},
+		{"9.255.0.255", false},
+		{"172.32.255.255", false},
+	} {
+		test.AssertEquals(t, IsReservedIPv4(net.ParseIP(tc.ip)), tc.reserved)
+	}
+	for _, tc := range []struct {
+		ip       string
+		reserved bool
+	}{
+		{"::0", true},
+		{"::1", true},
+		{"::2", false},
+		{"fe80::1", true},
+		{"febf::1", true},
+		{"fec0::1", false},
+		{"feff::1", false},
+		{"ff00::1", true},
+		{"ff10::1", true},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", true},
+		{"2002::", true},
+		{"2002:ffff:ffff:ffff:ffff:ffff:ffff:ffff", true},
+		{"0100::", true},
+		{"0100::0000:ffff:ffff:ffff:ffff", true},
+		{"0100::0001:0000:0000:0000:0000", false},
+	} {
+		test.AssertEquals(t, IsReservedIPv6(net.ParseIP(tc.ip)), tc.reserved)
+	}
+}
+
+func TestLoadSpecialRegistries(t *testing.T) {
+	restoreSpecialRegistries(t)
+
+	// The compiled-in data has no special-use domain names.
+	_, ok := SpecialUseDomain("www.example.com")
+	test.Assert(t, !ok, "found a special-use domain without loading the registry")
+	test.Assert(t, !IsReservedIPv6(net.ParseIP("64:ff9b:1::1")), "64:ff9b:1::1 is reserved by default")
+
+	err := LoadIPv4SpecialRegistry("../test/iana/iana-ipv4-special-registry-1.csv")
+	test.AssertNotError(t, err, "loading IPv4 special-purpose registry")
+	err = LoadIPv6SpecialRegistry("../test/iana/iana-ipv6-special-registry-1.csv")
+	test.AssertNotError(t, err, "loading IPv6 special-purpose registry")
+	err = LoadSpecialUseDomainsRegistry("../test/iana/special-use-domain.csv")
+	test.AssertNotError(t, err, "loading special-use domain names registry")
+
+	for _, tc := range []struct {
+		ip       string
+		reserved bool
+	}{
+		{"10.1.2.3", true},
+		{"127.0.0.1", true},
+		{"192.0.0.170", true},
+		{"255.255.255.255", true},
+		// Not in the registry, but always reserved.
+		{"224.0.0.1", true},
+		{"192.88.99.1", true},
+		{"192.31.196.1", false},
+		{"8.8.8.8", false},
+	} {
+		test.AssertEquals(t, IsReservedIPv4(net.ParseIP(tc.ip)), tc.reserved)
+	}
+	for _, tc := range []struct {
+		ip       string
+		reserved bool
+	}{
+		{"::1", true},
+		{"64:ff9b:1::1", true},
+		{"2001:db8::1", true},
+		{"fd00::1", true},
+		{"ff02::1", true},
+		{"2002::1", true},
+		{"64:ff9b::1", false},
+		{"2606:4700::1", false},
+	} {
+		test.AssertEquals(t, IsReservedIPv6(net.ParseIP(tc.ip)), tc.reserved)
+	}
+	for _, tc := range []struct {
+		name, domain string
+	}{
+		{"example.com", "example.com"},
+		{"WWW.Example.COM.", "example.com"},
+		{"foo.onion", "onion"},
+		{"router.home.arpa", "home.arpa"},
+		{"1.2.168.192.in-addr.arpa", "168.192.in-addr.arpa"},
+		{"notexample.com", ""},
+		{"arpa", ""},
+	} {
+		domain, ok := SpecialUseDomain(tc.name)
+		test.AssertEquals(t, domain, tc.domain)
+		test.AssertEquals(t, ok, tc.domain != "")
+	}
+}
+
+func TestLoadMalformedSpecialRegistries(t *testing.T) {
+	restoreSpecialRegistries(t)
+	header := "Address Block,Name,RFC,Allocation Date,Termination Date,Source,Destination,Forwardable,Globally Reachable,Reserved-by-Protocol\n"
+	write := func(contents string) string {
+		path := filepath.Join(t.TempDir(), "registry.csv")
+		err := os.WriteFile(path, []byte(contents), 0640)
+		test.AssertNotError(t, err, "writing registry")
+		return path
+	}
+
+	for _, tc := range []struct {
+		name     string
+		contents string
+		err      string
+	}{
+		{"empty", "", "reading header: EOF"},
+		{"no records", header, "no records"},
+		{"missing column", "Address Block,Name\n10.0.0.0/8,Private-Use\n", `missing "Source" column`},
+		{"wrong field count", header + "10.0.0.0/8,Private-Use\n", "wrong number of fields"},
+		{"bad CIDR", header + "10.0.0.0/33,Private-Use,[RFC1918],1996-02,N/A,True,True,True,False,False\n", "invalid CIDR address"},
+		{"bad boolean", header + "10.0.0.0/8,Private-Use,[RFC1918],1996-02,N/A,True,True,True,Maybe,False\n", `invalid Globally Reachable value "Maybe"`},
+		{"wrong version", header + "fc00::/7,Unique-Local,[RFC4193],2005-10,N/A,True,True,True,False,False\n", "wrong IP version"},
+		{"nothing reserved", header + "192.31.196.0/24,AS112-v4,[RFC7535],2014-12,N/A,True,True,True,True,False\n", "no reserved address blocks"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := LoadIPv4SpecialRegistry(write(tc.contents))
+			test.AssertError(t, err, "loaded malformed registry")
+			test.AssertContains(t, err.Error(), tc.err)
+		})
+	}
+	// A failed load leaves the networks in use unchanged.
+	test.Assert(t, IsReservedIPv4(net.ParseIP("10.1.2.3")), "10.1.2.3 isn't reserved")
+
+	for _, contents := range []string{
+		"Name,Reference\nexample,[RFC6761]\n",
+		"Name,Reference\n.,[RFC6761]\n",
+		"Name,Reference\nexa mple.,[RFC6761]\n",
+	} {
+		err := LoadSpecialUseDomainsRegistry(write(contents))
+		test.AssertError(t, err, "loaded malformed special-use domain names registry")
+		test.AssertContains(t, err.Error(), "invalid special-use domain name")
+	}
+}
@@ -26,11 +26,13 @@ import (
 	cttls "github.com/google/certificate-transparency-go/tls"
 	ctx509 "github.com/google/certificate-transparency-go/x509"
 	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/ocsp"
 
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/linter"
+	"github.com/letsencrypt/boulder/pkcs11helpers"
 	"github.com/letsencrypt/boulder/policyasn1"
 	"github.com/letsencrypt/boulder/privatekey"
 	"github.com/letsencrypt/pkcs11key/v4"
@@ -84,19 +86,27 @@ type IssuerLoc struct {
 	PKCS11 *pkcs11key.Config `validate:"required_without_all=ConfigFile File"`
 	// A file from which a certificate will be read and parsed.
 	CertFile string `validate:"required"`
-	// Number of sessions to open with the HSM. For maximum performance,
-	// this should be equal to the number of cores in the HSM. Defaults to 1.
+	// Number of sessions in the pool used to sign with the HSM. For maximum
+	// performance, this should be equal to the number of cores in the HSM.
+	// Defaults to 1.
 	NumSessions int
+	// The label of a key pair on the same token which is used for nothing but
+	// health checks. If set, health checks of the issuer's key also sign and
+	// verify a random digest with this key, through the same pool of sessions.
+	// Only used for keys in an HSM.
+	HealthCheckKeyLabel string
 }
 
-// LoadIssuer loads a signer (private key) and certificate from the locations specified.
-func LoadIssuer(location IssuerLoc) (*Certificate, crypto.Signer, error) {
+// LoadIssuer loads a signer (private key) and certificate from the locations
+// specified. Signers for keys in an HSM use a pool of sessions, whose metrics
+// are registered with stats, and implement pkcs11helpers.HealthChecker.
+func LoadIssuer(location IssuerLoc, stats prometheus.Registerer) (*Certificate, crypto.Signer, error) {
 	issuerCert, err := LoadCertificate(location.CertFile)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	signer, err := loadSigner(location, issuerCert)
+	signer, err := loadSigner(location, issuerCert, stats)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,7 +125,7 @@ func LoadCertificate(path string) (*Certificate, error) {
 	return NewCertificate(cert)
 }
 
-func loadSigner(location IssuerLoc, cert *Certificate) (crypto.Signer, error) {
+func loadSigner(location IssuerLoc, cert *Certificate, stats prometheus.Registerer) (crypto.Signer, error) {
 	if location.File != "" {
 		signer, _, err := privatekey.Load(location.File)
 		if err != nil {
@@ -150,8 +160,15 @@ func loadSigner(location IssuerLoc, cert *Certificate) (crypto.Signer, error) {
 		numSessions = 1
 	}
 
-	return pkcs11key.NewPool(numSessions, pkcs11Config.Module,
-		pkcs11Config.TokenLabel, pkcs11Config.PIN, cert.PublicKey)
+	pool, err := pkcs11helpers.InitializePoolForToken(pkcs11Config.Module,
+		pkcs11Config.TokenLabel, pkcs11Config.PIN, numSessions, stats)
+	if err != nil {
+		return nil, err
+	}
+	if location.HealthCheckKeyLabel != "" {
+		return pool.NewSignerWithHealthCheckKey("", cert.PublicKey, location.HealthCheckKeyLabel)
+	}
+	return pool.NewSigner("", cert.PublicKey)
 }
 
 // Profile is the validated structure created by reading in ProfileConfigs and IssuerConfigs
@@ -583,6 +583,45 @@ func (sa *StorageAuthority) AddBlockedKey(ctx context.Context, req *sapb.AddBloc
 	return &emptypb.Empty{}, nil
 }
 
+// AddBlockedName is a mock.
+func (sa *StorageAuthority) AddBlockedName(_ context.Context, req *sapb.AddBlockedNameRequest, _ ...grpc.CallOption) (*sapb.BlockedName, error) {
+	return &sapb.BlockedName{
+		Id:        1,
+		Name:      req.Name,
+		BlockType: req.BlockType,
+		Reason:    req.Reason,
+		Creator:   req.Creator,
+		Created:   timestamppb.New(sa.clk.Now()),
+		Expires:   req.Expires,
+	}, nil
+}
+
+// RemoveBlockedName is a mock.
+func (sa *StorageAuthority) RemoveBlockedName(_ context.Context, _ *sapb.RemoveBlockedNameRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// DecideOrderReview is a mock.
+func (sa *StorageAuthority) DecideOrderReview(_ context.Context, req *sapb.DecideOrderReviewRequest, _ ...grpc.CallOption) (*sapb.OrderReview, error) {
+	status := "denied"
+	if req.Approved {
+		status = "approved"
+	}
+	return &sapb.OrderReview{
+		Id:             1,
+		OrderID:        req.OrderID,
+		Status:         status,
+		Reviewer:       req.Reviewer,
+		DecisionReason: req.Reason,
+		Decided:        timestamppb.New(sa.clk.Now()),
+	}, nil
+}
+
+// AddTermsAgreement is a mock.
+func (sa *StorageAuthority) AddTermsAgreement(_ context.Context, _ *sapb.TermsAgreement, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
 // KeyBlocked is a mock
 func (sa *StorageAuthorityReadOnly) KeyBlocked(ctx context.Context, req *sapb.KeyBlockedRequest, _ ...grpc.CallOption) (*sapb.Exists, error) {
 	return &sapb.Exists{Exists: false}, nil
@@ -593,6 +632,46 @@ func (sa *StorageAuthorityReadOnly) IncidentsForSerial(ctx context.Context, req
 	return &sapb.Incidents{}, nil
 }
 
+// GetBlockedNames is a mock.
+func (sa *StorageAuthorityReadOnly) GetBlockedNames(_ context.Context, _ *sapb.GetBlockedNamesRequest, _ ...grpc.CallOption) (*sapb.BlockedNames, error) {
+	return &sapb.BlockedNames{}, nil
+}
+
+// GetTermsAgreement is a mock.
+func (sa *StorageAuthorityReadOnly) GetTermsAgreement(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.TermsAgreement, error) {
+	return nil, berrors.NotFoundError("no terms of service agreement for registration ID %d", req.Id)
+}
+
+// GetOrderReviews is a mock.
+func (sa *StorageAuthorityReadOnly) GetOrderReviews(_ context.Context, _ *sapb.GetOrderReviewsRequest, _ ...grpc.CallOption) (*sapb.OrderReviews, error) {
+	return &sapb.OrderReviews{}, nil
+}
+
+// GetValidationPerspectives is a mock.
+func (sa *StorageAuthorityReadOnly) GetValidationPerspectives(_ context.Context, _ *sapb.AuthorizationID2, _ ...grpc.CallOption) (*sapb.ValidationPerspectives, error) {
+	return &sapb.ValidationPerspectives{}, nil
+}
+
+// SetIssuanceState is a mock
+func (sa *StorageAuthority) SetIssuanceState(_ context.Context, _ *sapb.IssuanceState, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// AddValidationPerspectives is a mock
+func (sa *StorageAuthority) AddValidationPerspectives(_ context.Context, _ *sapb.AddValidationPerspectivesRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// GetIssuanceState is a mock
+func (sa *StorageAuthorityReadOnly) GetIssuanceState(_ context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*sapb.IssuanceState, error) {
+	return nil, berrors.NotFoundError("no issuance state found for order %d", req.Id)
+}
+
+// GetStalledIssuances is a mock
+func (sa *StorageAuthorityReadOnly) GetStalledIssuances(_ context.Context, _ *sapb.GetStalledIssuancesRequest, _ ...grpc.CallOption) (*sapb.IssuanceStates, error) {
+	return &sapb.IssuanceStates{}, nil
+}
+
 // Publisher is a mock
 type PublisherClient struct {
 	// empty
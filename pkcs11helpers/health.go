@@ -0,0 +1,113 @@
+package pkcs11helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HealthChecker is implemented by keys whose availability can be checked
+// without using them to sign anything, such as the signers returned by
+// Pool.NewSigner.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// KeyHealth periodically checks a set of keys, and reports whether the most
+// recent check of every key succeeded. Its Health method satisfies the
+// interface used by the gRPC server for deep health checks, so that a hung or
+// failing HSM shows up as an unhealthy service rather than only as signing
+// timeouts.
+type KeyHealth struct {
+	keys     map[string]HealthChecker
+	interval time.Duration
+	clk      clock.Clock
+	latency  *prometheus.HistogramVec
+
+	sync.Mutex
+	lastRun time.Time
+	lastErr error
+	// running is non-nil, and is closed when done, while a check is in
+	// progress.
+	running chan struct{}
+}
+
+// NewKeyHealth returns a KeyHealth which checks the given keys, identified in
+// errors and metrics by their map keys, no more often than once per interval.
+func NewKeyHealth(keys map[string]HealthChecker, interval time.Duration, stats prometheus.Registerer, clk clock.Clock) *KeyHealth {
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pkcs11_key_health_check_latency_seconds",
+		Help:    "Latency of key health checks, by key and result",
+		Buckets: []float64{.001, .005, .01, .05, .1, .5, 1, 5, 10},
+	}, []string{"key", "result"})
+	stats.MustRegister(latency)
+
+	return &KeyHealth{
+		keys:     keys,
+		interval: interval,
+		clk:      clk,
+		latency:  latency,
+	}
+}
+
+// Health starts a check of every key if none has been started within the last
+// interval, and returns the result of the most recent check. If a check is in
+// progress it waits for that to finish, or for ctx to be done, whichever
+// happens first. A check which hangs is never abandoned, so a hung HSM causes
+// every subsequent call to fail until it recovers.
+func (h *KeyHealth) Health(ctx context.Context) error {
+	h.Lock()
+	if h.running == nil && (h.lastRun.IsZero() || h.clk.Since(h.lastRun) >= h.interval) {
+		h.running = make(chan struct{})
+		h.lastRun = h.clk.Now()
+		go h.check(h.running)
+	}
+	running := h.running
+	lastErr := h.lastErr
+	h.Unlock()
+
+	if running == nil {
+		return lastErr
+	}
+	select {
+	case <-running:
+		h.Lock()
+		defer h.Unlock()
+		return h.lastErr
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for key health check: %w", ctx.Err())
+	}
+}
+
+// check checks every key in turn, records the result, and closes done.
+func (h *KeyHealth) check(done chan struct{}) {
+	names := make([]string, 0, len(h.keys))
+	for name := range h.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		start := h.clk.Now()
+		err := h.keys[name].CheckHealth(context.Background())
+		result := "success"
+		if err != nil {
+			result = "error"
+			errs = append(errs, fmt.Errorf("health check of key %q: %w", name, err))
+		}
+		h.latency.With(prometheus.Labels{"key": name, "result": result}).Observe(h.clk.Since(start).Seconds())
+	}
+
+	h.Lock()
+	h.lastErr = errors.Join(errs...)
+	h.running = nil
+	h.Unlock()
+	close(done)
+}
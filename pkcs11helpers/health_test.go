@@ -0,0 +1,85 @@
+package pkcs11helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// testChecker is a HealthChecker which counts its checks and optionally
+// blocks or fails them.
+type testChecker struct {
+	calls int
+	block chan struct{}
+	err   error
+}
+
+func (c *testChecker) CheckHealth(context.Context) error {
+	c.calls++
+	if c.block != nil {
+		<-c.block
+	}
+	return c.err
+}
+
+func TestKeyHealth(t *testing.T) {
+	good := &testChecker{}
+	bad := &testChecker{err: errors.New("oops")}
+
+	clk := clock.NewFake()
+	h := NewKeyHealth(map[string]HealthChecker{"good": good}, time.Minute, metrics.NoopRegisterer, clk)
+	err := h.Health(context.Background())
+	test.AssertNotError(t, err, "healthy key reported unhealthy")
+	test.AssertEquals(t, good.calls, 1)
+
+	// Within the interval, the previous result is reused.
+	clk.Add(30 * time.Second)
+	err = h.Health(context.Background())
+	test.AssertNotError(t, err, "healthy key reported unhealthy")
+	test.AssertEquals(t, good.calls, 1)
+
+	clk.Add(30 * time.Second)
+	err = h.Health(context.Background())
+	test.AssertNotError(t, err, "healthy key reported unhealthy")
+	test.AssertEquals(t, good.calls, 2)
+
+	h = NewKeyHealth(map[string]HealthChecker{"good": good, "bad": bad}, time.Minute, metrics.NoopRegisterer, clk)
+	err = h.Health(context.Background())
+	test.AssertError(t, err, "failing key reported healthy")
+	test.AssertContains(t, err.Error(), `"bad"`)
+	test.AssertNotContains(t, err.Error(), `"good"`)
+	test.AssertMetricWithLabelsEquals(t, h.latency, map[string]string{"key": "bad", "result": "error"}, 1)
+	test.AssertMetricWithLabelsEquals(t, h.latency, map[string]string{"key": "good", "result": "success"}, 1)
+}
+
+func TestKeyHealthHung(t *testing.T) {
+	hung := &testChecker{block: make(chan struct{})}
+
+	clk := clock.NewFake()
+	h := NewKeyHealth(map[string]HealthChecker{"hung": hung}, time.Minute, metrics.NoopRegisterer, clk)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := h.Health(ctx)
+	test.AssertErrorIs(t, err, context.DeadlineExceeded)
+
+	// Even once the interval has passed, a second check isn't started while
+	// the first is still hung.
+	clk.Add(time.Hour)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = h.Health(ctx)
+	test.AssertErrorIs(t, err, context.DeadlineExceeded)
+
+	// Once the HSM recovers, the hung check completes.
+	close(hung.block)
+	err = h.Health(context.Background())
+	test.AssertNotError(t, err, "recovered key reported unhealthy")
+	test.AssertEquals(t, hung.calls, 1)
+}
@@ -1,6 +1,7 @@
 package pkcs11helpers
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
@@ -37,8 +38,10 @@ func Initialize(module string, slot uint, pin string) (*Session, error) {
 	if ctx == nil {
 		return nil, errors.New("failed to load module")
 	}
+	// A module may already have been initialized if it was previously used to
+	// open a session to another slot, which is not an error.
 	err := ctx.Initialize()
-	if err != nil {
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
 		return nil, fmt.Errorf("couldn't initialize context: %s", err)
 	}
 
@@ -61,16 +64,15 @@ var curveOIDs = map[string]asn1.ObjectIdentifier{
 	"P-384": {1, 3, 132, 0, 34},
 }
 
-// getPublicKeyID looks up the given public key in the PKCS#11 token, and
-// returns its ID as a []byte, for use in looking up the corresponding private
-// key.
+// getPublicKeyID looks up the given public key, with the given label unless it
+// is empty, in the PKCS#11 token, and returns its ID as a []byte, for use in
+// looking up the corresponding private key.
 func (s *Session) getPublicKeyID(label string, publicKey crypto.PublicKey) ([]byte, error) {
 	var template []*pkcs11.Attribute
 	switch key := publicKey.(type) {
 	case *rsa.PublicKey:
 		template = []*pkcs11.Attribute{
 			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
-			pkcs11.NewAttribute(pkcs11.CKA_LABEL, []byte(label)),
 			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
 			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, key.N.Bytes()),
 			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, big.NewInt(int64(key.E)).Bytes()),
@@ -93,7 +95,6 @@ func (s *Session) getPublicKeyID(label string, publicKey crypto.PublicKey) ([]by
 		}
 		template = []*pkcs11.Attribute{
 			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
-			pkcs11.NewAttribute(pkcs11.CKA_LABEL, []byte(label)),
 			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
 			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, curveOID),
 			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, marshalledPoint),
@@ -102,6 +103,10 @@ func (s *Session) getPublicKeyID(label string, publicKey crypto.PublicKey) ([]by
 		return nil, fmt.Errorf("unsupported public key of type %T", publicKey)
 	}
 
+	if label != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, []byte(label)))
+	}
+
 	publicKeyHandle, err := s.FindObject(template)
 	if err != nil {
 		return nil, err
@@ -261,11 +266,11 @@ func (s *Session) Sign(object pkcs11.ObjectHandle, keyType keyType, digest []byt
 
 	err := s.Module.SignInit(s.Session, mech, object)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize signing operation: %s", err)
+		return nil, fmt.Errorf("failed to initialize signing operation: %w", err)
 	}
 	signature, err := s.Module.Sign(s.Session, digest)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign data: %s", err)
+		return nil, fmt.Errorf("failed to sign data: %w", err)
 	}
 
 	return signature, nil
@@ -319,16 +324,21 @@ func (p *x509Signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts)
 	}
 
 	if p.keyType == ECDSAKey {
-		// Convert from the PKCS#11 format to the RFC 5480 format so that
-		// it can be used in a X.509 certificate
-		r := big.NewInt(0).SetBytes(signature[:len(signature)/2])
-		s := big.NewInt(0).SetBytes(signature[len(signature)/2:])
-		signature, err = asn1.Marshal(struct {
-			R, S *big.Int
-		}{R: r, S: s})
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert signature to RFC 5480 format: %s", err)
-		}
+		return ecdsaSignatureToRFC5480(signature)
+	}
+	return signature, nil
+}
+
+// ecdsaSignatureToRFC5480 converts an ECDSA signature from the PKCS#11 format
+// to the RFC 5480 format so that it can be used in a X.509 certificate.
+func ecdsaSignatureToRFC5480(signature []byte) ([]byte, error) {
+	r := big.NewInt(0).SetBytes(signature[:len(signature)/2])
+	s := big.NewInt(0).SetBytes(signature[len(signature)/2:])
+	signature, err := asn1.Marshal(struct {
+		R, S *big.Int
+	}{R: r, S: s})
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert signature to RFC 5480 format: %s", err)
 	}
 	return signature, nil
 }
@@ -337,28 +347,77 @@ func (p *x509Signer) Public() crypto.PublicKey {
 	return p.pub
 }
 
-// NewSigner constructs an x509Signer for the private key object associated with the
-// given label and public key.
-func (s *Session) NewSigner(label string, publicKey crypto.PublicKey) (crypto.Signer, error) {
-	var kt keyType
+// keyTypeOf returns the keyType corresponding to the given public key.
+func keyTypeOf(publicKey crypto.PublicKey) (keyType, error) {
 	switch publicKey.(type) {
 	case *rsa.PublicKey:
-		kt = RSAKey
+		return RSAKey, nil
 	case *ecdsa.PublicKey:
-		kt = ECDSAKey
+		return ECDSAKey, nil
 	default:
-		return nil, fmt.Errorf("unsupported public key of type %T", publicKey)
+		return 0, fmt.Errorf("unsupported public key of type %T", publicKey)
 	}
+}
 
+// FindPrivateKey gets a handle to the private key object associated with the
+// given label and public key.
+func (s *Session) FindPrivateKey(label string, publicKey crypto.PublicKey) (pkcs11.ObjectHandle, error) {
 	publicKeyID, err := s.getPublicKeyID(label, publicKey)
 	if err != nil {
-		return nil, fmt.Errorf("looking up public key: %s", err)
+		return 0, fmt.Errorf("looking up public key: %s", err)
 	}
 
 	// Fetch the private key by matching its id to the public key handle.
 	privateKeyHandle, err := s.getPrivateKey(publicKeyID)
 	if err != nil {
-		return nil, fmt.Errorf("getting private key: %s", err)
+		return 0, fmt.Errorf("getting private key: %s", err)
+	}
+	return privateKeyHandle, nil
+}
+
+// FindPublicKey returns the RSA or ECDSA public key of the key pair with the
+// given label.
+func (s *Session) FindPublicKey(label string) (crypto.PublicKey, error) {
+	publicKeyHandle, err := s.FindObject([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, []byte(label)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("looking up public key: %s", err)
+	}
+
+	attrs, err := s.Module.GetAttributeValue(s.Session, publicKeyHandle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) != 1 || attrs[0].Type != pkcs11.CKA_KEY_TYPE {
+		return nil, fmt.Errorf("invalid result from GetAttributeValue")
+	}
+	// Compare against encoded attributes rather than decoding the CK_ULONG
+	// ourselves, since its encoding depends on the platform.
+	switch {
+	case bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA).Value):
+		return s.GetRSAPublicKey(publicKeyHandle)
+	case bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC).Value):
+		return s.GetECDSAPublicKey(publicKeyHandle)
+	default:
+		return nil, fmt.Errorf("unsupported key type %x", attrs[0].Value)
+	}
+}
+
+// NewSigner constructs an x509Signer for the private key object associated with the
+// given label and public key.
+func (s *Session) NewSigner(label string, publicKey crypto.PublicKey) (crypto.Signer, error) {
+	kt, err := keyTypeOf(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyHandle, err := s.FindPrivateKey(label, publicKey)
+	if err != nil {
+		return nil, err
 	}
 	return &x509Signer{
 		session:      s,
@@ -386,6 +445,13 @@ type MockCtx struct {
 	FindObjectsInitFunc   func(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) error
 	FindObjectsFunc       func(sh pkcs11.SessionHandle, max int) ([]pkcs11.ObjectHandle, bool, error)
 	FindObjectsFinalFunc  func(sh pkcs11.SessionHandle) error
+	OpenSessionFunc       func(slotID uint, flags uint) (pkcs11.SessionHandle, error)
+	CloseSessionFunc      func(sh pkcs11.SessionHandle) error
+	LoginFunc             func(sh pkcs11.SessionHandle, userType uint, pin string) error
+	CreateObjectFunc      func(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+	GenerateKeyFunc       func(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+	WrapKeyFunc           func(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, wrappingKey, key pkcs11.ObjectHandle) ([]byte, error)
+	UnwrapKeyFunc         func(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, unwrappingKey pkcs11.ObjectHandle, wrappedKey []byte, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
 }
 
 func (mc MockCtx) GenerateKeyPair(s pkcs11.SessionHandle, m []*pkcs11.Mechanism, a1 []*pkcs11.Attribute, a2 []*pkcs11.Attribute) (pkcs11.ObjectHandle, pkcs11.ObjectHandle, error) {
@@ -419,3 +485,31 @@ func (mc MockCtx) FindObjects(sh pkcs11.SessionHandle, max int) ([]pkcs11.Object
 func (mc MockCtx) FindObjectsFinal(sh pkcs11.SessionHandle) error {
 	return mc.FindObjectsFinalFunc(sh)
 }
+
+func (mc MockCtx) OpenSession(slotID uint, flags uint) (pkcs11.SessionHandle, error) {
+	return mc.OpenSessionFunc(slotID, flags)
+}
+
+func (mc MockCtx) CloseSession(sh pkcs11.SessionHandle) error {
+	return mc.CloseSessionFunc(sh)
+}
+
+func (mc MockCtx) Login(sh pkcs11.SessionHandle, userType uint, pin string) error {
+	return mc.LoginFunc(sh, userType, pin)
+}
+
+func (mc MockCtx) CreateObject(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	return mc.CreateObjectFunc(sh, temp)
+}
+
+func (mc MockCtx) GenerateKey(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	return mc.GenerateKeyFunc(sh, m, temp)
+}
+
+func (mc MockCtx) WrapKey(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, wrappingKey, key pkcs11.ObjectHandle) ([]byte, error) {
+	return mc.WrapKeyFunc(sh, m, wrappingKey, key)
+}
+
+func (mc MockCtx) UnwrapKey(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, unwrappingKey pkcs11.ObjectHandle, wrappedKey []byte, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	return mc.UnwrapKeyFunc(sh, m, unwrappingKey, wrappedKey, temp)
+}
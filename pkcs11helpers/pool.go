@@ -0,0 +1,361 @@
+package pkcs11helpers
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/pkcs11"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolCtx is the subset of the PKCS#11 API needed to manage a pool of sessions,
+// in addition to the operations performed with those sessions.
+type PoolCtx interface {
+	PKCtx
+	OpenSession(slotID uint, flags uint) (pkcs11.SessionHandle, error)
+	CloseSession(sh pkcs11.SessionHandle) error
+	Login(sh pkcs11.SessionHandle, userType uint, pin string) error
+}
+
+// Pool is a fixed-size pool of sessions with a single slot of a PKCS#11
+// module. Unlike a Session, it is safe for concurrent use. If the module stops
+// recognizing one of the pool's session handles, as happens when an HSM is
+// restarted, that session is reopened automatically.
+type Pool struct {
+	module   PoolCtx
+	slot     uint
+	pin      string
+	size     int
+	sessions chan *Session
+
+	latency *prometheus.HistogramVec
+	reopens prometheus.Counter
+}
+
+// InitializePool loads the given PKCS#11 module and returns a Pool of `size`
+// sessions with the given slot, each logged in using the given PIN.
+func InitializePool(module string, slot uint, pin string, size int, stats prometheus.Registerer) (*Pool, error) {
+	ctx, err := initializeModule(module)
+	if err != nil {
+		return nil, err
+	}
+	return NewPool(ctx, slot, pin, size, stats)
+}
+
+// InitializePoolForToken loads the given PKCS#11 module and returns a Pool of
+// `size` sessions with the slot holding the token with the given label, each
+// logged in using the given PIN.
+func InitializePoolForToken(module string, tokenLabel string, pin string, size int, stats prometheus.Registerer) (*Pool, error) {
+	ctx, err := initializeModule(module)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get token info for slot %d: %w", slot, err)
+		}
+		if strings.TrimSpace(info.Label) == tokenLabel {
+			return NewPool(ctx, slot, pin, size, stats)
+		}
+	}
+	return nil, fmt.Errorf("no token with label %q", tokenLabel)
+}
+
+// initializeModule loads and initializes the given PKCS#11 module. A module
+// may already have been initialized if it was previously used to open
+// sessions with another slot or token, which is not an error.
+func initializeModule(module string) (*pkcs11.Ctx, error) {
+	ctx := pkcs11.New(module)
+	if ctx == nil {
+		return nil, errors.New("failed to load module")
+	}
+	err := ctx.Initialize()
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return nil, fmt.Errorf("couldn't initialize context: %s", err)
+	}
+	return ctx, nil
+}
+
+// NewPool returns a Pool of `size` sessions with the given slot of an already
+// initialized PKCS#11 module, each logged in using the given PIN.
+func NewPool(module PoolCtx, slot uint, pin string, size int, stats prometheus.Registerer) (*Pool, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("invalid pool size %d: must be at least 1", size)
+	}
+
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pkcs11_operation_latency_seconds",
+		Help:    "Latency of PKCS#11 operations performed using a session pool, by operation and result",
+		Buckets: []float64{.001, .005, .01, .05, .1, .5, 1, 5, 10},
+	}, []string{"operation", "result"})
+	err := stats.Register(latency)
+	if err != nil {
+		are := prometheus.AlreadyRegisteredError{}
+		if !errors.As(err, &are) {
+			return nil, err
+		}
+		latency = are.ExistingCollector.(*prometheus.HistogramVec)
+	}
+
+	reopens := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pkcs11_session_reopens",
+		Help: "Number of PKCS#11 sessions reopened after the module reported their handle as invalid",
+	})
+	err = stats.Register(reopens)
+	if err != nil {
+		are := prometheus.AlreadyRegisteredError{}
+		if !errors.As(err, &are) {
+			return nil, err
+		}
+		reopens = are.ExistingCollector.(prometheus.Counter)
+	}
+
+	p := &Pool{
+		module:   module,
+		slot:     slot,
+		pin:      pin,
+		size:     size,
+		sessions: make(chan *Session, size),
+		latency:  latency,
+		reopens:  reopens,
+	}
+	for i := 0; i < size; i++ {
+		session, err := p.openSession()
+		if err != nil {
+			p.closeSessions(i)
+			return nil, err
+		}
+		p.sessions <- &Session{module, session}
+	}
+	return p, nil
+}
+
+// openSession opens a new session with the pool's slot and logs it in.
+func (p *Pool) openSession() (pkcs11.SessionHandle, error) {
+	session, err := p.module.OpenSession(p.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't open session: %w", err)
+	}
+
+	// Login state is shared by all of an application's sessions with a token,
+	// so every session after the first finds itself already logged in.
+	err = p.module.Login(session, pkcs11.CKU_USER, p.pin)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		_ = p.module.CloseSession(session)
+		return 0, fmt.Errorf("couldn't login: %w", err)
+	}
+	return session, nil
+}
+
+// Do calls f with a session from the pool, waiting until one is available or
+// ctx is done, and records the latency and result of the call under the given
+// operation name. If f fails because the module no longer recognizes the
+// session's handle, the session is reopened and f is called once more.
+func (p *Pool) Do(ctx context.Context, operation string, f func(*Session) error) error {
+	var s *Session
+	select {
+	case s = <-p.sessions:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { p.sessions <- s }()
+
+	start := time.Now()
+	err := f(s)
+	if errors.Is(err, pkcs11.Error(pkcs11.CKR_SESSION_HANDLE_INVALID)) {
+		err = p.reopen(s)
+		if err == nil {
+			err = f(s)
+		}
+	}
+
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	p.latency.With(prometheus.Labels{"operation": operation, "result": result}).Observe(time.Since(start).Seconds())
+	return err
+}
+
+// reopen replaces the handle of the given session with that of a newly opened
+// session.
+func (p *Pool) reopen(s *Session) error {
+	session, err := p.openSession()
+	if err != nil {
+		return fmt.Errorf("reopening invalid session: %w", err)
+	}
+	// The old handle is already invalid, so there's nothing useful to do if
+	// closing it fails.
+	_ = p.module.CloseSession(s.Session)
+	s.Session = session
+	p.reopens.Inc()
+	return nil
+}
+
+// Close waits for every session in the pool to be returned, and then closes
+// them all. The pool must not be used afterwards.
+func (p *Pool) Close() {
+	p.closeSessions(p.size)
+}
+
+func (p *Pool) closeSessions(n int) {
+	for i := 0; i < n; i++ {
+		s := <-p.sessions
+		_ = p.module.CloseSession(s.Session)
+	}
+}
+
+// NewSigner constructs a crypto.Signer for the private key object associated
+// with the given label and public key, which signs using sessions from the
+// pool. If label is empty, the key is found by its public key alone. The
+// signer implements HealthChecker.
+func (p *Pool) NewSigner(label string, publicKey crypto.PublicKey) (crypto.Signer, error) {
+	return p.newPoolSigner(label, publicKey)
+}
+
+// NewSignerWithHealthCheckKey is like NewSigner, but the returned signer's
+// CheckHealth also runs SelfTest, through the pool, with the key pair labelled
+// healthCheckLabel. That key pair must be on the same token, and must not be
+// used for anything else: it lets health checks exercise the HSM's signing
+// path without ever signing with the key being checked.
+func (p *Pool) NewSignerWithHealthCheckKey(label string, publicKey crypto.PublicKey, healthCheckLabel string) (crypto.Signer, error) {
+	signer, err := p.newPoolSigner(label, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var healthCheckPub crypto.PublicKey
+	err = p.Do(context.Background(), "find_key", func(s *Session) error {
+		var err error
+		healthCheckPub, err = s.FindPublicKey(healthCheckLabel)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading health check key %q: %w", healthCheckLabel, err)
+	}
+	signer.healthCheckKey, err = p.newPoolSigner(healthCheckLabel, healthCheckPub)
+	if err != nil {
+		return nil, fmt.Errorf("loading health check key %q: %w", healthCheckLabel, err)
+	}
+	return signer, nil
+}
+
+func (p *Pool) newPoolSigner(label string, publicKey crypto.PublicKey) (*poolSigner, error) {
+	kt, err := keyTypeOf(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var privateKeyHandle pkcs11.ObjectHandle
+	err = p.Do(context.Background(), "find_key", func(s *Session) error {
+		var err error
+		privateKeyHandle, err = s.FindPrivateKey(label, publicKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &poolSigner{
+		pool:         p,
+		label:        label,
+		objectHandle: privateKeyHandle,
+		keyType:      kt,
+		pub:          publicKey,
+	}, nil
+}
+
+// poolSigner is the equivalent of x509Signer for keys used through a Pool.
+type poolSigner struct {
+	pool  *Pool
+	label string
+
+	// objectHandle is looked up again if the module stops recognizing it, so
+	// it is protected by a mutex.
+	sync.Mutex
+	objectHandle pkcs11.ObjectHandle
+	keyType      keyType
+
+	pub crypto.PublicKey
+
+	// healthCheckKey, if non-nil, is a key which CheckHealth signs with in
+	// place of this one.
+	healthCheckKey *poolSigner
+}
+
+// Sign signs a digest. If the signing key is ECDSA then the signature
+// is converted from the PKCS#11 format to the RFC 5480 format. For RSA keys a
+// conversion step is not needed.
+func (p *poolSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var signature []byte
+	err := p.pool.Do(context.Background(), "sign", func(s *Session) error {
+		p.Lock()
+		objectHandle := p.objectHandle
+		p.Unlock()
+
+		var err error
+		signature, err = s.Sign(objectHandle, p.keyType, digest, opts.HashFunc())
+		if errors.Is(err, pkcs11.Error(pkcs11.CKR_KEY_HANDLE_INVALID)) ||
+			errors.Is(err, pkcs11.Error(pkcs11.CKR_OBJECT_HANDLE_INVALID)) {
+			// Object handles may change when an HSM is restarted, so look the
+			// key up again and retry.
+			objectHandle, err = s.FindPrivateKey(p.label, p.pub)
+			if err != nil {
+				return err
+			}
+			p.Lock()
+			p.objectHandle = objectHandle
+			p.Unlock()
+			signature, err = s.Sign(objectHandle, p.keyType, digest, opts.HashFunc())
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if p.keyType == ECDSAKey {
+		return ecdsaSignatureToRFC5480(signature)
+	}
+	return signature, nil
+}
+
+func (p *poolSigner) Public() crypto.PublicKey {
+	return p.pub
+}
+
+// CheckHealth checks that the pool can reach the HSM and that the key can
+// still be found there, without signing anything with it. This exercises a
+// session in the same way as signing does, reopening it if the module no
+// longer recognizes its handle. If the signer has a health check key, it then
+// runs SelfTest with that key, to check that the HSM can still sign.
+func (p *poolSigner) CheckHealth(ctx context.Context) error {
+	err := p.pool.Do(ctx, "health_check", func(s *Session) error {
+		objectHandle, err := s.FindPrivateKey(p.label, p.pub)
+		if err != nil {
+			return err
+		}
+		p.Lock()
+		p.objectHandle = objectHandle
+		p.Unlock()
+		return nil
+	})
+	if err != nil || p.healthCheckKey == nil {
+		return err
+	}
+	err = SelfTest(p.healthCheckKey)
+	if err != nil {
+		return fmt.Errorf("self-test with health check key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package pkcs11helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// newPoolMock returns a MockCtx which hands out sequentially numbered session
+// handles, and reports every session after the first as already logged in.
+func newPoolMock() (*MockCtx, *[]pkcs11.SessionHandle) {
+	var mu sync.Mutex
+	var next pkcs11.SessionHandle
+	var closed []pkcs11.SessionHandle
+	ctx := &MockCtx{}
+	ctx.OpenSessionFunc = func(uint, uint) (pkcs11.SessionHandle, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		next++
+		return next, nil
+	}
+	ctx.LoginFunc = func(sh pkcs11.SessionHandle, _ uint, _ string) error {
+		if sh == 1 {
+			return nil
+		}
+		return pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)
+	}
+	ctx.CloseSessionFunc = func(sh pkcs11.SessionHandle) error {
+		mu.Lock()
+		defer mu.Unlock()
+		closed = append(closed, sh)
+		return nil
+	}
+	return ctx, &closed
+}
+
+func TestNewPool(t *testing.T) {
+	ctx, closed := newPoolMock()
+
+	_, err := NewPool(ctx, 0, "1234", 0, metrics.NoopRegisterer)
+	test.AssertError(t, err, "NewPool didn't fail with zero size")
+
+	pool, err := NewPool(ctx, 0, "1234", 3, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+	pool.Close()
+	test.AssertEquals(t, len(*closed), 3)
+
+	// A login failure other than already being logged in is fatal.
+	ctx, closed = newPoolMock()
+	ctx.LoginFunc = func(pkcs11.SessionHandle, uint, string) error {
+		return pkcs11.Error(pkcs11.CKR_PIN_INCORRECT)
+	}
+	_, err = NewPool(ctx, 0, "1234", 3, metrics.NoopRegisterer)
+	test.AssertError(t, err, "NewPool didn't fail with incorrect PIN")
+	test.AssertEquals(t, len(*closed), 1)
+}
+
+func TestPoolReopensInvalidSession(t *testing.T) {
+	ctx, closed := newPoolMock()
+	pool, err := NewPool(ctx, 0, "1234", 1, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+
+	var used []pkcs11.SessionHandle
+	err = pool.Do(context.Background(), "test", func(s *Session) error {
+		used = append(used, s.Session)
+		if s.Session == 1 {
+			return pkcs11.Error(pkcs11.CKR_SESSION_HANDLE_INVALID)
+		}
+		return nil
+	})
+	test.AssertNotError(t, err, "Do failed")
+	test.AssertDeepEquals(t, used, []pkcs11.SessionHandle{1, 2})
+	test.AssertDeepEquals(t, *closed, []pkcs11.SessionHandle{1})
+	test.AssertMetricWithLabelsEquals(t, pool.reopens, nil, 1)
+
+	// Other errors are returned without reopening the session.
+	used = nil
+	err = pool.Do(context.Background(), "test", func(s *Session) error {
+		used = append(used, s.Session)
+		return errors.New("oops")
+	})
+	test.AssertError(t, err, "Do didn't return error")
+	test.AssertDeepEquals(t, used, []pkcs11.SessionHandle{2})
+	test.AssertMetricWithLabelsEquals(t, pool.reopens, nil, 1)
+}
+
+func TestPoolDoWaitsForSession(t *testing.T) {
+	ctx, _ := newPoolMock()
+	pool, err := NewPool(ctx, 0, "1234", 1, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = pool.Do(context.Background(), "test", func(*Session) error {
+		// The pool's only session is in use, so this has to wait.
+		return pool.Do(cancelled, "test", func(*Session) error {
+			return nil
+		})
+	})
+	test.AssertErrorIs(t, err, context.Canceled)
+}
+
+func TestPoolSignerFindsKeyAgain(t *testing.T) {
+	ctx, _ := newPoolMock()
+	pool, err := NewPool(ctx, 0, "1234", 1, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+
+	// Every object lookup returns a new handle, and only the most recently
+	// returned handle is valid. Looking up a private key takes two lookups: one
+	// for the public key, and one for the private key.
+	var handle pkcs11.ObjectHandle
+	ctx.FindObjectsInitFunc = func(pkcs11.SessionHandle, []*pkcs11.Attribute) error {
+		return nil
+	}
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		handle++
+		return []pkcs11.ObjectHandle{handle}, false, nil
+	}
+	ctx.FindObjectsFinalFunc = func(pkcs11.SessionHandle) error {
+		return nil
+	}
+	ctx.GetAttributeValueFunc = func(pkcs11.SessionHandle, pkcs11.ObjectHandle, []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+		return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, []byte{1})}, nil
+	}
+	var signingHandle pkcs11.ObjectHandle
+	ctx.SignInitFunc = func(_ pkcs11.SessionHandle, _ []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error {
+		if o != handle {
+			return pkcs11.Error(pkcs11.CKR_KEY_HANDLE_INVALID)
+		}
+		signingHandle = o
+		return nil
+	}
+	ctx.SignFunc = func(pkcs11.SessionHandle, []byte) ([]byte, error) {
+		return make([]byte, 64), nil
+	}
+
+	signer, err := pool.NewSigner("label", key.Public())
+	test.AssertNotError(t, err, "NewSigner failed")
+
+	digest := make([]byte, 32)
+	_, err = signer.Sign(rand.Reader, digest, crypto.SHA256)
+	test.AssertNotError(t, err, "Sign failed")
+	test.AssertEquals(t, signingHandle, pkcs11.ObjectHandle(2))
+
+	// Invalidate the private key's handle, as if the HSM had been restarted.
+	handle++
+	_, err = signer.Sign(rand.Reader, digest, crypto.SHA256)
+	test.AssertNotError(t, err, "Sign failed")
+	test.AssertEquals(t, signingHandle, pkcs11.ObjectHandle(5))
+}
+
+func TestPoolSignerCheckHealth(t *testing.T) {
+	ctx, _ := newPoolMock()
+	pool, err := NewPool(ctx, 0, "1234", 1, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+
+	var found bool
+	ctx.FindObjectsInitFunc = func(pkcs11.SessionHandle, []*pkcs11.Attribute) error {
+		return nil
+	}
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		if !found {
+			return nil, false, nil
+		}
+		return []pkcs11.ObjectHandle{1}, false, nil
+	}
+	ctx.FindObjectsFinalFunc = func(pkcs11.SessionHandle) error {
+		return nil
+	}
+	ctx.GetAttributeValueFunc = func(pkcs11.SessionHandle, pkcs11.ObjectHandle, []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+		return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, []byte{1})}, nil
+	}
+	ctx.SignInitFunc = func(pkcs11.SessionHandle, []*pkcs11.Mechanism, pkcs11.ObjectHandle) error {
+		t.Fatal("health check signed")
+		return nil
+	}
+
+	found = true
+	signer, err := pool.NewSigner("", key.Public())
+	test.AssertNotError(t, err, "NewSigner failed")
+	checker, ok := signer.(HealthChecker)
+	test.Assert(t, ok, "pool signer doesn't implement HealthChecker")
+
+	err = checker.CheckHealth(context.Background())
+	test.AssertNotError(t, err, "CheckHealth failed")
+
+	// The check fails once the key can no longer be found.
+	found = false
+	err = checker.CheckHealth(context.Background())
+	test.AssertError(t, err, "CheckHealth didn't fail without the key")
+}
+
+func TestPoolSignerCheckHealthWithKey(t *testing.T) {
+	ctx, _ := newPoolMock()
+	pool, err := NewPool(ctx, 0, "1234", 1, metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "NewPool failed")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	healthCheckKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating health check key")
+
+	// The issuer's key pair has handles 1 and 2, and the health check key pair,
+	// found by its label, has handles 3 and 4.
+	var template []*pkcs11.Attribute
+	ctx.FindObjectsInitFunc = func(_ pkcs11.SessionHandle, tmpl []*pkcs11.Attribute) error {
+		template = tmpl
+		return nil
+	}
+	ctx.FindObjectsFunc = func(pkcs11.SessionHandle, int) ([]pkcs11.ObjectHandle, bool, error) {
+		var handle pkcs11.ObjectHandle = 1
+		for _, attr := range template {
+			if (attr.Type == pkcs11.CKA_LABEL && string(attr.Value) == "health") ||
+				(attr.Type == pkcs11.CKA_ID && string(attr.Value) == "health") {
+				handle = 3
+			}
+		}
+		for _, attr := range template {
+			if attr.Type == pkcs11.CKA_CLASS && bytes.Equal(attr.Value, pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY).Value) {
+				handle++
+			}
+		}
+		return []pkcs11.ObjectHandle{handle}, false, nil
+	}
+	ctx.FindObjectsFinalFunc = func(pkcs11.SessionHandle) error {
+		return nil
+	}
+	ctx.GetAttributeValueFunc = func(_ pkcs11.SessionHandle, o pkcs11.ObjectHandle, attrs []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+		if o == 1 {
+			return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, []byte("issuer"))}, nil
+		}
+		switch attrs[0].Type {
+		case pkcs11.CKA_KEY_TYPE:
+			return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC)}, nil
+		case pkcs11.CKA_EC_PARAMS:
+			return []*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, []byte{0x06, 0x08, 0x2A, 0x86, 0x48, 0xCE, 0x3D, 0x03, 0x01, 0x07}),
+				pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, elliptic.Marshal(elliptic.P256(), healthCheckKey.X, healthCheckKey.Y)),
+			}, nil
+		default:
+			return []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, []byte("health"))}, nil
+		}
+	}
+	ctx.SignInitFunc = func(_ pkcs11.SessionHandle, _ []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error {
+		if o != 4 {
+			t.Fatalf("health check signed with object %d", o)
+		}
+		return nil
+	}
+	var broken bool
+	ctx.SignFunc = func(_ pkcs11.SessionHandle, digest []byte) ([]byte, error) {
+		if broken {
+			return nil, pkcs11.Error(pkcs11.CKR_DEVICE_ERROR)
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, healthCheckKey, digest)
+		if err != nil {
+			return nil, err
+		}
+		return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...), nil
+	}
+
+	signer, err := pool.NewSignerWithHealthCheckKey("", key.Public(), "health")
+	test.AssertNotError(t, err, "NewSignerWithHealthCheckKey failed")
+	checker, ok := signer.(HealthChecker)
+	test.Assert(t, ok, "pool signer doesn't implement HealthChecker")
+
+	err = checker.CheckHealth(context.Background())
+	test.AssertNotError(t, err, "CheckHealth failed")
+
+	// The check fails once the HSM can no longer sign, even though the key can
+	// still be found.
+	broken = true
+	err = checker.CheckHealth(context.Background())
+	test.AssertError(t, err, "CheckHealth didn't fail when signing failed")
+}
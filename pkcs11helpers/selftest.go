@@ -0,0 +1,46 @@
+package pkcs11helpers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// SelfTest checks that a signer is working by signing a random digest and
+// verifying the resulting signature using the signer's public key. The digest
+// is never published, so the signature is of no use to anyone else. It's meant
+// for ceremonies; a running CA should use a HealthChecker instead, which only
+// runs SelfTest with a dedicated health check key, so as not to sign with its
+// production keys.
+func SelfTest(signer crypto.Signer) error {
+	random := make([]byte, 32)
+	_, err := rand.Read(random)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(random)
+
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("signing: %w", err)
+	}
+
+	switch pub := signer.Public().(type) {
+	case *rsa.PublicKey:
+		err = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature)
+		if err != nil {
+			return fmt.Errorf("verifying: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, digest[:], signature) {
+			return errors.New("verifying: invalid ECDSA signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key of type %T", pub)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package pkcs11helpers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// testSigner wraps a crypto.Signer, counting its signatures and optionally
+// blocking or failing them.
+type testSigner struct {
+	crypto.Signer
+	calls int
+	block chan struct{}
+	err   error
+}
+
+func (s *testSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.calls++
+	if s.block != nil {
+		<-s.block
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.Signer.Sign(rand, digest, opts)
+}
+
+func TestSelfTest(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	err = SelfTest(rsaKey)
+	test.AssertNotError(t, err, "RSA self-test failed")
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	err = SelfTest(ecdsaKey)
+	test.AssertNotError(t, err, "ECDSA self-test failed")
+
+	err = SelfTest(&testSigner{Signer: ecdsaKey, err: errors.New("oops")})
+	test.AssertError(t, err, "self-test didn't fail when signing failed")
+}
+
+// mismatchedSigner signs with one key but reports the public key of another.
+type mismatchedSigner struct {
+	crypto.Signer
+	pub crypto.PublicKey
+}
+
+func (s mismatchedSigner) Public() crypto.PublicKey {
+	return s.pub
+}
+
+func TestSelfTestMismatchedKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+
+	err = SelfTest(mismatchedSigner{key, otherKey.Public()})
+	test.AssertError(t, err, "self-test didn't fail with mismatched public key")
+}
@@ -0,0 +1,144 @@
+package policy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/reloader"
+	"github.com/letsencrypt/boulder/strictyaml"
+)
+
+// challengeRulesPolicy is a list of rules which restrict or extend the
+// challenge types offered for particular identifiers or accounts, on top of
+// the challenge types enabled in the PA's config.
+type challengeRulesPolicy struct {
+	Rules []challengeRule `yaml:"Rules"`
+}
+
+// challengeRule disables and then enables challenge types for the
+// identifiers and accounts it matches. Rules are applied in order, so a later
+// rule overrides an earlier one.
+type challengeRule struct {
+	// ID identifies the rule in logs and errors. It must be unique.
+	ID string `yaml:"ID"`
+	// Suffixes are domain names. The rule matches identifiers equal to or
+	// subdomains of any of them. Like TLDs, they are matched
+	// case-insensitively, and may be written with a trailing dot.
+	Suffixes []string `yaml:"Suffixes"`
+	// TLDs are top-level domains. The rule matches identifiers under any of
+	// them.
+	TLDs []string `yaml:"TLDs"`
+	// Accounts are account IDs. If any are given, the rule only matches
+	// identifiers requested by one of those accounts.
+	Accounts []int64 `yaml:"Accounts"`
+	// Disable are the challenge types which aren't offered for identifiers
+	// the rule matches.
+	Disable []core.AcmeChallenge `yaml:"Disable"`
+	// Enable are the challenge types which are offered for identifiers the
+	// rule matches, even if the PA's config doesn't enable them.
+	Enable []core.AcmeChallenge `yaml:"Enable"`
+}
+
+// matches returns true if the rule applies to name, requested by the account
+// regID. A rule with no Suffixes or TLDs matches every name.
+func (r challengeRule) matches(name string, regID int64) bool {
+	if len(r.Accounts) > 0 && !slices.Contains(r.Accounts, regID) {
+		return false
+	}
+	if len(r.Suffixes) == 0 && len(r.TLDs) == 0 {
+		return true
+	}
+	for _, suffix := range r.Suffixes {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return slices.Contains(r.TLDs, name[strings.LastIndex(name, ".")+1:])
+}
+
+// SetChallengeRulesFile loads the challenge rules in the given file,
+// returning an error if it fails. It will also start a reloader in case the
+// file changes.
+func (pa *AuthorityImpl) SetChallengeRulesFile(f string) error {
+	_, err := reloader.New(f, pa.loadChallengeRules, pa.log)
+	return err
+}
+
+// loadChallengeRules is a callback suitable for use with reloader.New() that
+// will unmarshal a YAML list of challenge rules.
+func (pa *AuthorityImpl) loadChallengeRules(contents []byte) error {
+	hash := sha256.Sum256(contents)
+	pa.log.Infof("loading challenge rules, sha256: %s", hex.EncodeToString(hash[:]))
+	var policy challengeRulesPolicy
+	err := strictyaml.Unmarshal(contents, &policy)
+	if err != nil {
+		return err
+	}
+	return pa.processChallengeRules(policy)
+}
+
+// processChallengeRules checks each of the rules in policy and, if they're all
+// well-formed, replaces the PA's challenge rules with them. Their Suffixes and
+// TLDs are normalized to the lowercase, dotless form of the identifiers they
+// are matched against.
+func (pa *AuthorityImpl) processChallengeRules(policy challengeRulesPolicy) error {
+	seen := make(map[string]bool)
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		for _, names := range [][]string{rule.Suffixes, rule.TLDs} {
+			for j, name := range names {
+				names[j] = strings.TrimSuffix(strings.ToLower(name), ".")
+			}
+		}
+		if rule.ID == "" {
+			return errors.New("challenge rule has no ID")
+		}
+		if slices.Contains(rule.Suffixes, "") || slices.Contains(rule.TLDs, "") {
+			return fmt.Errorf("challenge rule %q has an empty suffix or TLD", rule.ID)
+		}
+		if seen[rule.ID] {
+			return fmt.Errorf("duplicate challenge rule ID %q", rule.ID)
+		}
+		seen[rule.ID] = true
+		if len(rule.Disable) == 0 && len(rule.Enable) == 0 {
+			return fmt.Errorf("challenge rule %q neither disables nor enables any challenge types", rule.ID)
+		}
+		for _, types := range [][]core.AcmeChallenge{rule.Disable, rule.Enable} {
+			for _, t := range types {
+				if !t.IsValid() {
+					return fmt.Errorf("challenge rule %q has invalid challenge type %q", rule.ID, t)
+				}
+			}
+		}
+	}
+	pa.blocklistMu.Lock()
+	pa.challengeRules = policy.Rules
+	pa.blocklistMu.Unlock()
+	return nil
+}
+
+// challengeTypeEnabledFor returns whether challenges of type t are offered for
+// name when requested by the account regID, after applying the challenge
+// rules to the challenge types enabled in the PA's config. The caller must
+// hold blocklistMu.
+func (pa *AuthorityImpl) challengeTypeEnabledFor(t core.AcmeChallenge, name string, regID int64) bool {
+	enabled := pa.enabledChallenges[t]
+	for _, rule := range pa.challengeRules {
+		if !rule.matches(name, regID) {
+			continue
+		}
+		if slices.Contains(rule.Disable, t) {
+			enabled = false
+		}
+		if slices.Contains(rule.Enable, t) {
+			enabled = true
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,122 @@
+package policy
+
+import (
+	"os"
+	"testing"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestChallengeRules(t *testing.T) {
+	pa := paImpl(t)
+	err := pa.SetChallengeRulesFile("../test/challenge-rules.yaml")
+	test.AssertNotError(t, err, "Couldn't load challenge rules")
+
+	challengeTypes := func(name string, regID int64) []core.AcmeChallenge {
+		t.Helper()
+		challs, err := pa.ChallengesFor(identifier.DNSIdentifier(name), regID)
+		test.AssertNotError(t, err, "ChallengesFor failed")
+		seen := make(map[core.AcmeChallenge]bool)
+		for _, chall := range challs {
+			seen[chall.Type] = true
+		}
+		var types []core.AcmeChallenge
+		for _, typ := range []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeTLSALPN01, core.ChallengeTypeDNS01} {
+			if seen[typ] {
+				types = append(types, typ)
+			}
+		}
+		return types
+	}
+
+	// Names no rule matches get the enabled challenge types.
+	test.AssertDeepEquals(t, challengeTypes("example.com", 2), []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01})
+	test.AssertDeepEquals(t, challengeTypes("example.cymru", 2), []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01})
+
+	// A TLD rule disables HTTP-01, and a later account rule enables it again.
+	test.AssertDeepEquals(t, challengeTypes("host.internal", 2), []core.AcmeChallenge{core.ChallengeTypeDNS01})
+	test.AssertDeepEquals(t, challengeTypes("host.internal", 1), []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01})
+	test.Assert(t, !pa.ChallengeTypeAllowed(identifier.DNSIdentifier("host.internal"), 2, core.ChallengeTypeHTTP01), "HTTP-01 allowed for host.internal")
+	test.Assert(t, pa.ChallengeTypeAllowed(identifier.DNSIdentifier("host.internal"), 1, core.ChallengeTypeHTTP01), "HTTP-01 not allowed for host.internal")
+
+	// Rules can enable challenge types which aren't enabled in the config,
+	// and disable every challenge type for a name.
+	err = pa.processChallengeRules(challengeRulesPolicy{Rules: []challengeRule{
+		{ID: "alpn", Suffixes: []string{"example.com"}, Enable: []core.AcmeChallenge{core.ChallengeTypeTLSALPN01}},
+		{ID: "none", Suffixes: []string{"www.example.com"}, Disable: []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeTLSALPN01, core.ChallengeTypeDNS01}},
+	}})
+	test.AssertNotError(t, err, "Couldn't set challenge rules")
+	test.AssertDeepEquals(t, challengeTypes("a.example.com", 2), []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeTLSALPN01, core.ChallengeTypeDNS01})
+	test.AssertDeepEquals(t, challengeTypes("notexample.com", 2), []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01})
+	_, err = pa.ChallengesFor(identifier.DNSIdentifier("www.example.com"), 2)
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.Assert(t, !pa.ChallengeTypeAllowed(identifier.DNSIdentifier("www.example.com"), 2, core.ChallengeTypeDNS01), "DNS-01 allowed for www.example.com")
+
+	// Wildcards are still only offered DNS-01, and not even that if a rule
+	// disables it.
+	challs, err := pa.ChallengesFor(identifier.DNSIdentifier("*.a.example.com"), 2)
+	test.AssertNotError(t, err, "ChallengesFor failed for wildcard")
+	test.AssertEquals(t, len(challs), 1)
+	test.AssertEquals(t, challs[0].Type, core.ChallengeTypeDNS01)
+	_, err = pa.ChallengesFor(identifier.DNSIdentifier("*.www.example.com"), 2)
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+
+	// Suffixes and TLDs match regardless of case or a trailing dot.
+	err = pa.processChallengeRules(challengeRulesPolicy{Rules: []challengeRule{
+		{ID: "suffix", Suffixes: []string{"Example.COM."}, Disable: []core.AcmeChallenge{core.ChallengeTypeHTTP01}},
+		{ID: "tld", TLDs: []string{"ORG."}, Disable: []core.AcmeChallenge{core.ChallengeTypeDNS01}},
+	}})
+	test.AssertNotError(t, err, "Couldn't set challenge rules")
+	test.AssertDeepEquals(t, challengeTypes("www.example.com", 2), []core.AcmeChallenge{core.ChallengeTypeDNS01})
+	test.AssertDeepEquals(t, challengeTypes("example.org", 2), []core.AcmeChallenge{core.ChallengeTypeHTTP01})
+}
+
+func TestMalformedChallengeRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents string
+		err      string
+	}{
+		{
+			name:     "no ID",
+			contents: "Rules:\n  - Disable: [http-01]\n",
+			err:      "challenge rule has no ID",
+		},
+		{
+			name:     "duplicate ID",
+			contents: "Rules:\n  - ID: a\n    Disable: [http-01]\n  - ID: a\n    Enable: [dns-01]\n",
+			err:      "duplicate challenge rule ID \"a\"",
+		},
+		{
+			name:     "no challenge types",
+			contents: "Rules:\n  - ID: a\n    TLDs: [com]\n",
+			err:      "challenge rule \"a\" neither disables nor enables any challenge types",
+		},
+		{
+			name:     "empty suffix",
+			contents: "Rules:\n  - ID: a\n    Suffixes: [\".\"]\n    Disable: [http-01]\n",
+			err:      "challenge rule \"a\" has an empty suffix or TLD",
+		},
+		{
+			name:     "invalid challenge type",
+			contents: "Rules:\n  - ID: a\n    Enable: [tls-sni-01]\n",
+			err:      "challenge rule \"a\" has invalid challenge type \"tls-sni-01\"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := os.CreateTemp("", "test-challenge-rules.*.yaml")
+			test.AssertNotError(t, err, "Couldn't create temp file")
+			defer os.Remove(f.Name())
+			err = os.WriteFile(f.Name(), []byte(tc.contents), 0640)
+			test.AssertNotError(t, err, "Couldn't write challenge rules")
+
+			err = paImpl(t).SetChallengeRulesFile(f.Name())
+			test.AssertError(t, err, "Loaded malformed challenge rules")
+			test.AssertEquals(t, err.Error(), tc.err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package policy
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/letsencrypt/boulder/iana"
+)
+
+//go:generate go run gen_confusables.go
+
+// skeleton returns the UTS #39 skeleton of s: the NFD form of s, less any
+// default ignorable characters, with each character replaced by its
+// confusables prototype and the result normalized to NFD again. Two strings
+// with the same skeleton are visually confusable. The prototypes are those of
+// the Unicode confusables.txt data file, in confusables_table.go.
+//
+// https://www.unicode.org/reports/tr39/#Confusable_Detection
+func skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if isDefaultIgnorable(r) {
+			continue
+		}
+		proto, ok := confusables[r]
+		if ok {
+			b.WriteString(proto)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+// isDefaultIgnorable returns true if r has the Default_Ignorable_Code_Point
+// property, which Unicode derives from the properties the unicode package
+// provides (see DerivedCoreProperties.txt).
+func isDefaultIgnorable(r rune) bool {
+	if unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r) || unicode.Is(unicode.Variation_Selector, r) {
+		return true
+	}
+	return unicode.Is(unicode.Cf, r) &&
+		!unicode.Is(unicode.White_Space, r) &&
+		!unicode.Is(unicode.Prepended_Concatenation_Mark, r) &&
+		!(r >= 0xFFF9 && r <= 0xFFFB) &&
+		!(r >= 0x13430 && r <= 0x1343F)
+}
+
+// confusableTarget is a protected name which IDN labels must not impersonate.
+type confusableTarget struct {
+	// label is the protected label, e.g. "example" for "example.com".
+	label string
+	// rule identifies the blocklist entry the label came from.
+	rule string
+}
+
+// registrableLabel returns the label of name immediately to the left of its
+// public suffix, e.g. "example" for "www.example.co.uk", or "" if name is
+// itself a public suffix.
+func registrableLabel(name string) string {
+	suffix, err := iana.ExtractSuffix(name)
+	if err != nil || suffix == name {
+		return ""
+	}
+	rest := strings.TrimSuffix(name, "."+suffix)
+	return rest[strings.LastIndex(rest, ".")+1:]
+}
@@ -0,0 +1,68 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestSkeleton(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+	}{
+		{"paypal", "раураl"},            // Cyrillic
+		{"google", "gοοgle"},            // Greek
+		{"google", "ցօօցlе"},            // Armenian and Cyrillic
+		{"modern", "rnodern"},           // ASCII
+		{"apple", "app1e"},              // ASCII digit
+		{"example", "exаmple"},          // one Cyrillic letter
+		{"google", "g\u0d20\u0d20gle"},  // Malayalam
+		{"example", "\uff45xample"},     // fullwidth
+		{"example", "\U0001d41example"}, // mathematical bold
+		{"example", "exam\u200bple"},    // zero width space is ignored
+	} {
+		test.AssertEquals(t, skeleton(tc.a), skeleton(tc.b))
+	}
+	test.AssertNotEquals(t, skeleton("example"), skeleton("exámple"))
+	test.AssertNotEquals(t, skeleton("example"), skeleton("examples"))
+}
+
+func TestRegistrableLabel(t *testing.T) {
+	test.AssertEquals(t, registrableLabel("example.com"), "example")
+	test.AssertEquals(t, registrableLabel("www.example.co.uk"), "example")
+	test.AssertEquals(t, registrableLabel("co.uk"), "")
+	test.AssertEquals(t, registrableLabel("example"), "")
+}
+
+func TestConfusableMatch(t *testing.T) {
+	pa := paImpl(t)
+	err := pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"www.paypal.com"},
+		ExactBlockedNames:    []string{"www.exact.com"},
+		ConfusableBrandNames: []string{"letsencrypt"},
+	})
+	test.AssertNotError(t, err, "loading hostname policy")
+
+	for _, tc := range []struct {
+		domain string
+		label  string
+		target string
+		rule   string
+	}{
+		// раураl, in Cyrillic
+		{"xn--l-7sba6dbr.com", "раураl", "paypal", "HighRiskBlockedNames:www.paypal.com"},
+		// Wildcards and subdomains are checked too.
+		{"*.login.xn--l-7sba6dbr.net", "раураl", "paypal", "HighRiskBlockedNames:www.paypal.com"},
+		// lеtsеncrурt, with Cyrillic е and у and р
+		{"xn--ltsncrt-7ggc7i6a.org", "lеtsеncrурt", "letsencrypt", "ConfusableBrandNames:letsencrypt"},
+		// ASCII names are never confusable.
+		{"paypa1.com", "", "", ""},
+		// Nor are unrelated IDNs.
+		{"xn--mnchen-3ya.de", "", "", ""},
+	} {
+		label, target, rule := pa.ConfusableMatch(tc.domain)
+		test.AssertEquals(t, label, tc.label)
+		test.AssertEquals(t, target, tc.target)
+		test.AssertEquals(t, rule, tc.rule)
+	}
+}
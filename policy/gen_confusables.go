@@ -0,0 +1,130 @@
+//go:build ignore
+
+// gen_confusables generates confusables_table.go from the Unicode confusables
+// data file for UTS #39 (https://www.unicode.org/reports/tr39/#Confusable_Detection).
+// Run it with `go generate ./policy`. By default it downloads the version of
+// confusables.txt named by -version; use -in to read a local copy instead.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	version := flag.String("version", "15.1.0", "Unicode version of confusables.txt to download")
+	in := flag.String("in", "", "Path to a local copy of confusables.txt, instead of downloading it")
+	out := flag.String("out", "confusables_table.go", "Path of the generated Go file")
+	flag.Parse()
+
+	var data io.Reader
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		data = f
+	} else {
+		url := fmt.Sprintf("https://www.unicode.org/Public/security/%s/confusables.txt", *version)
+		resp, err := http.Get(url)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("fetching %s: %s", url, resp.Status)
+		}
+		data = resp.Body
+	}
+
+	table, err := parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(table, *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads the source and prototype of each mapping in confusables.txt.
+// Lines have the form:
+//
+//	0441 ;	0063 ;	MA	# ( с → c ) CYRILLIC SMALL LETTER ES → LATIN SMALL LETTER C
+func parse(r io.Reader) (map[rune]string, error) {
+	table := make(map[rune]string)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		// The file starts with a byte order mark.
+		text = strings.TrimSpace(strings.TrimPrefix(text, "\ufeff"))
+		if text == "" {
+			continue
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: malformed mapping %q", line, text)
+		}
+		source, err := parseCodePoints(fields[0])
+		if err != nil || len(source) != 1 {
+			return nil, fmt.Errorf("line %d: malformed source %q", line, fields[0])
+		}
+		prototype, err := parseCodePoints(fields[1])
+		if err != nil || len(prototype) == 0 {
+			return nil, fmt.Errorf("line %d: malformed prototype %q", line, fields[1])
+		}
+		table[source[0]] = string(prototype)
+	}
+	return table, scanner.Err()
+}
+
+// parseCodePoints parses a space-separated sequence of hexadecimal code points.
+func parseCodePoints(s string) ([]rune, error) {
+	var runes []rune
+	for _, field := range strings.Fields(s) {
+		cp, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		runes = append(runes, rune(cp))
+	}
+	return runes, nil
+}
+
+func generate(table map[rune]string, version string) ([]byte, error) {
+	sources := make([]rune, 0, len(table))
+	for r := range table {
+		sources = append(sources, r)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_confusables.go from the Unicode %s confusables.txt. DO NOT EDIT.\n\n", version)
+	b.WriteString("package policy\n\n")
+	b.WriteString("// confusables maps characters to their UTS #39 prototype: the string they\n")
+	b.WriteString("// are visually confusable with.\n")
+	b.WriteString("var confusables = map[rune]string{\n")
+	for _, r := range sources {
+		fmt.Fprintf(&b, "\t0x%04X: %+q,\n", r, table[r])
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
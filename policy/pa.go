@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -11,10 +12,12 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/exp/slices"
 	"golang.org/x/net/idna"
 	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc"
 
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
@@ -22,6 +25,7 @@ import (
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/reloader"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/strictyaml"
 )
 
@@ -29,14 +33,33 @@ import (
 type AuthorityImpl struct {
 	log blog.Logger
 
-	blocklist              map[string]bool
-	exactBlocklist         map[string]bool
-	wildcardExactBlocklist map[string]bool
-	blocklistMu            sync.RWMutex
+	// The blocklists map each blocked name to the ID of the rule which blocks
+	// it, so that block decisions can be attributed. They are built from both
+	// the hostname policy file and any entries loaded from the database.
+	blocklist              map[string]string
+	exactBlocklist         map[string]string
+	wildcardExactBlocklist map[string]string
+	// confusableTargets maps the UTS #39 skeletons of protected labels to
+	// those labels.
+	confusableTargets map[string]confusableTarget
+	// reviewNames, reviewTLDs and reviewPatterns hold the rules under which
+	// issuance requires manual review, each with its rule ID.
+	reviewNames    map[string]string
+	reviewTLDs     map[string]string
+	reviewPatterns []reviewPattern
+	blocklistMu    sync.RWMutex
+
+	// filePolicy and dbBlockedNames are the sources of the blocklists. Both are
+	// protected by blocklistMu.
+	filePolicy     *blockedNamesPolicy
+	dbBlockedNames []*sapb.BlockedName
 
 	enabledChallenges map[core.AcmeChallenge]bool
-	pseudoRNG         *rand.Rand
-	rngMu             sync.Mutex
+	// challengeRules restrict or extend enabledChallenges for particular
+	// identifiers and accounts. They're protected by blocklistMu.
+	challengeRules []challengeRule
+	pseudoRNG      *rand.Rand
+	rngMu          sync.Mutex
 }
 
 // New constructs a Policy Authority.
@@ -72,6 +95,28 @@ type blockedNamesPolicy struct {
 	// time above and beyond the high-risk domains. Managing these entries separately
 	// from HighRiskBlockedNames makes it easier to vet changes accurately.
 	AdminBlockedNames []string `yaml:"AdminBlockedNames"`
+
+	// ConfusableBrandNames is a list of labels (e.g. `example`) which IDN
+	// labels must not be visually confusable with, in addition to the labels
+	// of the HighRiskBlockedNames entries.
+	ConfusableBrandNames []string `yaml:"ConfusableBrandNames"`
+
+	// ReviewRequiredNames is like HighRiskBlockedNames, except that issuance
+	// for matching names requires manual review by an operator rather than
+	// being forbidden.
+	ReviewRequiredNames []string `yaml:"ReviewRequiredNames"`
+	// ReviewRequiredPatterns are regular expressions. Issuance for names
+	// matching any of them requires manual review.
+	ReviewRequiredPatterns []string `yaml:"ReviewRequiredPatterns"`
+	// ReviewRequiredTLDs are TLDs, such as recently delegated ones, under which
+	// issuance requires manual review.
+	ReviewRequiredTLDs []string `yaml:"ReviewRequiredTLDs"`
+}
+
+// reviewPattern is a compiled ReviewRequiredPatterns entry.
+type reviewPattern struct {
+	re   *regexp.Regexp
+	rule string
 }
 
 // SetHostnamePolicyFile will load the given policy file, returning error if it
@@ -107,40 +152,208 @@ func (pa *AuthorityImpl) loadHostnamePolicy(contents []byte) error {
 // wildcardExactBlocklist by processHostnamePolicy to ensure that wildcards for
 // exact blocked names entries are forbidden.
 func (pa *AuthorityImpl) processHostnamePolicy(policy blockedNamesPolicy) error {
-	nameMap := make(map[string]bool)
-	for _, v := range policy.HighRiskBlockedNames {
-		nameMap[v] = true
-	}
-	for _, v := range policy.AdminBlockedNames {
-		nameMap[v] = true
-	}
-	exactNameMap := make(map[string]bool)
-	wildcardNameMap := make(map[string]bool)
 	for _, v := range policy.ExactBlockedNames {
-		exactNameMap[v] = true
-		// Remove the leftmost label of the exact blocked names entry to make an exact
-		// wildcard block list entry that will prevent issuing a wildcard that would
-		// include the exact blocklist entry. e.g. if "highvalue.example.com" is on
-		// the exact blocklist we want "example.com" to be in the
-		// wildcardExactBlocklist so that "*.example.com" cannot be issued.
-		//
-		// First, split the domain into two parts: the first label and the rest of the domain.
-		parts := strings.SplitN(v, ".", 2)
-		// if there are less than 2 parts then this entry is malformed! There should
-		// at least be a "something." and a TLD like "com"
-		if len(parts) < 2 {
+		// if there are less than 2 labels then this entry is malformed! There
+		// should at least be a "something." and a TLD like "com"
+		if wildcardBase(v) == "" {
 			return fmt.Errorf(
 				"Malformed ExactBlockedNames entry, only one label: %q", v)
 		}
-		// Add the second part, the domain minus the first label, to the
-		// wildcardNameMap to block issuance for `*.`+parts[1]
-		wildcardNameMap[parts[1]] = true
+	}
+	var patterns []reviewPattern
+	for _, v := range policy.ReviewRequiredPatterns {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return fmt.Errorf("Malformed ReviewRequiredPatterns entry %q: %w", v, err)
+		}
+		patterns = append(patterns, reviewPattern{re: re, rule: "ReviewRequiredPatterns:" + v})
+	}
+	reviewNames := make(map[string]string)
+	for _, v := range policy.ReviewRequiredNames {
+		reviewNames[v] = "ReviewRequiredNames:" + v
+	}
+	reviewTLDs := make(map[string]string)
+	for _, v := range policy.ReviewRequiredTLDs {
+		reviewTLDs[v] = "ReviewRequiredTLDs:" + v
 	}
 	pa.blocklistMu.Lock()
+	pa.filePolicy = &policy
+	pa.rebuildBlocklists()
+	pa.reviewNames = reviewNames
+	pa.reviewTLDs = reviewTLDs
+	pa.reviewPatterns = patterns
+	pa.blocklistMu.Unlock()
+	return nil
+}
+
+// ReviewRequired returns the ID of the hostname policy rule under which
+// issuance for domain requires manual review by an operator, or "" if it
+// doesn't. A wildcard domain is checked as its base domain.
+func (pa *AuthorityImpl) ReviewRequired(domain string) string {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	domain = strings.TrimPrefix(domain, "*.")
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		rule, ok := pa.reviewNames[strings.Join(labels[i:], ".")]
+		if ok {
+			return rule
+		}
+	}
+	rule, ok := pa.reviewTLDs[labels[len(labels)-1]]
+	if ok {
+		return rule
+	}
+	for _, p := range pa.reviewPatterns {
+		if p.re.MatchString(domain) {
+			return p.rule
+		}
+	}
+	return ""
+}
+
+// wildcardBase removes the leftmost label of an exact blocked names entry to
+// make an exact wildcard block list entry that will prevent issuing a wildcard
+// that would include the exact blocklist entry. e.g. if
+// "highvalue.example.com" is on the exact blocklist we want "example.com" to
+// be in the wildcardExactBlocklist so that "*.example.com" cannot be issued.
+// It returns "" if the name has only one label.
+func wildcardBase(name string) string {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// rebuildBlocklists combines the hostname policy file and the database
+// entries into the blocklists. Entries from the file take precedence when a
+// name is blocked by both. It does nothing until the policy file is loaded.
+// The caller must hold blocklistMu for writing.
+func (pa *AuthorityImpl) rebuildBlocklists() {
+	if pa.filePolicy == nil {
+		return
+	}
+	nameMap := make(map[string]string)
+	exactNameMap := make(map[string]string)
+	wildcardNameMap := make(map[string]string)
+	confusableMap := make(map[string]confusableTarget)
+	addExact := func(name, rule string) {
+		if _, ok := exactNameMap[name]; !ok {
+			exactNameMap[name] = rule
+		}
+		base := wildcardBase(name)
+		if _, ok := wildcardNameMap[base]; base != "" && !ok {
+			wildcardNameMap[base] = rule
+		}
+	}
+	addSubdomains := func(name, rule string) {
+		if _, ok := nameMap[name]; !ok {
+			nameMap[name] = rule
+		}
+	}
+	addConfusable := func(label, rule string) {
+		if label == "" {
+			return
+		}
+		sk := skeleton(label)
+		if _, ok := confusableMap[sk]; !ok {
+			confusableMap[sk] = confusableTarget{label: label, rule: rule}
+		}
+	}
+
+	for _, v := range pa.filePolicy.HighRiskBlockedNames {
+		addSubdomains(v, "HighRiskBlockedNames:"+v)
+		addConfusable(registrableLabel(v), "HighRiskBlockedNames:"+v)
+	}
+	for _, v := range pa.filePolicy.ConfusableBrandNames {
+		addConfusable(v, "ConfusableBrandNames:"+v)
+	}
+	for _, v := range pa.filePolicy.AdminBlockedNames {
+		addSubdomains(v, "AdminBlockedNames:"+v)
+	}
+	for _, v := range pa.filePolicy.ExactBlockedNames {
+		addExact(v, "ExactBlockedNames:"+v)
+	}
+	for _, bn := range pa.dbBlockedNames {
+		rule := fmt.Sprintf("blockedNames:%d", bn.Id)
+		// The admin tool checks names before adding them, but an entry for a
+		// public suffix would block far too much to trust that alone.
+		err := ValidBlockedName(bn.Name)
+		if err != nil {
+			pa.log.Errf("Ignoring invalid blocked name %q (rule=[%s]): %s", bn.Name, rule, err)
+			continue
+		}
+		switch bn.BlockType {
+		case "exact":
+			addExact(bn.Name, rule)
+		case "highRisk":
+			addSubdomains(bn.Name, rule)
+			addConfusable(registrableLabel(bn.Name), rule)
+		case "admin":
+			addSubdomains(bn.Name, rule)
+		default:
+			pa.log.Errf("Ignoring blocked name %q (rule=[%s]) with unknown block type %q", bn.Name, rule, bn.BlockType)
+		}
+	}
+
 	pa.blocklist = nameMap
 	pa.exactBlocklist = exactNameMap
 	pa.wildcardExactBlocklist = wildcardNameMap
+	pa.confusableTargets = confusableMap
+}
+
+// blockedNamesGetter is the part of the SA's interface needed to load
+// hostname blocklist entries from the database.
+type blockedNamesGetter interface {
+	GetBlockedNames(ctx context.Context, req *sapb.GetBlockedNamesRequest, _ ...grpc.CallOption) (*sapb.BlockedNames, error)
+}
+
+// SetBlockedNamesSource loads hostname blocklist entries from the database
+// using sa, and then reloads them every interval until the returned function
+// is called. The entries apply in addition to those in the hostname policy
+// file. It returns an error if the initial load fails. If a later load fails,
+// the previously loaded entries remain in effect.
+func (pa *AuthorityImpl) SetBlockedNamesSource(sa blockedNamesGetter, interval time.Duration) (func(), error) {
+	err := pa.LoadBlockedNames(sa, interval)
+	if err != nil {
+		return nil, err
+	}
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				err := pa.LoadBlockedNames(sa, interval)
+				if err != nil {
+					pa.log.Errf("loading blocked names from database: %s", err)
+				}
+			}
+		}
+	}()
+	return func() { close(stop) }, nil
+}
+
+// LoadBlockedNames fetches the unexpired database blocklist entries once and
+// rebuilds the blocklists with them. The fetch is given at most timeout. If it
+// fails, the previously loaded entries remain in effect.
+func (pa *AuthorityImpl) LoadBlockedNames(sa blockedNamesGetter, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	names, err := sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{})
+	if err != nil {
+		return err
+	}
+	pa.blocklistMu.Lock()
+	pa.dbBlockedNames = names.Names
+	pa.rebuildBlocklists()
 	pa.blocklistMu.Unlock()
+	pa.log.Infof("loaded %d blocked names from database", len(names.Names))
 	return nil
 }
 
@@ -178,6 +391,7 @@ var (
 	errInvalidIdentifier    = berrors.MalformedError("Invalid identifier type")
 	errNonPublic            = berrors.MalformedError("Domain name does not end with a valid public suffix (TLD)")
 	errICANNTLD             = berrors.MalformedError("Domain name is an ICANN TLD")
+	errSpecialUseDomain     = berrors.RejectedIdentifierError("Domain name is a special-use domain name")
 	errPolicyForbidden      = berrors.RejectedIdentifierError("The ACME server refuses to issue a certificate for this domain name, because it is forbidden by policy")
 	errInvalidDNSCharacter  = berrors.MalformedError("Domain name contains an invalid character")
 	errNameTooLong          = berrors.MalformedError("Domain name is longer than 253 bytes")
@@ -208,6 +422,7 @@ var (
 // * made of any invalid DNS labels
 // * suffixed with something other than an IANA registered TLD
 // * exactly equal to an IANA registered TLD
+// * equal to or a subdomain of an IANA special-use domain name
 //
 // It does _not_ check that the domain isn't on any PA blocked lists.
 func validDomain(domain string) error {
@@ -295,9 +510,21 @@ func validDomain(domain string) error {
 		return errICANNTLD
 	}
 
+	if _, ok := iana.SpecialUseDomain(domain); ok {
+		return errSpecialUseDomain
+	}
+
 	return nil
 }
 
+// ValidBlockedName returns an error if name can't be added to the hostname
+// blocklist because it isn't a name we could otherwise issue for: in
+// particular, wildcards and public suffixes such as TLDs are refused, since
+// blocking one of those with its subdomains would block whole registries.
+func ValidBlockedName(name string) error {
+	return validDomain(name)
+}
+
 // forbiddenMailDomains is a map of domain names we do not allow after the
 // @ symbol in contact mailto addresses. These are frequently used when
 // copy-pasting example configurations and would not result in expiration
@@ -509,7 +736,8 @@ func (pa *AuthorityImpl) checkWildcardHostList(domain string) error {
 		return fmt.Errorf("Hostname policy not yet loaded.")
 	}
 
-	if pa.wildcardExactBlocklist[domain] {
+	if rule, ok := pa.wildcardExactBlocklist[domain]; ok {
+		pa.log.AuditInfof("Blocked wildcard issuance for *.%s: rule=[%s]", domain, rule)
 		return errPolicyForbidden
 	}
 
@@ -527,29 +755,63 @@ func (pa *AuthorityImpl) checkHostLists(domain string) error {
 	labels := strings.Split(domain, ".")
 	for i := range labels {
 		joined := strings.Join(labels[i:], ".")
-		if pa.blocklist[joined] {
+		if rule, ok := pa.blocklist[joined]; ok {
+			pa.log.AuditInfof("Blocked issuance for %s: rule=[%s]", domain, rule)
 			return errPolicyForbidden
 		}
 	}
 
-	if pa.exactBlocklist[domain] {
+	if rule, ok := pa.exactBlocklist[domain]; ok {
+		pa.log.AuditInfof("Blocked issuance for %s: rule=[%s]", domain, rule)
 		return errPolicyForbidden
 	}
 	return nil
 }
 
+// ConfusableMatch checks whether any IDN label of domain is visually
+// confusable with a protected label: the label to the left of the public
+// suffix of a HighRiskBlockedNames entry, or a ConfusableBrandNames entry. Two
+// labels are confusable if they have the same UTS #39 skeleton. If a label
+// matches, ConfusableMatch returns it in Unicode form along with the protected
+// label and the ID of the rule it came from. Otherwise rule is empty.
+func (pa *AuthorityImpl) ConfusableMatch(domain string) (label, target, rule string) {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	for _, l := range strings.Split(strings.TrimPrefix(domain, "*."), ".") {
+		if !strings.HasPrefix(l, "xn--") {
+			continue
+		}
+		ulabel, err := idna.ToUnicode(l)
+		if err != nil {
+			continue
+		}
+		t, ok := pa.confusableTargets[skeleton(ulabel)]
+		if ok && ulabel != t.label {
+			return ulabel, t.label, t.rule
+		}
+	}
+	return "", "", ""
+}
+
 // challengesTypesFor determines which challenge types are acceptable for the
-// given identifier.
-func (pa *AuthorityImpl) challengeTypesFor(identifier identifier.ACMEIdentifier) ([]core.AcmeChallenge, error) {
+// given identifier when requested by the account regID. If none are, because
+// of the challenge rules or the challenge types enabled in the PA's config, it
+// returns a RejectedIdentifier error.
+func (pa *AuthorityImpl) challengeTypesFor(identifier identifier.ACMEIdentifier, regID int64) ([]core.AcmeChallenge, error) {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
 	var challenges []core.AcmeChallenge
+	name := strings.TrimPrefix(identifier.Value, "*.")
 
 	// If the identifier is for a DNS wildcard name we only
 	// provide a DNS-01 challenge as a matter of CA policy.
 	if strings.HasPrefix(identifier.Value, "*.") {
 		// We must have the DNS-01 challenge type enabled to create challenges for
 		// a wildcard identifier per LE policy.
-		if !pa.ChallengeTypeEnabled(core.ChallengeTypeDNS01) {
-			return nil, fmt.Errorf(
+		if !pa.challengeTypeEnabledFor(core.ChallengeTypeDNS01, name, regID) {
+			return nil, berrors.RejectedIdentifierError(
 				"Challenges requested for wildcard identifier but DNS-01 " +
 					"challenge type is not enabled")
 		}
@@ -557,28 +819,32 @@ func (pa *AuthorityImpl) challengeTypesFor(identifier identifier.ACMEIdentifier)
 		challenges = []core.AcmeChallenge{core.ChallengeTypeDNS01}
 	} else {
 		// Otherwise we collect up challenges based on what is enabled.
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeHTTP01) {
+		if pa.challengeTypeEnabledFor(core.ChallengeTypeHTTP01, name, regID) {
 			challenges = append(challenges, core.ChallengeTypeHTTP01)
 		}
 
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeTLSALPN01) {
+		if pa.challengeTypeEnabledFor(core.ChallengeTypeTLSALPN01, name, regID) {
 			challenges = append(challenges, core.ChallengeTypeTLSALPN01)
 		}
 
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeDNS01) {
+		if pa.challengeTypeEnabledFor(core.ChallengeTypeDNS01, name, regID) {
 			challenges = append(challenges, core.ChallengeTypeDNS01)
 		}
+
+		if len(challenges) == 0 {
+			return nil, berrors.RejectedIdentifierError("no challenge types are enabled for %q", identifier.Value)
+		}
 	}
 
 	return challenges, nil
 }
 
 // ChallengesFor determines which challenge types are acceptable for the given
-// identifier, and constructs new challenge objects for those challenge types.
-// The resulting challenge objects all share a single challenge token and are
-// returned in a random order.
-func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier) ([]core.Challenge, error) {
-	challTypes, err := pa.challengeTypesFor(identifier)
+// identifier when requested by the account regID, and constructs new challenge
+// objects for those challenge types. The resulting challenge objects all share
+// a single challenge token and are returned in a random order.
+func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier, regID int64) ([]core.Challenge, error) {
+	challTypes, err := pa.challengeTypesFor(identifier, regID)
 	if err != nil {
 		return nil, err
 	}
@@ -616,6 +882,17 @@ func (pa *AuthorityImpl) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	return pa.enabledChallenges[t]
 }
 
+// ChallengeTypeAllowed returns whether challenges of type t are currently
+// acceptable for the given identifier when requested by the account regID,
+// taking the challenge rules into account.
+func (pa *AuthorityImpl) ChallengeTypeAllowed(identifier identifier.ACMEIdentifier, regID int64, t core.AcmeChallenge) bool {
+	challTypes, err := pa.challengeTypesFor(identifier, regID)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(challTypes, t)
+}
+
 // CheckAuthz determines that an authorization was fulfilled by a challenge
 // that was appropriate for the kind of identifier in the authorization.
 func (pa *AuthorityImpl) CheckAuthz(authz *core.Authorization) error {
@@ -624,7 +901,7 @@ func (pa *AuthorityImpl) CheckAuthz(authz *core.Authorization) error {
 		return err
 	}
 
-	challTypes, err := pa.challengeTypesFor(authz.Identifier)
+	challTypes, err := pa.challengeTypesFor(authz.Identifier, authz.RegistrationID)
 	if err != nil {
 		return err
 	}
@@ -1,15 +1,22 @@
 package policy
 
 import (
+	"context"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
+	"google.golang.org/grpc"
 	"gopkg.in/yaml.v3"
 )
 
@@ -214,6 +221,36 @@ func TestWillingToIssue(t *testing.T) {
 	}
 }
 
+func TestWillingToIssueSpecialUseDomain(t *testing.T) {
+	pa := paImpl(t)
+	err := pa.processHostnamePolicy(blockedNamesPolicy{})
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+
+	err = pa.willingToIssue(identifier.DNSIdentifier("www.example.net"))
+	test.AssertNotError(t, err, "WillingToIssue failed without the special-use domain names registry")
+
+	err = iana.LoadSpecialUseDomainsRegistry("../test/iana/special-use-domain.csv")
+	test.AssertNotError(t, err, "Couldn't load special-use domain names registry")
+	t.Cleanup(func() {
+		// There's no way to unload the registry, so replace it with one whose
+		// only entry isn't a public suffix, and so can't affect other tests.
+		path := filepath.Join(t.TempDir(), "special-use-domain.csv")
+		err := os.WriteFile(path, []byte("Name,Reference\ninvalid.,[RFC6761]\n"), 0640)
+		test.AssertNotError(t, err, "Couldn't write special-use domain names registry")
+		err = iana.LoadSpecialUseDomainsRegistry(path)
+		test.AssertNotError(t, err, "Couldn't load special-use domain names registry")
+	})
+
+	for _, domain := range []string{"www.example.net", "example.org", "foo.onion", "router.home.arpa"} {
+		err = pa.willingToIssue(identifier.DNSIdentifier(domain))
+		test.AssertEquals(t, err, errSpecialUseDomain)
+	}
+	err = pa.willingToIssue(identifier.DNSIdentifier("www.example.co.uk"))
+	test.AssertNotError(t, err, "WillingToIssue failed for a name which isn't special-use")
+	err = pa.WillingToIssueWildcards([]identifier.ACMEIdentifier{identifier.DNSIdentifier("*.example.net")})
+	test.AssertError(t, err, "WillingToIssueWildcards allowed a special-use wildcard")
+}
+
 func TestWillingToIssueWildcard(t *testing.T) {
 	bannedDomains := []string{
 		"zombo.gov.us",
@@ -371,7 +408,7 @@ func TestWillingToIssueWildcards(t *testing.T) {
 func TestChallengesFor(t *testing.T) {
 	pa := paImpl(t)
 
-	challenges, err := pa.ChallengesFor(identifier.ACMEIdentifier{})
+	challenges, err := pa.ChallengesFor(identifier.ACMEIdentifier{}, 1)
 	test.AssertNotError(t, err, "ChallengesFor failed")
 
 	test.Assert(t, len(challenges) == len(enabledChallenges), "Wrong number of challenges returned")
@@ -407,7 +444,7 @@ func TestChallengesForWildcard(t *testing.T) {
 		core.ChallengeTypeDNS01:  false,
 	}
 	pa := mustConstructPA(t, enabledChallenges)
-	_, err := pa.ChallengesFor(wildcardIdent)
+	_, err := pa.ChallengesFor(wildcardIdent, 1)
 	test.AssertError(t, err, "ChallengesFor did not error for a wildcard ident "+
 		"when DNS-01 was disabled")
 	test.AssertEquals(t, err.Error(), "Challenges requested for wildcard "+
@@ -417,7 +454,7 @@ func TestChallengesForWildcard(t *testing.T) {
 	// should return only one DNS-01 type challenge
 	enabledChallenges[core.ChallengeTypeDNS01] = true
 	pa = mustConstructPA(t, enabledChallenges)
-	challenges, err := pa.ChallengesFor(wildcardIdent)
+	challenges, err := pa.ChallengesFor(wildcardIdent, 1)
 	test.AssertNotError(t, err, "ChallengesFor errored for a wildcard ident "+
 		"unexpectedly")
 	test.AssertEquals(t, len(challenges), 1)
@@ -458,6 +495,134 @@ func TestMalformedExactBlocklist(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Malformed ExactBlockedNames entry, only one label: \"com\"")
 }
 
+func TestReviewRequired(t *testing.T) {
+	pa := paImpl(t)
+	err := pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames:   []string{"highrisk.example"},
+		ExactBlockedNames:      []string{"exact.example.com"},
+		ReviewRequiredNames:    []string{"bank.example"},
+		ReviewRequiredPatterns: []string{`(^|\.)pay-?pal`},
+		ReviewRequiredTLDs:     []string{"new"},
+	})
+	test.AssertNotError(t, err, "processing hostname policy")
+
+	testCases := []struct {
+		domain string
+		rule   string
+	}{
+		{"bank.example", "ReviewRequiredNames:bank.example"},
+		{"www.bank.example", "ReviewRequiredNames:bank.example"},
+		{"*.bank.example", "ReviewRequiredNames:bank.example"},
+		{"notbank.example", ""},
+		{"shop.new", "ReviewRequiredTLDs:new"},
+		{"new.example.com", ""},
+		{"login.paypal.example.com", `ReviewRequiredPatterns:(^|\.)pay-?pal`},
+		{"pay-pal-login.example.com", `ReviewRequiredPatterns:(^|\.)pay-?pal`},
+		{"example.com", ""},
+	}
+	for _, tc := range testCases {
+		test.AssertEquals(t, pa.ReviewRequired(tc.domain), tc.rule)
+	}
+
+	err = pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames:   []string{"highrisk.example"},
+		ExactBlockedNames:      []string{"exact.example.com"},
+		ReviewRequiredPatterns: []string{"(unclosed"},
+	})
+	test.AssertError(t, err, "processed a malformed ReviewRequiredPatterns entry")
+	// The previous policy is kept.
+	test.AssertEquals(t, pa.ReviewRequired("bank.example"), "ReviewRequiredNames:bank.example")
+}
+
+// fakeBlockedNames is a blockedNamesGetter which returns names, or err if set.
+type fakeBlockedNames struct {
+	names []*sapb.BlockedName
+	err   error
+}
+
+func (f *fakeBlockedNames) GetBlockedNames(_ context.Context, _ *sapb.GetBlockedNamesRequest, _ ...grpc.CallOption) (*sapb.BlockedNames, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sapb.BlockedNames{Names: f.names}, nil
+}
+
+func TestBlockedNamesSource(t *testing.T) {
+	pa := paImpl(t)
+	log := pa.log.(*blog.Mock)
+	err := pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"highrisk.com"},
+		ExactBlockedNames:    []string{"www.exact.com"},
+	})
+	test.AssertNotError(t, err, "loading hostname policy")
+
+	sa := &fakeBlockedNames{names: []*sapb.BlockedName{
+		{Id: 1, Name: "admin.com", BlockType: "admin"},
+		{Id: 2, Name: "mail.exact.org", BlockType: "exact"},
+		{Id: 3, Name: "highrisk.com", BlockType: "highRisk"},
+		{Id: 4, Name: "org", BlockType: "exact"},
+		{Id: 5, Name: "co.uk", BlockType: "highRisk"},
+	}}
+	stop, err := pa.SetBlockedNamesSource(sa, time.Hour)
+	test.AssertNotError(t, err, "setting blocked names source")
+	defer stop()
+	// Invalid entries, such as public suffixes, are skipped.
+	test.AssertEquals(t, len(log.GetAllMatching(`invalid blocked name "org"`)), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`invalid blocked name "co.uk"`)), 1)
+	test.AssertNotError(t, pa.willingToIssue(identifier.DNSIdentifier("example.co.uk")), "public suffix block was applied")
+
+	for _, tc := range []struct {
+		domain string
+		rule   string
+	}{
+		{"highrisk.com", "HighRiskBlockedNames:highrisk.com"},
+		{"www.exact.com", "ExactBlockedNames:www.exact.com"},
+		{"sub.admin.com", "blockedNames:1"},
+		{"mail.exact.org", "blockedNames:2"},
+	} {
+		log.Clear()
+		err = pa.willingToIssue(identifier.DNSIdentifier(tc.domain))
+		test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+		test.AssertEquals(t, len(log.GetAllMatching(`rule=\[`+tc.rule+`\]`)), 1)
+	}
+	test.AssertNotError(t, pa.willingToIssue(identifier.DNSIdentifier("www.exact.org")), "exact block matched another name")
+
+	log.Clear()
+	err = pa.checkWildcardHostList("exact.org")
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.AssertEquals(t, len(log.GetAllMatching(`rule=\[blockedNames:2\]`)), 1)
+
+	// A failed load returns an error and keeps the previous entries.
+	sa.err = errors.New("oops")
+	err = pa.LoadBlockedNames(sa, time.Second)
+	test.AssertError(t, err, "load should have failed")
+	test.AssertError(t, pa.willingToIssue(identifier.DNSIdentifier("admin.com")), "lost blocked names after a failed load")
+
+	// A successful load replaces them.
+	sa.err = nil
+	sa.names = nil
+	err = pa.LoadBlockedNames(sa, time.Second)
+	test.AssertNotError(t, err, "loading blocked names")
+	test.AssertNotError(t, pa.willingToIssue(identifier.DNSIdentifier("admin.com")), "kept a removed blocked name")
+
+	// Reloading the policy file keeps the database entries.
+	sa.names = []*sapb.BlockedName{{Id: 6, Name: "admin.com", BlockType: "admin"}}
+	err = pa.LoadBlockedNames(sa, time.Second)
+	test.AssertNotError(t, err, "loading blocked names")
+	err = pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"highrisk.com"},
+		ExactBlockedNames:    []string{"www.exact.com"},
+	})
+	test.AssertNotError(t, err, "reloading hostname policy")
+	test.AssertError(t, pa.willingToIssue(identifier.DNSIdentifier("admin.com")), "lost blocked names after reloading policy")
+}
+
+func TestBlockedNamesSourceInitialFailure(t *testing.T) {
+	pa := paImpl(t)
+	_, err := pa.SetBlockedNamesSource(&fakeBlockedNames{err: errors.New("oops")}, time.Hour)
+	test.AssertError(t, err, "initial load should have failed")
+}
+
 func TestValidEmailError(t *testing.T) {
 	err := ValidEmail("(๑•́ ω •̀๑)")
 	test.AssertEquals(t, err.Error(), "\"(๑•́ ω •̀๑)\" is not a valid e-mail address")
@@ -10,8 +10,8 @@ import (
 const (
 	// Error types that can be used in ACME payloads. These are sorted in the
 	// same order as they are defined in RFC8555 Section 6.7. We do not implement
-	// the `compound`, `externalAccountRequired`, or `userActionRequired` errors,
-	// because we have no path that would return them.
+	// the `compound` or `externalAccountRequired` errors, because we have no
+	// path that would return them.
 	AccountDoesNotExistProblem   = ProblemType("accountDoesNotExist")
 	AlreadyRevokedProblem        = ProblemType("alreadyRevoked")
 	BadCSRProblem                = ProblemType("badCSR")
@@ -32,6 +32,7 @@ const (
 	UnauthorizedProblem          = ProblemType("unauthorized")
 	UnsupportedContactProblem    = ProblemType("unsupportedContact")
 	UnsupportedIdentifierProblem = ProblemType("unsupportedIdentifier")
+	UserActionRequiredProblem    = ProblemType("userActionRequired")
 
 	ErrorNS = "urn:ietf:params:acme:error:"
 )
@@ -50,6 +51,10 @@ type ProblemDetails struct {
 	// SubProblems are optional additional per-identifier problems. See
 	// RFC 8555 Section 6.7.1: https://tools.ietf.org/html/rfc8555#section-6.7.1
 	SubProblems []SubProblemDetails `json:"subproblems,omitempty"`
+	// Instance is an optional URL identifying this occurrence of the problem.
+	// For userActionRequired problems it's a page a human should visit, per
+	// RFC 8555 Section 7.3.3: https://tools.ietf.org/html/rfc8555#section-7.3.3
+	Instance string `json:"instance,omitempty"`
 }
 
 // SubProblemDetails represents sub-problems specific to an identifier that are
@@ -274,6 +279,16 @@ func UnsupportedIdentifier(detail string, a ...any) *ProblemDetails {
 	}
 }
 
+// UserActionRequired returns a ProblemDetails representing a
+// UserActionRequiredProblem
+func UserActionRequired(detail string, a ...any) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       UserActionRequiredProblem,
+		Detail:     fmt.Sprintf(detail, a...),
+		HTTPStatus: http.StatusForbidden,
+	}
+}
+
 // Additional helper functions that return variations on MalformedProblem with
 // different HTTP status codes set.
 
@@ -10,6 +10,7 @@ import (
 	context "context"
 	proto1 "github.com/letsencrypt/boulder/ca/proto"
 	proto "github.com/letsencrypt/boulder/core/proto"
+	proto2 "github.com/letsencrypt/boulder/sa/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -37,6 +38,9 @@ type RegistrationAuthorityClient interface {
 	FinalizeOrder(ctx context.Context, in *FinalizeOrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	// Generate an OCSP response based on the DB's current status and reason code.
 	GenerateOCSP(ctx context.Context, in *GenerateOCSPRequest, opts ...grpc.CallOption) (*proto1.OCSPResponse, error)
+	// Finish or abandon an issuance which was interrupted partway through.
+	ResumeIssuance(ctx context.Context, in *ResumeIssuanceRequest, opts ...grpc.CallOption) (*proto.Order, error)
+	ReviewOrder(ctx context.Context, in *ReviewOrderRequest, opts ...grpc.CallOption) (*proto2.OrderReview, error)
 }
 
 type registrationAuthorityClient struct {
@@ -146,6 +150,24 @@ func (c *registrationAuthorityClient) GenerateOCSP(ctx context.Context, in *Gene
 	return out, nil
 }
 
+func (c *registrationAuthorityClient) ResumeIssuance(ctx context.Context, in *ResumeIssuanceRequest, opts ...grpc.CallOption) (*proto.Order, error) {
+	out := new(proto.Order)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/ResumeIssuance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) ReviewOrder(ctx context.Context, in *ReviewOrderRequest, opts ...grpc.CallOption) (*proto2.OrderReview, error) {
+	out := new(proto2.OrderReview)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/ReviewOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationAuthorityServer is the server API for RegistrationAuthority service.
 // All implementations must embed UnimplementedRegistrationAuthorityServer
 // for forward compatibility
@@ -162,6 +184,9 @@ type RegistrationAuthorityServer interface {
 	FinalizeOrder(context.Context, *FinalizeOrderRequest) (*proto.Order, error)
 	// Generate an OCSP response based on the DB's current status and reason code.
 	GenerateOCSP(context.Context, *GenerateOCSPRequest) (*proto1.OCSPResponse, error)
+	// Finish or abandon an issuance which was interrupted partway through.
+	ResumeIssuance(context.Context, *ResumeIssuanceRequest) (*proto.Order, error)
+	ReviewOrder(context.Context, *ReviewOrderRequest) (*proto2.OrderReview, error)
 	mustEmbedUnimplementedRegistrationAuthorityServer()
 }
 
@@ -202,6 +227,12 @@ func (UnimplementedRegistrationAuthorityServer) FinalizeOrder(context.Context, *
 func (UnimplementedRegistrationAuthorityServer) GenerateOCSP(context.Context, *GenerateOCSPRequest) (*proto1.OCSPResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateOCSP not implemented")
 }
+func (UnimplementedRegistrationAuthorityServer) ResumeIssuance(context.Context, *ResumeIssuanceRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeIssuance not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) ReviewOrder(context.Context, *ReviewOrderRequest) (*proto2.OrderReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewOrder not implemented")
+}
 func (UnimplementedRegistrationAuthorityServer) mustEmbedUnimplementedRegistrationAuthorityServer() {}
 
 // UnsafeRegistrationAuthorityServer may be embedded to opt out of forward compatibility for this service.
@@ -413,6 +444,42 @@ func _RegistrationAuthority_GenerateOCSP_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RegistrationAuthority_ResumeIssuance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeIssuanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).ResumeIssuance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/ResumeIssuance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).ResumeIssuance(ctx, req.(*ResumeIssuanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_ReviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).ReviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/ReviewOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).ReviewOrder(ctx, req.(*ReviewOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RegistrationAuthority_ServiceDesc is the grpc.ServiceDesc for RegistrationAuthority service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +531,14 @@ var RegistrationAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateOCSP",
 			Handler:    _RegistrationAuthority_GenerateOCSP_Handler,
 		},
+		{
+			MethodName: "ResumeIssuance",
+			Handler:    _RegistrationAuthority_ResumeIssuance_Handler,
+		},
+		{
+			MethodName: "ReviewOrder",
+			Handler:    _RegistrationAuthority_ReviewOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ra.proto",
@@ -19,7 +19,6 @@ import (
 
 	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/weppos/publicsuffix-go/publicsuffix"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/crypto/ocsp"
 	"golang.org/x/exp/slices"
@@ -39,6 +38,7 @@ import (
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
@@ -100,6 +100,9 @@ type RegistrationAuthorityImpl struct {
 	orderLifetime                time.Duration
 	finalizeTimeout              time.Duration
 	finalizeWG                   sync.WaitGroup
+	// confusableAction is what NewOrder does with names which are visually
+	// confusable with a protected name. See SetConfusableNameAction.
+	confusableAction string
 
 	issuersByNameID map[issuance.IssuerNameID]*issuance.Certificate
 	issuersByID     map[issuance.IssuerID]*issuance.Certificate
@@ -118,6 +121,8 @@ type RegistrationAuthorityImpl struct {
 	authzAges                   *prometheus.HistogramVec
 	orderAges                   *prometheus.HistogramVec
 	inflightFinalizes           prometheus.Gauge
+	confusableNames             *prometheus.CounterVec
+	orderReviews                *prometheus.CounterVec
 }
 
 // NewRegistrationAuthorityImpl constructs a new RA object.
@@ -227,6 +232,18 @@ func NewRegistrationAuthorityImpl(
 	})
 	stats.MustRegister(inflightFinalizes)
 
+	confusableNames := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "confusable_names",
+		Help: "A counter of names in new orders which are confusable with a protected name, labelled by the action taken",
+	}, []string{"action"})
+	stats.MustRegister(confusableNames)
+
+	orderReviews := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_reviews",
+		Help: "A counter of orders which require manual review, labelled by whether they were queued, approved or denied",
+	}, []string{"result"})
+	stats.MustRegister(orderReviews)
+
 	issuersByNameID := make(map[issuance.IssuerNameID]*issuance.Certificate)
 	issuersByID := make(map[issuance.IssuerID]*issuance.Certificate)
 	for _, issuer := range issuers {
@@ -262,10 +279,34 @@ func NewRegistrationAuthorityImpl(
 		authzAges:                    authzAges,
 		orderAges:                    orderAges,
 		inflightFinalizes:            inflightFinalizes,
+		confusableNames:              confusableNames,
+		orderReviews:                 orderReviews,
 	}
 	return ra
 }
 
+// Actions the RA can take on names in new orders which are confusable with a
+// protected name.
+const (
+	confusableReject = "reject"
+	confusableReview = "review"
+)
+
+// SetConfusableNameAction sets what NewOrder does with names which the PA
+// finds visually confusable with a protected name. "reject" refuses them,
+// while "review" holds their orders for manual review by an operator. Unless
+// the OrderReviews feature is enabled, "review" only audit logs the names and
+// lets the orders proceed. The default, "", doesn't check names for
+// confusability.
+func (ra *RegistrationAuthorityImpl) SetConfusableNameAction(action string) error {
+	switch action {
+	case "", confusableReject, confusableReview:
+		ra.confusableAction = action
+		return nil
+	}
+	return fmt.Errorf("unknown confusable name action %q", action)
+}
+
 func (ra *RegistrationAuthorityImpl) SetRateLimitPoliciesFile(filename string) error {
 	_, err := reloader.New(filename, ra.rlPolicies.LoadPolicies, ra.log)
 	if err != nil {
@@ -296,6 +337,9 @@ type certificateRequestEvent struct {
 	// SerialNumber is the string representation of the issued certificate's
 	// serial number
 	SerialNumber string `json:",omitempty"`
+	// AlternateSerialNumber is the string representation of the serial number
+	// of the alternate certificate issued for a dual certificate order
+	AlternateSerialNumber string `json:",omitempty"`
 	// VerifiedFields are required by the baseline requirements and are always
 	// a static value for Boulder.
 	VerifiedFields []string `json:",omitempty"`
@@ -354,6 +398,20 @@ type finalizationCAACheckEvent struct {
 	Rechecked int `json:",omitempty"`
 }
 
+// resumedIssuanceEvent is a struct for holding information logged as JSON to
+// the audit log when the RA finishes or abandons an interrupted issuance on
+// behalf of the issuance-resumer.
+type resumedIssuanceEvent struct {
+	OrderID   int64
+	Requester int64
+	// State is the issuance state in which the interrupted issuance was found.
+	State        core.IssuanceState
+	SerialNumber string `json:",omitempty"`
+	// Outcome is the issuance state the issuance was moved into.
+	Outcome core.IssuanceState
+	Error   string `json:",omitempty"`
+}
+
 // noRegistrationID is used for the regID parameter to GetThreshold when no
 // registration-based overrides are necessary.
 const noRegistrationID = -1
@@ -474,6 +532,9 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, reques
 		Agreement:       request.Agreement,
 		InitialIP:       request.InitialIP,
 		Status:          string(core.StatusValid),
+		// The SA records the agreement to the terms of service along with the
+		// registration.
+		TosVersion: request.TosVersion,
 	}
 
 	// Store the registration object, then return the version that got stored.
@@ -486,6 +547,27 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, reques
 	return res, nil
 }
 
+// recordTermsAgreement records that the account regID agreed to the given
+// version of the terms of service, unless the latest version it agreed to is
+// already that one.
+func (ra *RegistrationAuthorityImpl) recordTermsAgreement(ctx context.Context, regID int64, version string) error {
+	latest, err := ra.SA.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil && !errors.Is(err, berrors.NotFound) {
+		return berrors.InternalServerError("Could not get terms of service agreement: %s", err)
+	}
+	if err == nil && latest.Version == version {
+		return nil
+	}
+	_, err = ra.SA.AddTermsAgreement(ctx, &sapb.TermsAgreement{
+		RegistrationID: regID,
+		Version:        version,
+	})
+	if err != nil {
+		return berrors.InternalServerError("Could not record terms of service agreement: %s", err)
+	}
+	return nil
+}
+
 // validateContacts checks the provided list of contacts, returning an error if
 // any are not acceptable. Unacceptable contacts lists include:
 // * An empty list
@@ -985,6 +1067,16 @@ func (ra *RegistrationAuthorityImpl) failOrder(
 	}
 }
 
+// abandonIssuance records that the RA has given up on issuing a certificate
+// for the given order, so that the issuance-resumer will not try to finish it.
+// Like failOrder, it MUST only be called when we are already returning an
+// error for another reason, and it uses its own context for the same reasons.
+func (ra *RegistrationAuthorityImpl) abandonIssuance(order *corepb.Order) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	ra.recordIssuanceState(ctx, accountID(order.RegistrationID), orderID(order.Id), core.IssuanceAbandoned)
+}
+
 // To help minimize the chance that an accountID would be used as an order ID
 // (or vice versa) when calling functions that use both we define internal
 // `accountID` and `orderID` types so that callers must explicitly cast.
@@ -1010,7 +1102,7 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		Requester:   req.Order.RegistrationID,
 		RequestTime: ra.clk.Now(),
 	}
-	csr, err := ra.validateFinalizeRequest(ctx, req, &logEvent)
+	csr, altCSR, err := ra.validateFinalizeRequest(ctx, req, &logEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -1056,7 +1148,7 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		// that it can wait for all goroutines to drain during shutdown.
 		ra.finalizeWG.Add(1)
 		go func() {
-			_, err := ra.issueCertificateOuter(ctx, proto.Clone(order).(*corepb.Order), csr, logEvent)
+			_, err := ra.issueCertificateOuter(ctx, proto.Clone(order).(*corepb.Order), csr, altCSR, logEvent)
 			if err != nil {
 				// We only log here, because this is in a background goroutine with
 				// no parent goroutine waiting for it to receive the error.
@@ -1066,7 +1158,7 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		}()
 		return order, nil
 	} else {
-		return ra.issueCertificateOuter(ctx, order, csr, logEvent)
+		return ra.issueCertificateOuter(ctx, order, csr, altCSR, logEvent)
 	}
 }
 
@@ -1075,17 +1167,17 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	ctx context.Context,
 	req *rapb.FinalizeOrderRequest,
-	logEvent *certificateRequestEvent) (*x509.CertificateRequest, error) {
+	logEvent *certificateRequestEvent) (*x509.CertificateRequest, *x509.CertificateRequest, error) {
 	if req.Order.Id <= 0 {
-		return nil, berrors.MalformedError("invalid order ID: %d", req.Order.Id)
+		return nil, nil, berrors.MalformedError("invalid order ID: %d", req.Order.Id)
 	}
 
 	if req.Order.RegistrationID <= 0 {
-		return nil, berrors.MalformedError("invalid account ID: %d", req.Order.RegistrationID)
+		return nil, nil, berrors.MalformedError("invalid account ID: %d", req.Order.RegistrationID)
 	}
 
 	if core.AcmeStatus(req.Order.Status) != core.StatusReady {
-		return nil, berrors.OrderNotReadyError(
+		return nil, nil, berrors.OrderNotReadyError(
 			"Order's status (%q) is not acceptable for finalization",
 			req.Order.Status)
 	}
@@ -1094,20 +1186,20 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	// be on the safe side, throwing an internal server error if this assumption
 	// is ever violated.
 	if len(req.Order.Names) == 0 {
-		return nil, berrors.InternalServerError("Order has no associated names")
+		return nil, nil, berrors.InternalServerError("Order has no associated names")
 	}
 
 	// Parse the CSR from the request
 	csr, err := x509.ParseCertificateRequest(req.Csr)
 	if err != nil {
-		return nil, berrors.BadCSRError("unable to parse CSR: %s", err.Error())
+		return nil, nil, berrors.BadCSRError("unable to parse CSR: %s", err.Error())
 	}
 
 	err = csrlib.VerifyCSR(ctx, csr, ra.maxNames, &ra.keyPolicy, ra.PA)
 	if err != nil {
 		// VerifyCSR returns berror instances that can be passed through as-is
 		// without wrapping.
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Dedupe, lowercase and sort both the names from the CSR and the names in the
@@ -1117,30 +1209,41 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 
 	// Immediately reject the request if the number of names differ
 	if len(orderNames) != len(csrNames) {
-		return nil, berrors.UnauthorizedError("Order includes different number of names than CSR specifies")
+		return nil, nil, berrors.UnauthorizedError("Order includes different number of names than CSR specifies")
 	}
 
 	// Check that the order names and the CSR names are an exact match
 	for i, name := range orderNames {
 		if name != csrNames[i] {
-			return nil, berrors.UnauthorizedError("CSR is missing Order domain %q", name)
+			return nil, nil, berrors.UnauthorizedError("CSR is missing Order domain %q", name)
+		}
+	}
+
+	var altCSR *x509.CertificateRequest
+	if len(req.AlternateCsr) != 0 {
+		altCSR, err = ra.validateAlternateCSR(ctx, csr, req.AlternateCsr, orderNames)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 
 	// Get the originating account for use in the next check.
 	regPB, err := ra.SA.GetRegistration(ctx, &sapb.RegistrationID{Id: req.Order.RegistrationID})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	account, err := bgrpc.PbToRegistration(regPB)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Make sure they're not using their account key as the certificate key too.
 	if core.KeyDigestEquals(csr.PublicKey, account.Key) {
-		return nil, berrors.MalformedError("certificate public key must be different than account key")
+		return nil, nil, berrors.MalformedError("certificate public key must be different than account key")
+	}
+	if altCSR != nil && core.KeyDigestEquals(altCSR.PublicKey, account.Key) {
+		return nil, nil, berrors.MalformedError("alternate certificate public key must be different than account key")
 	}
 
 	// Double-check that all authorizations on this order are also associated with
@@ -1149,7 +1252,7 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	if err != nil {
 		// Pass through the error without wrapping it because the called functions
 		// return BoulderError and we don't want to lose the type.
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Collect up a certificateRequestAuthz that stores the ID and challenge type
@@ -1171,7 +1274,48 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	// Mark that we verified the CN and SANs
 	logEvent.VerifiedFields = []string{"subject.commonName", "subjectAltName"}
 
-	return csr, nil
+	return csr, altCSR, nil
+}
+
+// validateAlternateCSR parses and checks the alternate CSR of a dual
+// certificate finalize request. The alternate CSR must pass the same checks as
+// the primary CSR, must request exactly the same names, and must use a
+// different type of key. Since the names are identical, the authorizations
+// checked for the primary CSR cover the alternate CSR as well.
+func (ra *RegistrationAuthorityImpl) validateAlternateCSR(
+	ctx context.Context,
+	csr *x509.CertificateRequest,
+	altCSRDER []byte,
+	orderNames []string) (*x509.CertificateRequest, error) {
+	if !features.Enabled(features.DualCertificateOrders) {
+		return nil, berrors.MalformedError("alternate CSRs are not supported")
+	}
+
+	altCSR, err := x509.ParseCertificateRequest(altCSRDER)
+	if err != nil {
+		return nil, berrors.BadCSRError("unable to parse alternate CSR: %s", err.Error())
+	}
+
+	err = csrlib.VerifyCSR(ctx, altCSR, ra.maxNames, &ra.keyPolicy, ra.PA)
+	if err != nil {
+		return nil, err
+	}
+
+	if altCSR.PublicKeyAlgorithm == csr.PublicKeyAlgorithm {
+		return nil, berrors.BadCSRError("alternate CSR must use a different key type than the CSR")
+	}
+
+	altNames := csrlib.NamesFromCSR(altCSR).SANs
+	if len(orderNames) != len(altNames) {
+		return nil, berrors.UnauthorizedError("Order includes different number of names than alternate CSR specifies")
+	}
+	for i, name := range orderNames {
+		if name != altNames[i] {
+			return nil, berrors.UnauthorizedError("alternate CSR is missing Order domain %q", name)
+		}
+	}
+
+	return altCSR, nil
 }
 
 // issueCertificateOuter exists solely to ensure that all calls to
@@ -1182,14 +1326,15 @@ func (ra *RegistrationAuthorityImpl) issueCertificateOuter(
 	ctx context.Context,
 	order *corepb.Order,
 	csr *x509.CertificateRequest,
+	altCSR *x509.CertificateRequest,
 	logEvent certificateRequestEvent,
 ) (*corepb.Order, error) {
 	ra.inflightFinalizes.Inc()
 	defer ra.inflightFinalizes.Dec()
 
 	// Step 3: Issue the Certificate
-	cert, err := ra.issueCertificateInner(
-		ctx, csr, accountID(order.RegistrationID), orderID(order.Id))
+	cert, altCert, err := ra.issueCertificateInner(
+		ctx, csr, altCSR, accountID(order.RegistrationID), orderID(order.Id))
 
 	// Step 4: Fail the order if necessary, and update metrics and log fields
 	var result string
@@ -1202,11 +1347,16 @@ func (ra *RegistrationAuthorityImpl) issueCertificateOuter(
 		// info.
 		ra.failOrder(order, web.ProblemDetailsForError(err, "Error finalizing order"))
 		order.Status = string(core.StatusInvalid)
+		ra.abandonIssuance(order)
 
 		logEvent.Error = err.Error()
 		result = "error"
 	} else {
 		order.CertificateSerial = core.SerialToString(cert.SerialNumber)
+		if altCert != nil {
+			order.AlternateCertificateSerial = core.SerialToString(altCert.SerialNumber)
+			logEvent.AlternateSerialNumber = order.AlternateCertificateSerial
+		}
 		order.Status = string(core.StatusValid)
 
 		ra.namesPerCert.With(
@@ -1243,11 +1393,20 @@ func (ra *RegistrationAuthorityImpl) issueCertificateOuter(
 // never have final certificates issued for them (because there is a possibility
 // that the certificate was actually issued but there was an error returning
 // it).
+//
+// If altCSR is non-nil, a second (alternate) certificate is issued for it, and
+// both are returned. A dual certificate order is finalized with both
+// certificates or not at all. The alternate precertificate is issued first, so
+// that if the CA can't issue for the alternate CSR, issuance fails before
+// anything has been issued for the primary CSR. Both precertificates are then
+// recorded in the issuance state together, so that an interrupted issuance can
+// be resumed with both final certificates.
 func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	ctx context.Context,
 	csr *x509.CertificateRequest,
+	altCSR *x509.CertificateRequest,
 	acctID accountID,
-	oID orderID) (*x509.Certificate, error) {
+	oID orderID) (*x509.Certificate, *x509.Certificate, error) {
 	if features.Enabled(features.AsyncFinalize) {
 		// If we're in async mode, use a context with a much longer timeout.
 		// TODO(go1.22?): use context.Detach to preserve tracing metadata.
@@ -1257,15 +1416,36 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		defer cancel()
 	}
 
-	// wrapError adds a prefix to an error. If the error is a boulder error then
-	// the problem detail is updated with the prefix. Otherwise a new error is
-	// returned with the message prefixed using `fmt.Errorf`
-	wrapError := func(e error, prefix string) error {
-		if berr, ok := e.(*berrors.BoulderError); ok {
-			berr.Detail = fmt.Sprintf("%s: %s", prefix, berr.Detail)
-			return berr
+	if features.Enabled(features.TrackIssuanceState) {
+		// If we can't durably record that issuance has begun, don't begin it:
+		// nothing would be able to clean up after us if we crashed partway.
+		_, err := ra.SA.SetIssuanceState(ctx, &sapb.IssuanceState{
+			OrderID:        int64(oID),
+			RegistrationID: int64(acctID),
+			State:          string(core.IssuanceStarted),
+		})
+		if err != nil {
+			return nil, nil, wrapError(err, "recording start of issuance")
+		}
+	}
+
+	var altPrecert *capb.IssuePrecertificateResponse
+	var parsedAltPrecert *x509.Certificate
+	if altCSR != nil {
+		var err error
+		altPrecert, err = ra.CA.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{
+			Csr:            altCSR.Raw,
+			RegistrationID: int64(acctID),
+			OrderID:        int64(oID),
+			Alternate:      true,
+		})
+		if err != nil {
+			return nil, nil, wrapError(err, "issuing alternate precertificate")
+		}
+		parsedAltPrecert, err = x509.ParseCertificate(altPrecert.DER)
+		if err != nil {
+			return nil, nil, wrapError(err, "parsing alternate precertificate")
 		}
-		return fmt.Errorf("%s: %s", prefix, e)
 	}
 
 	issueReq := &capb.IssueCertificateRequest{
@@ -1275,24 +1455,99 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	}
 	precert, err := ra.CA.IssuePrecertificate(ctx, issueReq)
 	if err != nil {
-		return nil, wrapError(err, "issuing precertificate")
+		return nil, nil, wrapError(err, "issuing precertificate")
 	}
 
 	parsedPrecert, err := x509.ParseCertificate(precert.DER)
 	if err != nil {
-		return nil, wrapError(err, "parsing precertificate")
+		return nil, nil, wrapError(err, "parsing precertificate")
+	}
+
+	if features.Enabled(features.TrackIssuanceState) {
+		// Failing to record the precertificate only means that, were we to crash
+		// before finishing, the issuance-resumer would abandon this issuance
+		// rather than completing it. That's no worse than not tracking issuance
+		// state at all, so carry on.
+		precertState := &sapb.IssuanceState{
+			OrderID:        int64(oID),
+			RegistrationID: int64(acctID),
+			State:          string(core.IssuancePrecertificateIssued),
+			Serial:         core.SerialToString(parsedPrecert.SerialNumber),
+			Precertificate: precert.DER,
+		}
+		if altPrecert != nil {
+			precertState.AlternateSerial = core.SerialToString(parsedAltPrecert.SerialNumber)
+			precertState.AlternatePrecertificate = altPrecert.DER
+		}
+		_, err = ra.SA.SetIssuanceState(ctx, precertState)
+		if err != nil {
+			ra.log.AuditErrf("Could not record precertificate issuance for order %d: %s", oID, err)
+		}
+	}
+
+	parsedCertificate, err := ra.issueFinalCertificate(ctx, precert.DER, parsedPrecert, acctID, oID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO(#6587): Make this error case Very Alarming
+	err = ra.matchesCSR(parsedCertificate, csr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	finalizeReq := &sapb.FinalizeOrderRequest{
+		Id:                int64(oID),
+		CertificateSerial: core.SerialToString(parsedCertificate.SerialNumber),
+	}
+	// The alternate certificate is issued by the issuer matching the
+	// alternate CSR's key type, and the SA is told not to count it towards
+	// rate limits.
+	var altCertificate *x509.Certificate
+	if altCSR != nil {
+		altCertificate, err = ra.issueFinalCertificate(ctx, altPrecert.DER, parsedAltPrecert, acctID, oID, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = ra.matchesCSR(altCertificate, altCSR)
+		if err != nil {
+			return nil, nil, err
+		}
+		finalizeReq.AlternateCertificateSerial = core.SerialToString(altCertificate.SerialNumber)
+	}
+
+	_, err = ra.SA.FinalizeOrder(ctx, finalizeReq)
+	if err != nil {
+		return nil, nil, wrapError(err, "persisting finalized order")
 	}
 
-	scts, err := ra.getSCTs(ctx, precert.DER, parsedPrecert.NotAfter)
+	ra.recordIssuanceState(ctx, acctID, oID, core.IssuanceComplete)
+
+	return parsedCertificate, altCertificate, nil
+}
+
+// issueFinalCertificate collects SCTs for the given precertificate, has the CA
+// issue the corresponding final certificate, and submits that final
+// certificate to any configured CT logs. It must only be called once for any
+// given precertificate; see the comment on issueCertificateInner.
+func (ra *RegistrationAuthorityImpl) issueFinalCertificate(
+	ctx context.Context,
+	precertDER []byte,
+	parsedPrecert *x509.Certificate,
+	acctID accountID,
+	oID orderID,
+	alternate bool) (*x509.Certificate, error) {
+	scts, err := ra.getSCTs(ctx, precertDER, parsedPrecert.NotAfter)
 	if err != nil {
 		return nil, wrapError(err, "getting SCTs")
 	}
 
 	cert, err := ra.CA.IssueCertificateForPrecertificate(ctx, &capb.IssueCertificateForPrecertificateRequest{
-		DER:            precert.DER,
+		DER:            precertDER,
 		SCTs:           scts,
 		RegistrationID: int64(acctID),
 		OrderID:        int64(oID),
+		Alternate:      alternate,
 	})
 	if err != nil {
 		return nil, wrapError(err, "issuing certificate for precertificate")
@@ -1306,23 +1561,241 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	// Asynchronously submit the final certificate to any configured logs
 	go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
 
-	// TODO(#6587): Make this error case Very Alarming
-	err = ra.matchesCSR(parsedCertificate, csr)
+	return parsedCertificate, nil
+}
+
+// recordIssuanceState records that the issuance for the given order has moved
+// into the given state, if issuance state tracking is enabled. Errors are
+// logged rather than returned, because by the time we are recording these
+// states there is nothing more useful for the caller to do about them.
+func (ra *RegistrationAuthorityImpl) recordIssuanceState(ctx context.Context, acctID accountID, oID orderID, state core.IssuanceState) {
+	if !features.Enabled(features.TrackIssuanceState) {
+		return
+	}
+	_, err := ra.SA.SetIssuanceState(ctx, &sapb.IssuanceState{
+		OrderID:        int64(oID),
+		RegistrationID: int64(acctID),
+		State:          string(state),
+	})
+	if err != nil {
+		ra.log.AuditErrf("Could not record issuance state %q for order %d: %s", state, oID, err)
+	}
+}
+
+// ReviewOrder records an operator's decision about an order which is awaiting
+// manual review. An approved order becomes ready or pending as its
+// authorizations dictate, while a denied order becomes invalid.
+func (ra *RegistrationAuthorityImpl) ReviewOrder(ctx context.Context, req *rapb.ReviewOrderRequest) (*sapb.OrderReview, error) {
+	if req == nil || req.OrderID == 0 || req.Reviewer == "" || req.Reason == "" {
+		return nil, errIncompleteGRPCRequest
+	}
+	if !features.Enabled(features.OrderReviews) {
+		return nil, berrors.InternalServerError("order reviews are not enabled")
+	}
+
+	review, err := ra.SA.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{
+		OrderID:  req.OrderID,
+		Approved: req.Approved,
+		Reviewer: req.Reviewer,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, wrapError(err, "deciding order review")
+	}
+
+	result := "denied"
+	if req.Approved {
+		result = "approved"
+	}
+	ra.orderReviews.WithLabelValues(result).Inc()
+	ra.log.AuditInfof("Order %d for account %d was %s by %s: %s",
+		req.OrderID, review.RegistrationID, result, req.Reviewer, req.Reason)
+	return review, nil
+}
+
+// ResumeIssuance finishes or abandons an issuance which was interrupted before
+// it could complete, most likely because the RA handling it went away. An
+// issuance which never got as far as a precertificate is abandoned and its
+// order failed. An issuance which has a precertificate is completed by issuing
+// the corresponding final certificate (or reusing it, if the CA already issued
+// it), along with the alternate certificate of a dual certificate order, and
+// finalizing the order. If finishing such an issuance fails for a
+// reason which may be temporary, such as an RPC, SCT or CA failure, the error
+// is returned and the issuance left as it is, to be resumed again later; only
+// a precertificate which can never be completed causes it to be abandoned.
+// The caller is responsible for ensuring that
+// the issuance is no longer in progress anywhere else; the issuance-resumer
+// does so by only resuming issuances which have not been updated for longer
+// than the RA's finalizeTimeout.
+func (ra *RegistrationAuthorityImpl) ResumeIssuance(ctx context.Context, req *rapb.ResumeIssuanceRequest) (*corepb.Order, error) {
+	if req == nil || req.OrderID == 0 {
+		return nil, errIncompleteGRPCRequest
+	}
+	if !features.Enabled(features.TrackIssuanceState) {
+		return nil, berrors.InternalServerError("issuance state tracking is not enabled")
+	}
+
+	state, err := ra.SA.GetIssuanceState(ctx, &sapb.OrderRequest{Id: req.OrderID})
+	if err != nil {
+		return nil, wrapError(err, "getting issuance state")
+	}
+	order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: req.OrderID})
 	if err != nil {
+		return nil, wrapError(err, "getting order")
+	}
+	acctID := accountID(order.RegistrationID)
+	oID := orderID(order.Id)
+
+	logEvent := resumedIssuanceEvent{
+		OrderID:      order.Id,
+		Requester:    order.RegistrationID,
+		State:        core.IssuanceState(state.State),
+		SerialNumber: state.Serial,
+	}
+
+	switch {
+	case logEvent.State == core.IssuanceComplete || logEvent.State == core.IssuanceAbandoned:
+		// Another resumer got here first; there is nothing left to do.
+		return order, nil
+	case order.CertificateSerial != "":
+		// The order was finalized but we went away before recording that.
+		ra.recordIssuanceState(ctx, acctID, oID, core.IssuanceComplete)
+		logEvent.Outcome = core.IssuanceComplete
+	case core.AcmeStatus(order.Status) != core.StatusProcessing:
+		// The order was failed but we went away before recording that.
+		ra.recordIssuanceState(ctx, acctID, oID, core.IssuanceAbandoned)
+		logEvent.Outcome = core.IssuanceAbandoned
+	case logEvent.State == core.IssuancePrecertificateIssued:
+		var cert, altCert *x509.Certificate
+		cert, altCert, err = ra.resumeFromPrecertificate(ctx, order, state)
+		if err != nil {
+			break
+		}
+		order.CertificateSerial = core.SerialToString(cert.SerialNumber)
+		if altCert != nil {
+			order.AlternateCertificateSerial = core.SerialToString(altCert.SerialNumber)
+		}
+		order.Status = string(core.StatusValid)
+		ra.newCertCounter.Inc()
+		ra.recordIssuanceState(ctx, acctID, oID, core.IssuanceComplete)
+		logEvent.Outcome = core.IssuanceComplete
+	default:
+		// We may or may not have asked the CA for a precertificate, but we never
+		// learned its serial, so there is no way to safely finish issuance.
+		err = unresumableError{berrors.InternalServerError("issuance was interrupted before a precertificate was recorded")}
+	}
+
+	var unresumable unresumableError
+	if err != nil && !errors.As(err, &unresumable) {
+		logEvent.Outcome = logEvent.State
+		logEvent.Error = err.Error()
+		ra.log.AuditObject("Failed to resume issuance", logEvent)
 		return nil, err
 	}
+	if err != nil {
+		ra.failOrder(order, web.ProblemDetailsForError(err, "Error finalizing order"))
+		order.Status = string(core.StatusInvalid)
+		ra.abandonIssuance(order)
+		logEvent.Outcome = core.IssuanceAbandoned
+		logEvent.Error = err.Error()
+	}
 
-	_, err = ra.SA.FinalizeOrder(ctx, &sapb.FinalizeOrderRequest{
-		Id:                int64(oID),
+	ra.log.AuditObject("Resumed issuance", logEvent)
+	return order, nil
+}
+
+// resumeFromPrecertificate completes an interrupted issuance for which a
+// precertificate was issued and recorded, and returns the final certificate,
+// along with the alternate final certificate if the order is a dual
+// certificate order.
+func (ra *RegistrationAuthorityImpl) resumeFromPrecertificate(ctx context.Context, order *corepb.Order, state *sapb.IssuanceState) (*x509.Certificate, *x509.Certificate, error) {
+	parsedCertificate, err := ra.resumeCertificate(ctx, order, state.Precertificate, state.Serial, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	finalizeReq := &sapb.FinalizeOrderRequest{
+		Id:                order.Id,
 		CertificateSerial: core.SerialToString(parsedCertificate.SerialNumber),
-	})
+	}
+
+	var altCertificate *x509.Certificate
+	if state.AlternateSerial != "" {
+		altCertificate, err = ra.resumeCertificate(ctx, order, state.AlternatePrecertificate, state.AlternateSerial, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		finalizeReq.AlternateCertificateSerial = core.SerialToString(altCertificate.SerialNumber)
+	}
+
+	_, err = ra.SA.FinalizeOrder(ctx, finalizeReq)
+	if err != nil {
+		return nil, nil, wrapError(err, "persisting finalized order")
+	}
+
+	return parsedCertificate, altCertificate, nil
+}
+
+// resumeCertificate returns the final certificate for a recorded
+// precertificate of an interrupted issuance, issuing it if the CA hadn't
+// already done so.
+func (ra *RegistrationAuthorityImpl) resumeCertificate(ctx context.Context, order *corepb.Order, precertDER []byte, serial string, alternate bool) (*x509.Certificate, error) {
+	parsedPrecert, err := x509.ParseCertificate(precertDER)
 	if err != nil {
-		return nil, wrapError(err, "persisting finalized order")
+		return nil, unresumableError{wrapError(err, "parsing precertificate")}
+	}
+	if core.SerialToString(parsedPrecert.SerialNumber) != serial {
+		return nil, unresumableError{berrors.InternalServerError("recorded precertificate does not match recorded serial %q", serial)}
+	}
+
+	// If the CA issued the final certificate before we went away we must reuse
+	// it, rather than issuing a second certificate with the same serial.
+	var parsedCertificate *x509.Certificate
+	existing, err := ra.SA.GetCertificate(ctx, &sapb.Serial{Serial: serial})
+	switch {
+	case err == nil:
+		parsedCertificate, err = x509.ParseCertificate(existing.Der)
+		if err != nil {
+			return nil, unresumableError{wrapError(err, "parsing final certificate")}
+		}
+	case errors.Is(err, berrors.NotFound):
+		parsedCertificate, err = ra.issueFinalCertificate(ctx, precertDER, parsedPrecert, accountID(order.RegistrationID), orderID(order.Id), alternate)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, wrapError(err, "checking for final certificate")
+	}
+
+	// The CSR is long gone, so check the certificate against the order instead.
+	if !slices.Equal(core.UniqueLowerNames(order.Names), core.UniqueLowerNames(parsedCertificate.DNSNames)) {
+		return nil, unresumableError{berrors.InternalServerError("names in final certificate do not match order")}
 	}
 
 	return parsedCertificate, nil
 }
 
+// unresumableError wraps an error which means an interrupted issuance can
+// never be completed, so ResumeIssuance should abandon it rather than leaving
+// it to be retried.
+type unresumableError struct {
+	error
+}
+
+func (e unresumableError) Unwrap() error {
+	return e.error
+}
+
+// wrapError adds a prefix to an error. If the error is a boulder error then
+// the problem detail is updated with the prefix. Otherwise a new error is
+// returned with the message prefixed using `fmt.Errorf`
+func wrapError(e error, prefix string) error {
+	if berr, ok := e.(*berrors.BoulderError); ok {
+		berr.Detail = fmt.Sprintf("%s: %s", prefix, berr.Detail)
+		return berr
+	}
+	return fmt.Errorf("%s: %s", prefix, e)
+}
+
 func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, expiration time.Time) (core.SCTDERs, error) {
 	started := ra.clk.Now()
 	scts, err := ra.ctpolicy.GetSCTs(ctx, cert, expiration)
@@ -1352,10 +1825,10 @@ func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, e
 func domainsForRateLimiting(names []string) []string {
 	var domains []string
 	for _, name := range names {
-		domain, err := publicsuffix.Domain(name)
+		domain, err := iana.Domain(name)
 		if err != nil {
 			// The only possible errors are:
-			// (1) publicsuffix.Domain is giving garbage values
+			// (1) iana.Domain is giving garbage values
 			// (2) the public suffix is the domain itself
 			// We assume 2 and include the original name in the result.
 			domains = append(domains, name)
@@ -1553,19 +2026,28 @@ func (ra *RegistrationAuthorityImpl) UpdateRegistration(ctx context.Context, req
 	}
 
 	update, changed := mergeUpdate(req.Base, req.Update)
-	if !changed {
-		// If merging the update didn't actually change the base then our work is
-		// done, we can return before calling ra.SA.UpdateRegistration since there's
-		// nothing for the SA to do
-		return req.Base, nil
+	if changed {
+		_, err = ra.SA.UpdateRegistration(ctx, update)
+		if err != nil {
+			// berrors.InternalServerError since the user-data was validated before being
+			// passed to the SA.
+			err = berrors.InternalServerError("Could not update registration: %s", err)
+			return nil, err
+		}
+	} else {
+		// If merging the update didn't actually change the base then there's
+		// nothing for the SA to update.
+		update = req.Base
 	}
 
-	_, err = ra.SA.UpdateRegistration(ctx, update)
-	if err != nil {
-		// berrors.InternalServerError since the user-data was validated before being
-		// passed to the SA.
-		err = berrors.InternalServerError("Could not update registration: %s", err)
-		return nil, err
+	// Agreeing to a version of the terms of service is recorded separately
+	// from the registration, so it may happen even if nothing else changes.
+	// It's only recorded once the rest of the update has succeeded.
+	if req.Update.TosVersion != "" && features.Enabled(features.TrackTermsAgreements) {
+		err = ra.recordTermsAgreement(ctx, req.Base.Id, req.Update.TosVersion)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return update, nil
@@ -1647,9 +2129,10 @@ func mergeUpdate(base *corepb.Registration, update *corepb.Registration) (*corep
 	return res, changed
 }
 
-// recordValidation records an authorization validation event,
-// it should only be used on v2 style authorizations.
-func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authID string, authExpires *time.Time, challenge *core.Challenge) error {
+// recordValidation records an authorization validation event, along with the
+// result of the validation from each perspective, if known. It should only be
+// used on v2 style authorizations.
+func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authID string, authExpires *time.Time, challenge *core.Challenge, perspectives []*corepb.PerspectiveResult) error {
 	authzID, err := strconv.ParseInt(authID, 10, 64)
 	if err != nil {
 		return err
@@ -1676,6 +2159,7 @@ func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authI
 		AttemptedAt:       validated,
 		ValidationRecords: vr.Records,
 		ValidationError:   vr.Problems,
+		Perspectives:      perspectives,
 	})
 	if err != nil {
 		return err
@@ -1715,8 +2199,9 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 
 	ch := &authz.Challenges[challIndex]
 
-	// This challenge type may have been disabled since the challenge was created.
-	if !ra.PA.ChallengeTypeEnabled(ch.Type) {
+	// This challenge type may have been disabled, for this identifier or
+	// account or entirely, since the challenge was created.
+	if !ra.PA.ChallengeTypeAllowed(authz.Identifier, authz.RegistrationID, ch.Type) {
 		return nil, berrors.MalformedError("challenge type %q no longer allowed", ch.Type)
 	}
 
@@ -1784,6 +2269,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 
 		challenge := &authz.Challenges[challIndex]
 		var prob *probs.ProblemDetails
+		var perspectives []*corepb.PerspectiveResult
 
 		if err != nil {
 			prob = probs.ServerInternal("Could not communicate with VA")
@@ -1806,6 +2292,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 				}
 			}
 			challenge.ValidationRecord = records
+			perspectives = res.Perspectives
 		}
 
 		if !challenge.RecordsSane() && prob == nil {
@@ -1821,7 +2308,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		challenge.Validated = &vStart
 		authz.Challenges[challIndex] = *challenge
 
-		err = ra.recordValidation(vaCtx, authz.ID, authz.Expires, challenge)
+		err = ra.recordValidation(vaCtx, authz.ID, authz.Expires, challenge, perspectives)
 		if err != nil {
 			ra.log.AuditErrf("Could not record updated validation: regID=[%d] authzID=[%s] err=[%s]",
 				authz.RegistrationID, authz.ID, err)
@@ -2274,17 +2761,74 @@ func (ra *RegistrationAuthorityImpl) DeactivateAuthorization(ctx context.Context
 // checkOrderNames validates that the RA's policy authority allows issuing for
 // each of the names in an order. If any of the names are unacceptable a
 // malformed or rejectedIdentifier error with suberrors for each rejected
-// identifier is returned.
-func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
+// identifier is returned. Otherwise, the reasons any of the names require
+// manual review are returned.
+func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) ([]*sapb.OrderReviewReason, error) {
 	idents := make([]identifier.ACMEIdentifier, len(names))
 	for i, name := range names {
 		idents[i] = identifier.DNSIdentifier(name)
 	}
 	err := ra.PA.WillingToIssueWildcards(idents)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	reviewReasons, err := ra.checkConfusableNames(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		rule := ra.PA.ReviewRequired(name)
+		if rule != "" {
+			ra.log.AuditInfof("Name %q requires review rule=[%s]", name, rule)
+			reviewReasons = append(reviewReasons, &sapb.OrderReviewReason{Name: name, Rule: rule})
+		}
+	}
+	return reviewReasons, nil
+}
+
+// checkConfusableNames applies the configured confusable name action to each
+// name which the PA finds visually confusable with a protected name. If the
+// action is to reject them, a rejectedIdentifier error with suberrors for each
+// such name is returned. If the action is to review them, a review reason for
+// each such name is returned.
+func (ra *RegistrationAuthorityImpl) checkConfusableNames(names []string) ([]*sapb.OrderReviewReason, error) {
+	if ra.confusableAction == "" {
+		return nil, nil
+	}
+	var reviewReasons []*sapb.OrderReviewReason
+	var subErrors []berrors.SubBoulderError
+	for _, name := range names {
+		label, target, rule := ra.PA.ConfusableMatch(name)
+		if rule == "" {
+			continue
+		}
+		ra.confusableNames.WithLabelValues(ra.confusableAction).Inc()
+		if ra.confusableAction == confusableReview {
+			ra.log.AuditInfof("Name %q requires review: label %q is confusable with %q rule=[%s]", name, label, target, rule)
+			reviewReasons = append(reviewReasons, &sapb.OrderReviewReason{Name: name, Rule: "confusable:" + rule})
+			continue
+		}
+		ra.log.AuditInfof("Rejected name %q: label %q is confusable with %q rule=[%s]", name, label, target, rule)
+		subErrors = append(subErrors, berrors.SubBoulderError{
+			Identifier: identifier.DNSIdentifier(name),
+			BoulderError: &berrors.BoulderError{
+				Type:   berrors.RejectedIdentifier,
+				Detail: "Domain name is visually confusable with a name the ACME server protects",
+			},
+		})
+	}
+	if len(subErrors) == 0 {
+		return reviewReasons, nil
+	}
+	if len(subErrors) == 1 {
+		return nil, berrors.RejectedIdentifierError("Cannot issue for %q: %s",
+			subErrors[0].Identifier.Value, subErrors[0].BoulderError.Detail)
+	}
+	return nil, (&berrors.BoulderError{
+		Type: berrors.RejectedIdentifier,
+		Detail: fmt.Sprintf("Cannot issue for %q: %s (and %d more problems. Refer to sub-problems for more information.)",
+			subErrors[0].Identifier.Value, subErrors[0].BoulderError.Detail, len(subErrors)-1),
+	}).WithSubErrors(subErrors)
 }
 
 // GenerateOCSP looks up a certificate's status, then requests a signed OCSP
@@ -2337,11 +2881,17 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			"Order cannot contain more than %d DNS names", ra.maxNames)
 	}
 
-	// Validate that our policy allows issuing for each of the names in the order
-	err := ra.checkOrderNames(newOrder.Names)
+	// Validate that our policy allows issuing for each of the names in the
+	// order, and find out whether any of them require manual review.
+	reviewReasons, err := ra.checkOrderNames(newOrder.Names)
 	if err != nil {
 		return nil, err
 	}
+	if !features.Enabled(features.OrderReviews) {
+		// Without somewhere to queue them, names requiring review are only
+		// audit logged.
+		reviewReasons = nil
+	}
 
 	err = wildcardOverlap(newOrder.Names)
 	if err != nil {
@@ -2416,6 +2966,13 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		}
 		authz := nameToExistingAuthz[name]
 		authzAge := (ra.authorizationLifetime - time.Unix(0, authz.Expires).Sub(ra.clk.Now())).Seconds()
+		// The challenge rules may have changed since the authz was created,
+		// in which case it can't be reused.
+		if !ra.authzChallengesAllowed(name, authz) {
+			delete(nameToExistingAuthz, name)
+			missingAuthzNames = append(missingAuthzNames, name)
+			continue
+		}
 		// If the identifier is a wildcard and the existing authz only has one
 		// DNS-01 type challenge we can reuse it. In theory we will
 		// never get back an authorization for a domain with a wildcard prefix
@@ -2505,8 +3062,9 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	newOrder.Expires = minExpiry.Truncate(time.Second).UnixNano()
 
 	newOrderAndAuthzsReq := &sapb.NewOrderAndAuthzsRequest{
-		NewOrder:  newOrder,
-		NewAuthzs: newAuthzs,
+		NewOrder:      newOrder,
+		NewAuthzs:     newAuthzs,
+		ReviewReasons: reviewReasons,
 	}
 	storedOrder, err := ra.SA.NewOrderAndAuthzs(ctx, newOrderAndAuthzsReq)
 	if err != nil {
@@ -2515,6 +3073,10 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	if storedOrder.Id == 0 || storedOrder.Created == 0 || storedOrder.Status == "" || storedOrder.RegistrationID == 0 || storedOrder.Expires == 0 || len(storedOrder.Names) == 0 {
 		return nil, errIncompleteGRPCResponse
 	}
+	if len(reviewReasons) > 0 {
+		ra.orderReviews.WithLabelValues("queued").Inc()
+		ra.log.AuditInfof("Order %d for account %d was queued for review", storedOrder.Id, storedOrder.RegistrationID)
+	}
 	ra.orderAges.WithLabelValues("NewOrder").Observe(0)
 
 	// Note how many names are being requested in this certificate order.
@@ -2523,6 +3085,23 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	return storedOrder, nil
 }
 
+// authzChallengesAllowed returns whether an existing authz for name can be
+// reused under the current challenge rules: a valid authz only if the
+// challenge type it was solved with is still allowed for name and its
+// account, and a pending authz only if any of its challenge types are.
+func (ra *RegistrationAuthorityImpl) authzChallengesAllowed(name string, authz *corepb.Authorization) bool {
+	ident := identifier.DNSIdentifier(name)
+	for _, chall := range authz.Challenges {
+		if authz.Status == string(core.StatusValid) && chall.Status != string(core.StatusValid) {
+			continue
+		}
+		if ra.PA.ChallengeTypeAllowed(ident, authz.RegistrationID, core.AcmeChallenge(chall.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
 // createPendingAuthz checks that a name is allowed for issuance and creates the
 // necessary challenges for it and puts this and all of the relevant information
 // into a corepb.Authorization for transmission to the SA to be stored
@@ -2535,11 +3114,15 @@ func (ra *RegistrationAuthorityImpl) createPendingAuthz(reg int64, identifier id
 	}
 
 	// Create challenges. The WFE will update them with URIs before sending them out.
-	challenges, err := ra.PA.ChallengesFor(identifier)
+	challenges, err := ra.PA.ChallengesFor(identifier, reg)
 	if err != nil {
-		// The only time ChallengesFor errors it is a fatal configuration error
-		// where challenges required by policy for an identifier are not enabled. We
-		// want to treat this as an internal server error.
+		// ChallengesFor refuses identifiers for which the challenge rules leave
+		// no challenge types enabled, which the client needs to know about.
+		// Any other error is a fatal configuration error, which we want to
+		// treat as an internal server error.
+		if errors.Is(err, berrors.RejectedIdentifier) {
+			return nil, err
+		}
 		return nil, berrors.InternalServerError(err.Error())
 	}
 	// Check each challenge for sanity.
@@ -3,6 +3,7 @@ package ra
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -10,6 +11,7 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -44,6 +46,7 @@ import (
 	"github.com/letsencrypt/boulder/ctpolicy"
 	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
@@ -738,6 +741,122 @@ func TestUpdateRegistrationSame(t *testing.T) {
 	test.AssertNotError(t, err, "Error updating registration")
 }
 
+// mockSATermsAgreements is a StorageAuthority which keeps the terms of service
+// versions each account has agreed to, and fails registration updates if
+// failUpdate is set.
+type mockSATermsAgreements struct {
+	mocks.StorageAuthority
+	agreements map[int64][]string
+	failUpdate bool
+}
+
+func (sa *mockSATermsAgreements) NewRegistration(_ context.Context, req *corepb.Registration, _ ...grpc.CallOption) (*corepb.Registration, error) {
+	if req.TosVersion != "" {
+		sa.agreements[1] = append(sa.agreements[1], req.TosVersion)
+	}
+	return &corepb.Registration{
+		Id:        1,
+		Key:       req.Key,
+		Agreement: req.Agreement,
+		InitialIP: req.InitialIP,
+		Status:    req.Status,
+	}, nil
+}
+
+func (sa *mockSATermsAgreements) UpdateRegistration(_ context.Context, _ *corepb.Registration, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if sa.failUpdate {
+		return nil, errors.New("oops")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *mockSATermsAgreements) AddTermsAgreement(_ context.Context, req *sapb.TermsAgreement, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.agreements[req.RegistrationID] = append(sa.agreements[req.RegistrationID], req.Version)
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *mockSATermsAgreements) GetTermsAgreement(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.TermsAgreement, error) {
+	versions := sa.agreements[req.Id]
+	if len(versions) == 0 {
+		return nil, berrors.NotFoundError("no terms of service agreement for registration ID %d", req.Id)
+	}
+	return &sapb.TermsAgreement{RegistrationID: req.Id, Version: versions[len(versions)-1]}, nil
+}
+
+func TestTermsAgreement(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	msa := &mockSATermsAgreements{
+		StorageAuthority: *mocks.NewStorageAuthority(fc),
+		agreements:       make(map[int64][]string),
+	}
+	ra.SA = msa
+
+	err := features.Set(map[string]bool{"TrackTermsAgreements": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	acctKeyB, err := AccountKeyB.MarshalJSON()
+	test.AssertNotError(t, err, "failed to marshal account key")
+	reg, err := ra.NewRegistration(ctx, &corepb.Registration{
+		Key:        acctKeyB,
+		InitialIP:  parseAndMarshalIP(t, "7.6.6.5"),
+		Agreement:  "https://example.com/terms/2023-01-01",
+		TosVersion: "2023-01-01",
+	})
+	test.AssertNotError(t, err, "creating registration")
+	test.AssertDeepEquals(t, msa.agreements[reg.Id], []string{"2023-01-01"})
+
+	// Agreeing to the same version again isn't recorded.
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{TosVersion: "2023-01-01"},
+	})
+	test.AssertNotError(t, err, "updating registration")
+	test.AssertDeepEquals(t, msa.agreements[reg.Id], []string{"2023-01-01"})
+
+	// Agreeing to a new version is recorded, even though nothing else about
+	// the registration changes.
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{TosVersion: "2023-07-01"},
+	})
+	test.AssertNotError(t, err, "updating registration")
+	test.AssertDeepEquals(t, msa.agreements[reg.Id], []string{"2023-01-01", "2023-07-01"})
+
+	// Updates which don't agree to the terms of service don't record anything.
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{Agreement: "https://example.com/terms/2023-07-01"},
+	})
+	test.AssertNotError(t, err, "updating registration")
+	test.AssertEquals(t, len(msa.agreements[reg.Id]), 2)
+
+	// Nor do updates which fail, or which are invalid.
+	msa.failUpdate = true
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{Agreement: "https://example.com/terms/2024-01-01", TosVersion: "2024-01-01"},
+	})
+	test.AssertError(t, err, "updating registration should have failed")
+	msa.failUpdate = false
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{Contact: []string{"tel:5555555555"}, ContactsPresent: true, TosVersion: "2024-01-01"},
+	})
+	test.AssertError(t, err, "updating registration with an invalid contact should have failed")
+	test.AssertEquals(t, len(msa.agreements[reg.Id]), 2)
+
+	// Without the TrackTermsAgreements feature, nothing is recorded.
+	features.Reset()
+	_, err = ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{
+		Base:   reg,
+		Update: &corepb.Registration{TosVersion: "2024-01-01"},
+	})
+	test.AssertNotError(t, err, "updating registration")
+	test.AssertEquals(t, len(msa.agreements[reg.Id]), 2)
+}
+
 func TestPerformValidationExpired(t *testing.T) {
 	_, sa, ra, fc, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3249,10 +3368,10 @@ func TestUpdateMissingAuthorization(t *testing.T) {
 	// Twiddle the authz to pretend its been validated by the VA
 	authz.Status = "valid"
 	authz.Challenges[0].Status = "valid"
-	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0])
+	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0], nil)
 	test.AssertNotError(t, err, "ra.recordValidation failed")
 
-	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0])
+	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0], nil)
 	test.AssertError(t, err, "ra.recordValidation didn't fail")
 	test.AssertErrorIs(t, err, berrors.NotFound)
 }
@@ -3427,6 +3546,50 @@ func (ca *mockCAFailCertForPrecert) IssueCertificateForPrecertificate(
 	return &corepb.Certificate{}, ca.err
 }
 
+func TestValidateAlternateCSR(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating RSA key")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "error generating ECDSA key")
+
+	makeCSR := func(key crypto.Signer, names ...string) []byte {
+		t.Helper()
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: names}, key)
+		test.AssertNotError(t, err, "error creating CSR")
+		return der
+	}
+	csr, err := x509.ParseCertificateRequest(makeCSR(rsaKey, "a.com", "b.com"))
+	test.AssertNotError(t, err, "error parsing CSR")
+	orderNames := []string{"a.com", "b.com"}
+
+	// Alternate CSRs are rejected unless the feature is enabled.
+	_, err = ra.validateAlternateCSR(ctx, csr, makeCSR(ecKey, "a.com", "b.com"), orderNames)
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	err = features.Set(map[string]bool{"DualCertificateOrders": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	altCSR, err := ra.validateAlternateCSR(ctx, csr, makeCSR(ecKey, "b.com", "a.com"), orderNames)
+	test.AssertNotError(t, err, "validateAlternateCSR failed for a valid alternate CSR")
+	test.AssertEquals(t, altCSR.PublicKeyAlgorithm, x509.ECDSA)
+
+	_, err = ra.validateAlternateCSR(ctx, csr, []byte("not a CSR"), orderNames)
+	test.AssertErrorIs(t, err, berrors.BadCSR)
+
+	_, err = ra.validateAlternateCSR(ctx, csr, makeCSR(rsaKey, "a.com", "b.com"), orderNames)
+	test.AssertErrorIs(t, err, berrors.BadCSR)
+
+	_, err = ra.validateAlternateCSR(ctx, csr, makeCSR(ecKey, "a.com"), orderNames)
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+
+	_, err = ra.validateAlternateCSR(ctx, csr, makeCSR(ecKey, "a.com", "c.com"), orderNames)
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+}
+
 // TestIssueCertificateInnerErrs tests that errors from the CA caught during
 // `ra.issueCertificateInner` are propagated correctly, with the part of the
 // issuance process that failed prefixed on the error message.
@@ -3516,7 +3679,7 @@ func TestIssueCertificateInnerErrs(t *testing.T) {
 			// Mock the CA
 			ra.CA = tc.Mock
 			// Attempt issuance
-			_, err = ra.issueCertificateInner(ctx, csrOb, accountID(Registration.Id), orderID(order.Id))
+			_, _, err = ra.issueCertificateInner(ctx, csrOb, nil, accountID(Registration.Id), orderID(order.Id))
 			// We expect all of the testcases to fail because all use mocked CAs that deliberately error
 			test.AssertError(t, err, "issueCertificateInner with failing mock CA did not fail")
 			// If there is an expected `error` then match the error message
@@ -3535,6 +3698,319 @@ func TestIssueCertificateInnerErrs(t *testing.T) {
 	}
 }
 
+// mockSAResumeIssuance is a mock SA which holds a single order and its
+// issuance state, for exercising ResumeIssuance.
+type mockSAResumeIssuance struct {
+	sapb.StorageAuthorityClient
+	order       *corepb.Order
+	state       *sapb.IssuanceState
+	certificate []byte
+	finalized   string
+	// finalizedAlternate is the alternate certificate serial the order was
+	// finalized with, if any.
+	finalizedAlternate string
+}
+
+func (sa *mockSAResumeIssuance) GetIssuanceState(context.Context, *sapb.OrderRequest, ...grpc.CallOption) (*sapb.IssuanceState, error) {
+	return sa.state, nil
+}
+
+func (sa *mockSAResumeIssuance) SetIssuanceState(_ context.Context, req *sapb.IssuanceState, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.state.State = req.State
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *mockSAResumeIssuance) GetOrder(context.Context, *sapb.OrderRequest, ...grpc.CallOption) (*corepb.Order, error) {
+	return sa.order, nil
+}
+
+func (sa *mockSAResumeIssuance) SetOrderError(_ context.Context, req *sapb.SetOrderErrorRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.order.Error = req.Error
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *mockSAResumeIssuance) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	if sa.certificate == nil {
+		return nil, berrors.NotFoundError("certificate with serial %q not found", req.Serial)
+	}
+	cert, err := x509.ParseCertificate(sa.certificate)
+	if err != nil {
+		return nil, err
+	}
+	if core.SerialToString(cert.SerialNumber) != req.Serial {
+		return nil, berrors.NotFoundError("certificate with serial %q not found", req.Serial)
+	}
+	return &corepb.Certificate{Der: sa.certificate, Serial: req.Serial}, nil
+}
+
+func (sa *mockSAResumeIssuance) FinalizeOrder(_ context.Context, req *sapb.FinalizeOrderRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.finalized = req.CertificateSerial
+	sa.finalizedAlternate = req.AlternateCertificateSerial
+	return &emptypb.Empty{}, nil
+}
+
+// mockCAResumeIssuance is a mock CA which issues final certificates by
+// re-signing the given precertificate without its poison extension.
+// If failures is non-zero, that many calls fail before any succeed.
+type mockCAResumeIssuance struct {
+	mocks.MockCA
+	key      *ecdsa.PrivateKey
+	issued   int
+	failures int
+}
+
+func (ca *mockCAResumeIssuance) IssueCertificateForPrecertificate(_ context.Context, req *capb.IssueCertificateForPrecertificateRequest, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	if ca.failures > 0 {
+		ca.failures--
+		return nil, berrors.InternalServerError("CA unavailable")
+	}
+	precert, err := x509.ParseCertificate(req.DER)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: precert.SerialNumber,
+		DNSNames:     precert.DNSNames,
+		NotAfter:     precert.NotAfter,
+	}, precert, ca.key.Public(), ca.key)
+	if err != nil {
+		return nil, err
+	}
+	ca.issued++
+	return &corepb.Certificate{Der: der}, nil
+}
+
+func TestResumeIssuance(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"TrackIssuanceState": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	precertPoison := []pkix.Extension{
+		{
+			Id:       asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3},
+			Critical: true,
+			Value:    []byte{0x05, 0x00},
+		},
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:    big.NewInt(1337),
+		DNSNames:        []string{"example.com", "www.example.com"},
+		NotAfter:        fc.Now().Add(24 * time.Hour),
+		ExtraExtensions: precertPoison,
+	}
+	precertDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	test.AssertNotError(t, err, "creating precertificate")
+	tmpl.ExtraExtensions = nil
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	test.AssertNotError(t, err, "creating certificate")
+	serial := core.SerialToString(tmpl.SerialNumber)
+
+	// The alternate precertificate of a dual certificate order. The mock CA
+	// only needs its serial to differ from the primary precertificate's.
+	tmpl.SerialNumber = big.NewInt(1338)
+	tmpl.ExtraExtensions = precertPoison
+	altPrecertDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	test.AssertNotError(t, err, "creating alternate precertificate")
+	altSerial := core.SerialToString(tmpl.SerialNumber)
+
+	testCases := []struct {
+		name           string
+		state          core.IssuanceState
+		orderStatus    core.AcmeStatus
+		orderSerial    string
+		orderNames     []string
+		existingCert   []byte
+		dual           bool
+		expectStatus   core.AcmeStatus
+		expectState    core.IssuanceState
+		expectIssued   int
+		expectFinalize bool
+	}{
+		{
+			name:         "already complete",
+			state:        core.IssuanceComplete,
+			orderStatus:  core.StatusValid,
+			orderSerial:  serial,
+			expectStatus: core.StatusValid,
+			expectState:  core.IssuanceComplete,
+		},
+		{
+			name:         "order finalized but not recorded",
+			state:        core.IssuancePrecertificateIssued,
+			orderStatus:  core.StatusValid,
+			orderSerial:  serial,
+			expectStatus: core.StatusValid,
+			expectState:  core.IssuanceComplete,
+		},
+		{
+			name:         "order failed but not recorded",
+			state:        core.IssuanceStarted,
+			orderStatus:  core.StatusInvalid,
+			expectStatus: core.StatusInvalid,
+			expectState:  core.IssuanceAbandoned,
+		},
+		{
+			name:         "interrupted before precertificate",
+			state:        core.IssuanceStarted,
+			orderStatus:  core.StatusProcessing,
+			expectStatus: core.StatusInvalid,
+			expectState:  core.IssuanceAbandoned,
+		},
+		{
+			name:           "interrupted after precertificate",
+			state:          core.IssuancePrecertificateIssued,
+			orderStatus:    core.StatusProcessing,
+			orderNames:     []string{"www.example.com", "example.com"},
+			expectStatus:   core.StatusValid,
+			expectState:    core.IssuanceComplete,
+			expectIssued:   1,
+			expectFinalize: true,
+		},
+		{
+			name:           "interrupted after final certificate",
+			state:          core.IssuancePrecertificateIssued,
+			orderStatus:    core.StatusProcessing,
+			orderNames:     []string{"example.com", "www.example.com"},
+			existingCert:   certDER,
+			expectStatus:   core.StatusValid,
+			expectState:    core.IssuanceComplete,
+			expectFinalize: true,
+		},
+		{
+			name:           "interrupted after dual precertificates",
+			state:          core.IssuancePrecertificateIssued,
+			orderStatus:    core.StatusProcessing,
+			orderNames:     []string{"example.com", "www.example.com"},
+			existingCert:   certDER,
+			dual:           true,
+			expectStatus:   core.StatusValid,
+			expectState:    core.IssuanceComplete,
+			expectIssued:   1,
+			expectFinalize: true,
+		},
+		{
+			name:         "final certificate doesn't match order",
+			state:        core.IssuancePrecertificateIssued,
+			orderStatus:  core.StatusProcessing,
+			orderNames:   []string{"example.com"},
+			existingCert: certDER,
+			expectStatus: core.StatusInvalid,
+			expectState:  core.IssuanceAbandoned,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSA := &mockSAResumeIssuance{
+				order: &corepb.Order{
+					Id:                1,
+					RegistrationID:    Registration.Id,
+					Status:            string(tc.orderStatus),
+					Names:             tc.orderNames,
+					CertificateSerial: tc.orderSerial,
+				},
+				state: &sapb.IssuanceState{
+					OrderID:        1,
+					RegistrationID: Registration.Id,
+					State:          string(tc.state),
+				},
+				certificate: tc.existingCert,
+			}
+			if tc.state == core.IssuancePrecertificateIssued {
+				mockSA.state.Serial = serial
+				mockSA.state.Precertificate = precertDER
+			}
+			if tc.dual {
+				mockSA.state.AlternateSerial = altSerial
+				mockSA.state.AlternatePrecertificate = altPrecertDER
+			}
+			mockCA := &mockCAResumeIssuance{key: key}
+			ra.SA = mockSA
+			ra.CA = mockCA
+
+			order, err := ra.ResumeIssuance(ctx, &rapb.ResumeIssuanceRequest{OrderID: 1})
+			test.AssertNotError(t, err, "ResumeIssuance failed")
+			test.AssertEquals(t, order.Status, string(tc.expectStatus))
+			test.AssertEquals(t, mockSA.state.State, string(tc.expectState))
+			test.AssertEquals(t, mockCA.issued, tc.expectIssued)
+			if tc.expectFinalize {
+				test.AssertEquals(t, mockSA.finalized, serial)
+				test.AssertEquals(t, order.CertificateSerial, serial)
+				if tc.dual {
+					test.AssertEquals(t, mockSA.finalizedAlternate, altSerial)
+					test.AssertEquals(t, order.AlternateCertificateSerial, altSerial)
+				}
+			} else {
+				test.AssertEquals(t, mockSA.finalized, "")
+			}
+			if tc.expectStatus == core.StatusInvalid && tc.orderStatus == core.StatusProcessing {
+				test.AssertNotNil(t, mockSA.order.Error, "order error should have been set")
+			}
+		})
+	}
+}
+
+func TestResumeIssuanceRetriesCAFailure(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"TrackIssuanceState": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1337),
+		DNSNames:     []string{"example.com"},
+		NotAfter:     fc.Now().Add(24 * time.Hour),
+	}
+	precertDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	test.AssertNotError(t, err, "creating precertificate")
+	serial := core.SerialToString(tmpl.SerialNumber)
+
+	mockSA := &mockSAResumeIssuance{
+		order: &corepb.Order{
+			Id:             1,
+			RegistrationID: Registration.Id,
+			Status:         string(core.StatusProcessing),
+			Names:          []string{"example.com"},
+		},
+		state: &sapb.IssuanceState{
+			OrderID:        1,
+			RegistrationID: Registration.Id,
+			State:          string(core.IssuancePrecertificateIssued),
+			Serial:         serial,
+			Precertificate: precertDER,
+		},
+	}
+	mockCA := &mockCAResumeIssuance{key: key, failures: 1}
+	ra.SA = mockSA
+	ra.CA = mockCA
+
+	// The first attempt fails at the CA, which should leave the issuance and
+	// its order untouched so that it can be resumed again.
+	_, err = ra.ResumeIssuance(ctx, &rapb.ResumeIssuanceRequest{OrderID: 1})
+	test.AssertError(t, err, "ResumeIssuance should have failed")
+	test.AssertEquals(t, mockSA.state.State, string(core.IssuancePrecertificateIssued))
+	test.AssertEquals(t, mockSA.order.Status, string(core.StatusProcessing))
+	test.Assert(t, mockSA.order.Error == nil, "order error should not have been set")
+	test.AssertEquals(t, mockSA.finalized, "")
+
+	// The next attempt should complete the issuance.
+	order, err := ra.ResumeIssuance(ctx, &rapb.ResumeIssuanceRequest{OrderID: 1})
+	test.AssertNotError(t, err, "ResumeIssuance failed")
+	test.AssertEquals(t, order.Status, string(core.StatusValid))
+	test.AssertEquals(t, mockSA.state.State, string(core.IssuanceComplete))
+	test.AssertEquals(t, mockCA.issued, 1)
+	test.AssertEquals(t, mockSA.finalized, serial)
+}
+
 func TestNewOrderMaxNames(t *testing.T) {
 	_, _, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3992,3 +4468,261 @@ func TestAdministrativelyRevokeCertificate(t *testing.T) {
 	})
 	test.AssertError(t, err, "AdministrativelyRevokeCertificate should have failed with just serial for keyCompromise")
 }
+
+func TestCheckConfusableNames(t *testing.T) {
+	pa, err := policy.New(map[core.AcmeChallenge]bool{core.ChallengeTypeDNS01: true}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+
+	log := blog.NewMock()
+	ra := NewRegistrationAuthorityImpl(
+		clock.NewFake(), log, metrics.NoopRegisterer,
+		1, testKeyPolicy, 100,
+		300*24*time.Hour, 7*24*time.Hour,
+		nil, noopCAA{},
+		0, 5*time.Minute,
+		nil, nil, nil)
+	ra.PA = pa
+
+	// lеtsеncrурt.org, with Cyrillic е, у and р, is confusable with the
+	// "letsencrypt" entry in ConfusableBrandNames.
+	names := []string{"xn--ltsncrt-7ggc7i6a.org", "example.com"}
+
+	// By default names aren't checked.
+	reasons, err := ra.checkOrderNames(names)
+	test.AssertNotError(t, err, "checking names without a confusable name action")
+	test.AssertEquals(t, len(reasons), 0)
+
+	err = ra.SetConfusableNameAction("review")
+	test.AssertNotError(t, err, "setting confusable name action")
+	reasons, err = ra.checkOrderNames(names)
+	test.AssertNotError(t, err, "checking names with the review action")
+	test.AssertEquals(t, len(reasons), 1)
+	test.AssertEquals(t, reasons[0].Name, "xn--ltsncrt-7ggc7i6a.org")
+	test.AssertEquals(t, reasons[0].Rule, "confusable:ConfusableBrandNames:letsencrypt")
+	test.AssertEquals(t, len(log.GetAllMatching(`Name "xn--ltsncrt-7ggc7i6a.org" requires review: label "lеtsеncrурt" is confusable with "letsencrypt" rule=\[ConfusableBrandNames:letsencrypt\]`)), 1)
+	test.AssertMetricWithLabelsEquals(t, ra.confusableNames, prometheus.Labels{"action": "review"}, 1)
+
+	err = ra.SetConfusableNameAction("reject")
+	test.AssertNotError(t, err, "setting confusable name action")
+	_, err = ra.checkOrderNames(names)
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.AssertContains(t, err.Error(), `Cannot issue for "xn--ltsncrt-7ggc7i6a.org"`)
+	test.AssertEquals(t, len(log.GetAllMatching(`Rejected name "xn--ltsncrt-7ggc7i6a.org"`)), 1)
+
+	err = ra.SetConfusableNameAction("ignore")
+	test.AssertError(t, err, "set an unknown confusable name action")
+}
+
+func TestChallengeRulesAuthzs(t *testing.T) {
+	pa, err := policy.New(map[core.AcmeChallenge]bool{
+		core.ChallengeTypeHTTP01: true,
+		core.ChallengeTypeDNS01:  true,
+	}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetChallengeRulesFile("../test/challenge-rules.yaml")
+	test.AssertNotError(t, err, "Couldn't set challenge rules")
+
+	ra := NewRegistrationAuthorityImpl(
+		clock.NewFake(), blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100,
+		300*24*time.Hour, 7*24*time.Hour,
+		nil, noopCAA{},
+		0, 5*time.Minute,
+		nil, nil, nil)
+	ra.PA = pa
+
+	authz := func(regID int64, status core.AcmeStatus, challs ...*corepb.Challenge) *corepb.Authorization {
+		return &corepb.Authorization{Identifier: "host.internal", RegistrationID: regID, Status: string(status), Challenges: challs}
+	}
+	http01 := func(status core.AcmeStatus) *corepb.Challenge {
+		return &corepb.Challenge{Type: string(core.ChallengeTypeHTTP01), Status: string(status)}
+	}
+	dns01 := func(status core.AcmeStatus) *corepb.Challenge {
+		return &corepb.Challenge{Type: string(core.ChallengeTypeDNS01), Status: string(status)}
+	}
+
+	// The rules only allow HTTP-01 for .internal names to account 1, so
+	// authzs for other accounts which were solved with it can't be reused.
+	test.Assert(t, ra.authzChallengesAllowed("host.internal", authz(1, core.StatusValid, http01(core.StatusValid), dns01(core.StatusPending))),
+		"HTTP-01 authz not reusable by account 1")
+	test.Assert(t, !ra.authzChallengesAllowed("host.internal", authz(2, core.StatusValid, http01(core.StatusValid), dns01(core.StatusPending))),
+		"HTTP-01 authz reusable by account 2")
+	test.Assert(t, ra.authzChallengesAllowed("host.internal", authz(2, core.StatusValid, http01(core.StatusPending), dns01(core.StatusValid))),
+		"DNS-01 authz not reusable by account 2")
+
+	// Pending authzs can be reused if any of their challenges can still be
+	// attempted.
+	test.Assert(t, ra.authzChallengesAllowed("host.internal", authz(2, core.StatusPending, http01(core.StatusPending), dns01(core.StatusPending))),
+		"pending authz with DNS-01 not reusable by account 2")
+	test.Assert(t, !ra.authzChallengesAllowed("host.internal", authz(2, core.StatusPending, http01(core.StatusPending))),
+		"pending HTTP-01 authz reusable by account 2")
+
+	// Identifiers with no challenge types left are rejected, rather than
+	// treated as a server error.
+	pa, err = policy.New(map[core.AcmeChallenge]bool{}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	ra.PA = pa
+	_, err = ra.createPendingAuthz(2, identifier.DNSIdentifier("host.internal"))
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+}
+
+// mockCADualIssuance is a mock CA which issues precertificates and final
+// certificates matching the given CSRs, optionally failing to issue the
+// alternate precertificate of a dual certificate order.
+type mockCADualIssuance struct {
+	mocks.MockCA
+	key           *ecdsa.PrivateKey
+	clk           clock.Clock
+	failAlternate bool
+	serial        int64
+	// primaryIssued is set once a precertificate has been issued for a
+	// primary CSR.
+	primaryIssued bool
+}
+
+func (ca *mockCADualIssuance) issue(csr *x509.CertificateRequest, serial *big.Int, poison bool) ([]byte, error) {
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: csr.Subject.CommonName},
+		DNSNames:              csr.DNSNames,
+		NotBefore:             ca.clk.Now(),
+		NotAfter:              ca.clk.Now().Add(90 * 24 * time.Hour),
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if poison {
+		tmpl.ExtraExtensions = []pkix.Extension{{
+			Id:       asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3},
+			Critical: true,
+			Value:    []byte{0x05, 0x00},
+		}}
+	}
+	return x509.CreateCertificate(rand.Reader, tmpl, tmpl, csr.PublicKey, ca.key)
+}
+
+func (ca *mockCADualIssuance) IssuePrecertificate(_ context.Context, req *capb.IssueCertificateRequest, _ ...grpc.CallOption) (*capb.IssuePrecertificateResponse, error) {
+	if req.Alternate && ca.failAlternate {
+		return nil, errors.New("alternate issuer is unavailable")
+	}
+	csr, err := x509.ParseCertificateRequest(req.Csr)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Alternate {
+		ca.primaryIssued = true
+	}
+	ca.serial++
+	der, err := ca.issue(csr, big.NewInt(ca.serial), true)
+	if err != nil {
+		return nil, err
+	}
+	return &capb.IssuePrecertificateResponse{DER: der}, nil
+}
+
+func (ca *mockCADualIssuance) IssueCertificateForPrecertificate(_ context.Context, req *capb.IssueCertificateForPrecertificateRequest, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	precert, err := x509.ParseCertificate(req.DER)
+	if err != nil {
+		return nil, err
+	}
+	der, err := ca.issue(&x509.CertificateRequest{
+		Subject:   precert.Subject,
+		DNSNames:  precert.DNSNames,
+		PublicKey: precert.PublicKey,
+	}, precert.SerialNumber, false)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Certificate{Der: der}, nil
+}
+
+// mockSADualIssuance is a mock SA which records how orders are finalized and
+// the issuance states recorded for them.
+type mockSADualIssuance struct {
+	mocks.StorageAuthority
+	finalized *sapb.FinalizeOrderRequest
+	states    []*sapb.IssuanceState
+}
+
+func (sa *mockSADualIssuance) FinalizeOrder(_ context.Context, req *sapb.FinalizeOrderRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.finalized = req
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *mockSADualIssuance) SetIssuanceState(_ context.Context, req *sapb.IssuanceState, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.states = append(sa.states, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestIssueCertificateInnerAlternate(t *testing.T) {
+	err := features.Set(map[string]bool{"TrackIssuanceState": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	ra := NewRegistrationAuthorityImpl(
+		fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100,
+		300*24*time.Hour, 7*24*time.Hour,
+		nil, noopCAA{},
+		0, 5*time.Minute,
+		ctpolicy.New(&mocks.PublisherClient{}, loglist.List{
+			"OperA": {"LogA1": {Url: "UrlA1", Key: "KeyA1"}},
+			"OperB": {"LogB1": {Url: "UrlB1", Key: "KeyB1"}},
+		}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer),
+		nil, nil)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating CA key")
+	makeCSR := func(key crypto.Signer) *x509.CertificateRequest {
+		t.Helper()
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: "example.com"},
+			DNSNames: []string{"example.com", "www.example.com"},
+		}, key)
+		test.AssertNotError(t, err, "creating CSR")
+		csr, err := x509.ParseCertificateRequest(der)
+		test.AssertNotError(t, err, "parsing CSR")
+		return csr
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	csr, altCSR := makeCSR(rsaKey), makeCSR(ecKey)
+
+	msa := &mockSADualIssuance{StorageAuthority: *mocks.NewStorageAuthority(fc)}
+	ra.SA = msa
+	ra.CA = &mockCADualIssuance{key: caKey, clk: fc}
+
+	cert, altCert, err := ra.issueCertificateInner(ctx, csr, altCSR, accountID(1), orderID(1))
+	test.AssertNotError(t, err, "issueCertificateInner failed")
+	test.AssertNotNil(t, altCert, "no alternate certificate returned")
+	altSerial := core.SerialToString(altCert.SerialNumber)
+	test.AssertEquals(t, msa.finalized.CertificateSerial, core.SerialToString(cert.SerialNumber))
+	test.AssertEquals(t, msa.finalized.AlternateCertificateSerial, altSerial)
+
+	// Both precertificates are recorded together, so that issuance can be
+	// resumed with both.
+	test.AssertEquals(t, len(msa.states), 3)
+	precertState := msa.states[1]
+	test.AssertEquals(t, precertState.State, string(core.IssuancePrecertificateIssued))
+	test.AssertEquals(t, precertState.Serial, core.SerialToString(cert.SerialNumber))
+	test.AssertEquals(t, precertState.AlternateSerial, altSerial)
+	test.Assert(t, len(precertState.AlternatePrecertificate) != 0, "alternate precertificate wasn't recorded")
+	test.AssertEquals(t, msa.states[2].State, string(core.IssuanceComplete))
+
+	// If the alternate can't be issued, the order fails before anything is
+	// issued for the primary CSR.
+	msa = &mockSADualIssuance{StorageAuthority: *mocks.NewStorageAuthority(fc)}
+	ra.SA = msa
+	mca := &mockCADualIssuance{key: caKey, clk: fc, failAlternate: true}
+	ra.CA = mca
+
+	_, _, err = ra.issueCertificateInner(ctx, csr, altCSR, accountID(1), orderID(1))
+	test.AssertError(t, err, "issueCertificateInner should have failed")
+	test.Assert(t, !mca.primaryIssued, "primary precertificate was issued")
+	test.Assert(t, msa.finalized == nil, "order was finalized")
+}
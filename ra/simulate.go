@@ -0,0 +1,281 @@
+package ra
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/letsencrypt/boulder/core"
+	csrlib "github.com/letsencrypt/boulder/csr"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/ratelimit"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// IssuanceSimulation is the result of asking whether the RA would issue a
+// certificate for a set of names to an account, without creating an order.
+type IssuanceSimulation struct {
+	// WouldIssue is true if nothing found by the simulation would prevent
+	// issuance, other than the account completing validation for each name.
+	WouldIssue bool `json:"wouldIssue"`
+	// Problems are the reasons the RA would refuse the order as a whole, such
+	// as an invalid CSR or an exceeded rate limit.
+	Problems []string `json:"problems,omitempty"`
+	// ExistingOrder is the ID of a pending or ready order for the same names
+	// which NewOrder would return instead of creating a new one, bypassing
+	// the rate limits.
+	ExistingOrder int64              `json:"existingOrder,omitempty"`
+	Identifiers   []IdentifierResult `json:"identifiers"`
+	RateLimits    []RateLimitUsage   `json:"rateLimits,omitempty"`
+}
+
+// IdentifierResult is the simulated outcome for a single identifier.
+type IdentifierResult struct {
+	Identifier string `json:"identifier"`
+	// Problem is the reason policy forbids issuance for the identifier, if it
+	// does.
+	Problem string `json:"problem,omitempty"`
+	// ReviewRules are the rules under which an order including the
+	// identifier would be held for manual review.
+	ReviewRules []string `json:"reviewRules,omitempty"`
+	// ChallengeTypes are the challenge types which would be offered for a new
+	// authorization for the identifier.
+	ChallengeTypes []core.AcmeChallenge `json:"challengeTypes,omitempty"`
+}
+
+// RateLimitUsage is the current usage of a rate limit which applies to the
+// simulated order.
+type RateLimitUsage struct {
+	Name string `json:"name"`
+	// Key is what usage is counted against, e.g. an account ID or a name.
+	Key       string `json:"key"`
+	Count     int64  `json:"count"`
+	Threshold int64  `json:"threshold"`
+	Window    string `json:"window"`
+	// Exempt is true if issuance would be exempt from the limit, e.g.
+	// because it's a renewal.
+	Exempt bool `json:"exempt,omitempty"`
+}
+
+// SimulateIssuance reports whether the RA would issue a certificate for names
+// to the account regID right now, and if not why, without creating an order
+// or authorizations. If csr is non-nil it's checked as it would be at
+// finalization, and if names is empty the names are taken from it. The
+// simulation reads current rate limit usage from the SA, so its answer can be
+// invalidated by any later issuance.
+func (ra *RegistrationAuthorityImpl) SimulateIssuance(ctx context.Context, regID int64, names []string, csr *x509.CertificateRequest) (*IssuanceSimulation, error) {
+	if regID == 0 || (len(names) == 0 && csr == nil) {
+		return nil, errIncompleteGRPCRequest
+	}
+	sim := &IssuanceSimulation{}
+	problem := func(err error) {
+		sim.Problems = append(sim.Problems, err.Error())
+	}
+
+	reg, err := ra.SA.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		if !errors.Is(err, berrors.NotFound) {
+			return nil, fmt.Errorf("getting account: %w", err)
+		}
+		problem(fmt.Errorf("account %d does not exist", regID))
+	} else if core.AcmeStatus(reg.Status) != core.StatusValid {
+		problem(fmt.Errorf("account %d has status %q", regID, reg.Status))
+	}
+
+	if csr != nil {
+		csrNames := csrlib.NamesFromCSR(csr).SANs
+		if len(names) == 0 {
+			names = csrNames
+		}
+		err = csrlib.VerifyCSR(ctx, csr, ra.maxNames, &ra.keyPolicy, ra.PA)
+		if err != nil {
+			problem(fmt.Errorf("CSR: %w", err))
+		}
+		if strings.Join(csrNames, ",") != strings.Join(core.UniqueLowerNames(names), ",") {
+			problem(errors.New("CSR names don't match the order names"))
+		}
+	}
+	names = core.UniqueLowerNames(names)
+	if len(names) > ra.maxNames {
+		problem(fmt.Errorf("order cannot contain more than %d DNS names", ra.maxNames))
+	}
+
+	policyOK := true
+	for _, name := range names {
+		result := IdentifierResult{Identifier: name}
+		ident := identifier.DNSIdentifier(name)
+		err = ra.PA.WillingToIssueWildcards([]identifier.ACMEIdentifier{ident})
+		if err != nil {
+			result.Problem = err.Error()
+			policyOK = false
+			sim.Identifiers = append(sim.Identifiers, result)
+			continue
+		}
+		challs, err := ra.PA.ChallengesFor(ident, regID)
+		if err != nil {
+			result.Problem = err.Error()
+			policyOK = false
+		}
+		for _, chall := range challs {
+			result.ChallengeTypes = append(result.ChallengeTypes, chall.Type)
+		}
+		sort.Slice(result.ChallengeTypes, func(i, j int) bool {
+			return result.ChallengeTypes[i] < result.ChallengeTypes[j]
+		})
+		sim.Identifiers = append(sim.Identifiers, result)
+	}
+	if !policyOK {
+		sim.Problems = append(sim.Problems, "policy forbids issuance for one or more identifiers")
+	} else {
+		// The confusable and review rules only apply to names which policy
+		// otherwise allows, as in NewOrder.
+		reviewReasons, err := ra.checkOrderNames(names)
+		if err != nil {
+			problem(err)
+		}
+		for _, reason := range reviewReasons {
+			for i := range sim.Identifiers {
+				if sim.Identifiers[i].Identifier == reason.Name {
+					sim.Identifiers[i].ReviewRules = append(sim.Identifiers[i].ReviewRules, reason.Rule)
+				}
+			}
+		}
+	}
+
+	existing, err := ra.SA.GetOrderForNames(ctx, &sapb.GetOrderForNamesRequest{AcctID: regID, Names: names})
+	if err != nil && !errors.Is(err, berrors.NotFound) {
+		return nil, fmt.Errorf("getting existing order: %w", err)
+	}
+	if existing != nil {
+		sim.ExistingOrder = existing.Id
+	}
+
+	sim.RateLimits, err = ra.rateLimitUsage(ctx, regID, names)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		err = ra.checkLimits(ctx, names, regID)
+		if err != nil {
+			problem(err)
+		}
+		err = ra.checkPendingAuthorizationLimit(ctx, regID)
+		if err != nil {
+			problem(err)
+		}
+	}
+
+	sim.WouldIssue = len(sim.Problems) == 0
+	return sim, nil
+}
+
+// rateLimitUsage reads from the SA the current usage of each enabled rate
+// limit which NewOrder would check for names and regID. Whether a limit is
+// exceeded is left to the checks NewOrder itself uses.
+func (ra *RegistrationAuthorityImpl) rateLimitUsage(ctx context.Context, regID int64, names []string) ([]RateLimitUsage, error) {
+	var usage []RateLimitUsage
+	now := ra.clk.Now()
+	acct := strconv.FormatInt(regID, 10)
+	add := func(name string, limit ratelimit.RateLimitPolicy, key string, thresholdKey string, count int64, exempt bool) {
+		usage = append(usage, RateLimitUsage{
+			Name:      name,
+			Key:       key,
+			Count:     count,
+			Threshold: limit.GetThreshold(thresholdKey, regID),
+			Window:    limit.Window.Duration.String(),
+			Exempt:    exempt,
+		})
+	}
+
+	limit := ra.rlPolicies.NewOrdersPerAccount()
+	if limit.Enabled() {
+		count, err := ra.SA.CountOrders(ctx, &sapb.CountOrdersRequest{
+			AccountID: regID,
+			Range: &sapb.Range{
+				Earliest: limit.WindowBegin(now).UnixNano(),
+				Latest:   now.UnixNano(),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("counting orders: %w", err)
+		}
+		add("NewOrdersPerAccount", limit, acct, "", count.Count, false)
+	}
+
+	limit = ra.rlPolicies.PendingAuthorizationsPerAccount()
+	if limit.Enabled() {
+		count, err := ra.SA.CountPendingAuthorizations2(ctx, &sapb.RegistrationID{Id: regID})
+		if err != nil {
+			return nil, fmt.Errorf("counting pending authorizations: %w", err)
+		}
+		add("PendingAuthorizationsPerAccount", limit, acct, "", count.Count, false)
+	}
+
+	limit = ra.rlPolicies.CertificatesPerName()
+	if limit.Enabled() {
+		exists, err := ra.SA.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{Domains: names})
+		if err != nil {
+			return nil, fmt.Errorf("checking renewal exemption: %w", err)
+		}
+		baseDomains := domainsForRateLimiting(names)
+		counts, err := ra.SA.CountCertificatesByNames(ctx, &sapb.CountCertificatesByNamesRequest{
+			Names: baseDomains,
+			Range: &sapb.Range{
+				Earliest: limit.WindowBegin(now).UnixNano(),
+				Latest:   now.UnixNano(),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("counting certificates by name: %w", err)
+		}
+		for _, name := range baseDomains {
+			add("CertificatesPerName", limit, name, name, counts.Counts[name], exists.Exists)
+		}
+	}
+
+	fqdnSet := strings.Join(names, ",")
+	for _, l := range []struct {
+		name  string
+		limit ratelimit.RateLimitPolicy
+	}{
+		{"CertificatesPerFQDNSetFast", ra.rlPolicies.CertificatesPerFQDNSetFast()},
+		{"CertificatesPerFQDNSet", ra.rlPolicies.CertificatesPerFQDNSet()},
+	} {
+		if !l.limit.Enabled() {
+			continue
+		}
+		timestamps, err := ra.SA.FQDNSetTimestampsForWindow(ctx, &sapb.CountFQDNSetsRequest{
+			Domains: names,
+			Window:  l.limit.Window.Duration.Nanoseconds(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("counting certificates for FQDN set: %w", err)
+		}
+		add(l.name, l.limit, fqdnSet, fqdnSet, int64(len(timestamps.Timestamps)), false)
+	}
+
+	limit = ra.rlPolicies.InvalidAuthorizationsPerAccount()
+	if limit.Enabled() {
+		latest := now.Add(ra.pendingAuthorizationLifetime)
+		for _, name := range names {
+			count, err := ra.SA.CountInvalidAuthorizations2(ctx, &sapb.CountInvalidAuthorizationsRequest{
+				RegistrationID: regID,
+				Hostname:       name,
+				Range: &sapb.Range{
+					Earliest: latest.Add(-limit.Window.Duration).UnixNano(),
+					Latest:   latest.UnixNano(),
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("counting invalid authorizations: %w", err)
+			}
+			add("InvalidAuthorizationsPerAccount", limit, name, "", count.Count, false)
+		}
+	}
+	return usage, nil
+}
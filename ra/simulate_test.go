@@ -0,0 +1,148 @@
+package ra
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/ratelimit"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSimulationSA is a StorageAuthority with fixed rate limit usage.
+type mockSimulationSA struct {
+	mocks.StorageAuthority
+	orders int64
+}
+
+func (sa *mockSimulationSA) CountOrders(_ context.Context, _ *sapb.CountOrdersRequest, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{Count: sa.orders}, nil
+}
+
+func (sa *mockSimulationSA) CountCertificatesByNames(_ context.Context, req *sapb.CountCertificatesByNamesRequest, _ ...grpc.CallOption) (*sapb.CountByNames, error) {
+	counts := make(map[string]int64)
+	for _, name := range req.Names {
+		counts[name] = 3
+	}
+	return &sapb.CountByNames{Counts: counts}, nil
+}
+
+func TestSimulateIssuance(t *testing.T) {
+	pa, err := policy.New(map[core.AcmeChallenge]bool{
+		core.ChallengeTypeHTTP01: true,
+		core.ChallengeTypeDNS01:  true,
+	}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+
+	fc := clock.NewFake()
+	msa := &mockSimulationSA{StorageAuthority: *mocks.NewStorageAuthority(fc)}
+	ra := NewRegistrationAuthorityImpl(
+		fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 2,
+		300*24*time.Hour, 7*24*time.Hour,
+		nil, noopCAA{},
+		0, 5*time.Minute,
+		nil, nil, nil)
+	ra.PA = pa
+	ra.SA = msa
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerNamePolicy: ratelimit.RateLimitPolicy{
+			Threshold: 10,
+			Window:    config.Duration{Duration: 7 * 24 * time.Hour},
+		},
+		NewOrdersPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 5,
+			Window:    config.Duration{Duration: 3 * time.Hour},
+		},
+	}
+
+	_, err = ra.SimulateIssuance(ctx, 1, nil, nil)
+	test.AssertError(t, err, "simulated issuance without any names")
+
+	sim, err := ra.SimulateIssuance(ctx, 1, []string{"WWW.example.com", "*.example.com"}, nil)
+	test.AssertNotError(t, err, "simulating issuance")
+	test.Assert(t, sim.WouldIssue, "expected issuance to be allowed")
+	test.AssertEquals(t, len(sim.Problems), 0)
+	test.AssertDeepEquals(t, sim.Identifiers, []IdentifierResult{
+		{Identifier: "*.example.com", ChallengeTypes: []core.AcmeChallenge{core.ChallengeTypeDNS01}},
+		{Identifier: "www.example.com", ChallengeTypes: []core.AcmeChallenge{core.ChallengeTypeDNS01, core.ChallengeTypeHTTP01}},
+	})
+	test.AssertDeepEquals(t, sim.RateLimits, []RateLimitUsage{
+		{Name: "NewOrdersPerAccount", Key: "1", Count: 0, Threshold: 5, Window: "3h0m0s"},
+		{Name: "CertificatesPerName", Key: "example.com", Count: 3, Threshold: 10, Window: "168h0m0s"},
+	})
+
+	// Policy problems are reported per identifier, and other problems for the
+	// order as a whole.
+	msa.orders = 5
+	sim, err = ra.SimulateIssuance(ctx, 102, []string{"bad_name.example.com", "example.com", "example.net"}, nil)
+	test.AssertNotError(t, err, "simulating issuance")
+	test.Assert(t, !sim.WouldIssue, "expected issuance to be refused")
+	test.AssertEquals(t, len(sim.Identifiers), 3)
+	test.AssertContains(t, sim.Identifiers[0].Problem, "Domain name contains an invalid character")
+	test.AssertEquals(t, sim.Identifiers[1].Problem, "")
+	test.AssertDeepEquals(t, sim.Problems, []string{
+		"account 102 does not exist",
+		"order cannot contain more than 2 DNS names",
+		"policy forbids issuance for one or more identifiers",
+		"too many new orders recently: see https://letsencrypt.org/docs/rate-limits/",
+	})
+}
+
+func TestSimulateIssuanceCSR(t *testing.T) {
+	pa, err := policy.New(map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+
+	fc := clock.NewFake()
+	ra := NewRegistrationAuthorityImpl(
+		fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100,
+		300*24*time.Hour, 7*24*time.Hour,
+		nil, noopCAA{},
+		0, 5*time.Minute,
+		nil, nil, nil)
+	ra.PA = pa
+	ra.SA = &mockSimulationSA{StorageAuthority: *mocks.NewStorageAuthority(fc)}
+	ra.rlPolicies = &dummyRateLimitConfig{}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com", "www.example.com"},
+	}, key)
+	test.AssertNotError(t, err, "creating CSR")
+	csr, err := x509.ParseCertificateRequest(der)
+	test.AssertNotError(t, err, "parsing CSR")
+
+	// Without names, the CSR's are used.
+	sim, err := ra.SimulateIssuance(ctx, 1, nil, csr)
+	test.AssertNotError(t, err, "simulating issuance")
+	test.Assert(t, sim.WouldIssue, "expected issuance to be allowed")
+	test.AssertEquals(t, len(sim.Identifiers), 2)
+	test.AssertEquals(t, len(sim.RateLimits), 0)
+
+	sim, err = ra.SimulateIssuance(ctx, 1, []string{"example.com"}, csr)
+	test.AssertNotError(t, err, "simulating issuance")
+	test.Assert(t, !sim.WouldIssue, "expected issuance to be refused")
+	test.AssertDeepEquals(t, sim.Problems, []string{"CSR names don't match the order names"})
+}
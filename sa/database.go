@@ -280,6 +280,12 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(precertificateModel{}, "precertificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(keyHashModel{}, "keyHashToSerial").SetKeys(true, "ID")
 	dbMap.AddTableWithName(incidentModel{}, "incidents").SetKeys(true, "ID")
+	dbMap.AddTableWithName(issuanceStateModel{}, "issuanceStates").SetKeys(true, "ID")
+	dbMap.AddTableWithName(orderAlternateCertificateModel{}, "orderAlternateCertificates").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(validationPerspectiveModel{}, "validationPerspectives").SetKeys(true, "ID")
+	dbMap.AddTableWithName(blockedNameModel{}, "blockedNames").SetKeys(true, "ID")
+	dbMap.AddTableWithName(orderReviewModel{}, "orderReviews").SetKeys(true, "ID")
+	dbMap.AddTableWithName(termsAgreementModel{}, "termsAgreements").SetKeys(true, "ID")
 	dbMap.AddTable(incidentSerialModel{})
 
 	// Read-only maps used for selecting subsets of columns.
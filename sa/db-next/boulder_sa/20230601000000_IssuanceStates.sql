@@ -0,0 +1,21 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `issuanceStates` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `orderID` bigint(20) UNSIGNED NOT NULL,
+  `registrationID` bigint(20) NOT NULL,
+  `state` tinyint(4) NOT NULL,
+  `serial` varchar(255) NOT NULL DEFAULT '',
+  `precertificate` mediumblob DEFAULT NULL,
+  `created` datetime NOT NULL,
+  `updated` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `orderID_idx` (`orderID`),
+  KEY `state_updated_idx` (`state`,`updated`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `issuanceStates`;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `validationPerspectives` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `authzID` bigint(20) UNSIGNED NOT NULL,
+  `perspective` varchar(255) NOT NULL,
+  `rir` varchar(32) NOT NULL DEFAULT '',
+  `success` tinyint(1) NOT NULL,
+  `problem` mediumblob DEFAULT NULL,
+  `created` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `authzID_idx` (`authzID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `validationPerspectives`;
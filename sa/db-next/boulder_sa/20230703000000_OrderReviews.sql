@@ -0,0 +1,22 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `orderReviews` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `orderID` bigint(20) UNSIGNED NOT NULL,
+  `registrationID` bigint(20) UNSIGNED NOT NULL,
+  `reasons` mediumblob NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `created` datetime NOT NULL,
+  `reviewer` varchar(255) NOT NULL DEFAULT '',
+  `decisionReason` varchar(255) NOT NULL DEFAULT '',
+  `decided` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `orderID_idx` (`orderID`),
+  KEY `status_created_idx` (`status`, `created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `orderReviews`;
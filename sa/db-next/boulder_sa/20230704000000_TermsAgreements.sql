@@ -0,0 +1,16 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `termsAgreements` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `registrationID` bigint(20) NOT NULL,
+  `version` varchar(255) NOT NULL,
+  `agreed` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `registrationID_agreed_idx` (`registrationID`,`agreed`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `termsAgreements`;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+ALTER TABLE `issuanceStates` ADD COLUMN `alternatePrecertificate` mediumblob DEFAULT NULL;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `issuanceStates` DROP COLUMN `alternatePrecertificate`;
@@ -32,6 +32,12 @@ GRANT SELECT,INSERT ON keyHashToSerial TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON blockedKeys TO 'sa'@'localhost';
 GRANT SELECT,INSERT,UPDATE ON newOrdersRL TO 'sa'@'localhost';
 GRANT SELECT ON incidents TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON issuanceStates TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON orderAlternateCertificates TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON validationPerspectives TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON blockedNames TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON orderReviews TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON termsAgreements TO 'sa'@'localhost';
 
 GRANT SELECT ON certificates TO 'sa_ro'@'localhost';
 GRANT SELECT ON certificateStatus TO 'sa_ro'@'localhost';
@@ -50,6 +56,12 @@ GRANT SELECT ON keyHashToSerial TO 'sa_ro'@'localhost';
 GRANT SELECT ON blockedKeys TO 'sa_ro'@'localhost';
 GRANT SELECT ON newOrdersRL TO 'sa_ro'@'localhost';
 GRANT SELECT ON incidents TO 'sa_ro'@'localhost';
+GRANT SELECT ON issuanceStates TO 'sa_ro'@'localhost';
+GRANT SELECT ON orderAlternateCertificates TO 'sa_ro'@'localhost';
+GRANT SELECT ON validationPerspectives TO 'sa_ro'@'localhost';
+GRANT SELECT ON blockedNames TO 'sa_ro'@'localhost';
+GRANT SELECT ON orderReviews TO 'sa_ro'@'localhost';
+GRANT SELECT ON termsAgreements TO 'sa_ro'@'localhost';
 
 -- OCSP Responder
 GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
@@ -23,6 +23,7 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
@@ -390,6 +391,14 @@ type orderToAuthzModel struct {
 	AuthzID int64
 }
 
+// orderAlternateCertificateModel represents one row in the
+// orderAlternateCertificates table, which records the serial of the second
+// certificate issued for an order finalized with two CSRs.
+type orderAlternateCertificateModel struct {
+	OrderID           int64
+	CertificateSerial string
+}
+
 func orderToModel(order *corepb.Order) (*orderModel, error) {
 	om := &orderModel{
 		ID:                order.Id,
@@ -1063,6 +1072,18 @@ func statusForOrder(s db.Selector, order *corepb.Order, now time.Time) (string,
 	if otherAuthzs > 0 || expiredAuthzs > 0 {
 		return string(core.StatusInvalid), nil
 	}
+
+	// An order which requires manual review makes no further progress until an
+	// operator approves it. If they deny it, the order's error is set instead.
+	if features.Enabled(features.OrderReviews) {
+		awaiting, err := orderAwaitingReview(s, order.Id)
+		if err != nil {
+			return "", err
+		}
+		if awaiting {
+			return string(core.StatusPendingReview), nil
+		}
+	}
 	// An order is pending if **any** of its authzs are pending
 	if pendingAuthzs > 0 {
 		return string(core.StatusPending), nil
@@ -1168,3 +1189,261 @@ func namesForOrder(s db.Selector, orderID int64) ([]string, error) {
 	}
 	return reversedNames, nil
 }
+
+// alternateCertificateForOrder returns the serial of the alternate certificate
+// issued for an order, or the empty string if there is none. It assumes that
+// the provided database selector already has a context associated with it.
+func alternateCertificateForOrder(s db.OneSelector, orderID int64) (string, error) {
+	var serial string
+	err := s.SelectOne(
+		&serial,
+		"SELECT certificateSerial FROM orderAlternateCertificates WHERE orderID = ?",
+		orderID,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return serial, nil
+}
+
+// issuanceStateModel represents one row in the issuanceStates table, which
+// records how far the RA has progressed in issuing the certificate for an
+// order.
+type issuanceStateModel struct {
+	ID              int64     `db:"id"`
+	OrderID         int64     `db:"orderID"`
+	RegistrationID  int64     `db:"registrationID"`
+	State           uint8     `db:"state"`
+	Serial          string    `db:"serial"`
+	Precertificate  []byte    `db:"precertificate"`
+	Created         time.Time `db:"created"`
+	Updated         time.Time `db:"updated"`
+	AlternateSerial string    `db:"alternateSerial"`
+	// AlternatePrecertificate is only set for dual certificate orders.
+	AlternatePrecertificate []byte `db:"alternatePrecertificate"`
+}
+
+const issuanceStateFields = "id, orderID, registrationID, state, serial, precertificate, created, updated, alternateSerial, alternatePrecertificate"
+
+var issuanceStateToUint = map[core.IssuanceState]uint8{
+	core.IssuanceStarted:              0,
+	core.IssuancePrecertificateIssued: 1,
+	core.IssuanceComplete:             2,
+	core.IssuanceAbandoned:            3,
+}
+
+var uintToIssuanceState = map[uint8]core.IssuanceState{
+	0: core.IssuanceStarted,
+	1: core.IssuancePrecertificateIssued,
+	2: core.IssuanceComplete,
+	3: core.IssuanceAbandoned,
+}
+
+// issuanceStateTransitions lists, for each state, the states from which an
+// issuance is allowed to move into it. Issuance only ever moves forward, so
+// that a slow or retried write can never resurrect an issuance which has
+// already been completed or abandoned.
+var issuanceStateTransitions = map[core.IssuanceState][]core.IssuanceState{
+	core.IssuancePrecertificateIssued: {core.IssuanceStarted},
+	core.IssuanceComplete:             {core.IssuanceStarted, core.IssuancePrecertificateIssued},
+	core.IssuanceAbandoned:            {core.IssuanceStarted, core.IssuancePrecertificateIssued},
+}
+
+func issuanceStateModelToPB(m issuanceStateModel) (*sapb.IssuanceState, error) {
+	state, ok := uintToIssuanceState[m.State]
+	if !ok {
+		return nil, fmt.Errorf("unrecognized issuance state %d for order %d", m.State, m.OrderID)
+	}
+	return &sapb.IssuanceState{
+		OrderID:                 m.OrderID,
+		RegistrationID:          m.RegistrationID,
+		State:                   string(state),
+		Serial:                  m.Serial,
+		Precertificate:          m.Precertificate,
+		Updated:                 timestamppb.New(m.Updated),
+		AlternateSerial:         m.AlternateSerial,
+		AlternatePrecertificate: m.AlternatePrecertificate,
+	}, nil
+}
+
+// validationPerspectiveModel represents one row in the validationPerspectives
+// table, which records the result of an authorization's validation from a
+// single network perspective.
+type validationPerspectiveModel struct {
+	ID          int64     `db:"id"`
+	AuthzID     int64     `db:"authzID"`
+	Perspective string    `db:"perspective"`
+	RIR         string    `db:"rir"`
+	Success     bool      `db:"success"`
+	Problem     []byte    `db:"problem"`
+	Created     time.Time `db:"created"`
+}
+
+const validationPerspectiveFields = "id, authzID, perspective, rir, success, problem, created"
+
+func perspectiveResultToModel(authzID int64, pr *corepb.PerspectiveResult, created time.Time) (*validationPerspectiveModel, error) {
+	m := &validationPerspectiveModel{
+		AuthzID:     authzID,
+		Perspective: pr.Perspective,
+		RIR:         pr.Rir,
+		Success:     pr.Success,
+		Created:     created,
+	}
+	if pr.Problem != nil {
+		prob, err := grpc.PBToProblemDetails(pr.Problem)
+		if err != nil {
+			return nil, err
+		}
+		m.Problem, err = json.Marshal(prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func validationPerspectiveModelToPB(m validationPerspectiveModel) (*corepb.PerspectiveResult, error) {
+	pr := &corepb.PerspectiveResult{
+		Perspective: m.Perspective,
+		Rir:         m.RIR,
+		Success:     m.Success,
+	}
+	if len(m.Problem) > 0 {
+		var prob probs.ProblemDetails
+		err := json.Unmarshal(m.Problem, &prob)
+		if err != nil {
+			return nil, err
+		}
+		pr.Problem, err = grpc.ProblemDetailsToPB(&prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pr, nil
+}
+
+// blockedNameModel represents one row in the blockedNames table, an entry in
+// the hostname blocklist which is layered on top of the hostname policy file.
+type blockedNameModel struct {
+	ID        int64      `db:"id"`
+	Name      string     `db:"name"`
+	BlockType string     `db:"blockType"`
+	Reason    string     `db:"reason"`
+	Creator   string     `db:"creator"`
+	Created   time.Time  `db:"created"`
+	Expires   *time.Time `db:"expires"`
+	// RemovedAt and RemovedBy are set when the entry is removed. Entries are
+	// never deleted, so that the blocklist's history is kept.
+	RemovedAt *time.Time `db:"removedAt"`
+	RemovedBy *string    `db:"removedBy"`
+}
+
+const blockedNameFields = "id, name, blockType, reason, creator, created, expires, removedAt, removedBy"
+
+// blockedNameTypes are the valid values of blockedNameModel.BlockType. They
+// correspond to the lists in the hostname policy file.
+var blockedNameTypes = map[string]bool{
+	"exact":    true,
+	"highRisk": true,
+	"admin":    true,
+}
+
+func blockedNameModelToPB(m blockedNameModel) *sapb.BlockedName {
+	pb := &sapb.BlockedName{
+		Id:        m.ID,
+		Name:      m.Name,
+		BlockType: m.BlockType,
+		Reason:    m.Reason,
+		Creator:   m.Creator,
+		Created:   timestamppb.New(m.Created),
+	}
+	if m.Expires != nil {
+		pb.Expires = timestamppb.New(*m.Expires)
+	}
+	if m.RemovedAt != nil {
+		pb.Removed = timestamppb.New(*m.RemovedAt)
+	}
+	if m.RemovedBy != nil {
+		pb.RemovedBy = *m.RemovedBy
+	}
+	return pb
+}
+
+// The statuses of an orderReviewModel.
+const (
+	orderReviewPending  = "pending"
+	orderReviewApproved = "approved"
+	orderReviewDenied   = "denied"
+)
+
+// orderReviewModel represents one row in the orderReviews table, which records
+// why an order requires manual review and the decision an operator made.
+type orderReviewModel struct {
+	ID             int64      `db:"id"`
+	OrderID        int64      `db:"orderID"`
+	RegistrationID int64      `db:"registrationID"`
+	Reasons        []byte     `db:"reasons"`
+	Status         string     `db:"status"`
+	Created        time.Time  `db:"created"`
+	Reviewer       string     `db:"reviewer"`
+	DecisionReason string     `db:"decisionReason"`
+	Decided        *time.Time `db:"decided"`
+}
+
+const orderReviewFields = "id, orderID, registrationID, reasons, status, created, reviewer, decisionReason, decided"
+
+// orderReviewReason is the JSON form of a sapb.OrderReviewReason, as stored in
+// orderReviewModel.Reasons.
+type orderReviewReason struct {
+	Name string `json:"name"`
+	Rule string `json:"rule"`
+}
+
+func orderReviewModelToPB(m orderReviewModel) (*sapb.OrderReview, error) {
+	var reasons []orderReviewReason
+	err := json.Unmarshal(m.Reasons, &reasons)
+	if err != nil {
+		return nil, err
+	}
+	pb := &sapb.OrderReview{
+		Id:             m.ID,
+		OrderID:        m.OrderID,
+		RegistrationID: m.RegistrationID,
+		Status:         m.Status,
+		Created:        timestamppb.New(m.Created),
+		Reviewer:       m.Reviewer,
+		DecisionReason: m.DecisionReason,
+	}
+	for _, r := range reasons {
+		pb.Reasons = append(pb.Reasons, &sapb.OrderReviewReason{Name: r.Name, Rule: r.Rule})
+	}
+	if m.Decided != nil {
+		pb.Decided = timestamppb.New(*m.Decided)
+	}
+	return pb, nil
+}
+
+// orderAwaitingReview returns true if the order has a manual review which no
+// operator has decided yet.
+func orderAwaitingReview(s db.Selector, orderID int64) (bool, error) {
+	var statuses []string
+	_, err := s.Select(&statuses, "SELECT status FROM orderReviews WHERE orderID = ?", orderID)
+	if err != nil {
+		return false, err
+	}
+	return len(statuses) > 0 && statuses[0] == orderReviewPending, nil
+}
+
+// termsAgreementModel represents one row in the termsAgreements table, a
+// record of an account agreeing to a version of the terms of service.
+type termsAgreementModel struct {
+	ID             int64     `db:"id"`
+	RegistrationID int64     `db:"registrationID"`
+	Version        string    `db:"version"`
+	Agreed         time.Time `db:"agreed"`
+}
+
+const termsAgreementFields = "id, registrationID, version, agreed"
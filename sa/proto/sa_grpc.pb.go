@@ -36,8 +36,11 @@ type StorageAuthorityReadOnlyClient interface {
 	FQDNSetTimestampsForWindow(ctx context.Context, in *CountFQDNSetsRequest, opts ...grpc.CallOption) (*Timestamps, error)
 	GetAuthorization2(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*proto.Authorization, error)
 	GetAuthorizations2(ctx context.Context, in *GetAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
+	GetBlockedNames(ctx context.Context, in *GetBlockedNamesRequest, opts ...grpc.CallOption) (*BlockedNames, error)
+	GetOrderReviews(ctx context.Context, in *GetOrderReviewsRequest, opts ...grpc.CallOption) (*OrderReviews, error)
 	GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
+	GetIssuanceState(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*IssuanceState, error)
 	GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
@@ -46,9 +49,12 @@ type StorageAuthorityReadOnlyClient interface {
 	GetRegistrationByKey(ctx context.Context, in *JSONWebKey, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRevocationStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*RevocationStatus, error)
 	GetRevokedCerts(ctx context.Context, in *GetRevokedCertsRequest, opts ...grpc.CallOption) (StorageAuthorityReadOnly_GetRevokedCertsClient, error)
+	GetStalledIssuances(ctx context.Context, in *GetStalledIssuancesRequest, opts ...grpc.CallOption) (*IssuanceStates, error)
 	GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error)
+	GetTermsAgreement(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*TermsAgreement, error)
 	GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetValidOrderAuthorizations2(ctx context.Context, in *GetValidOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
+	GetValidationPerspectives(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*ValidationPerspectives, error)
 	IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error)
 	KeyBlocked(ctx context.Context, in *KeyBlockedRequest, opts ...grpc.CallOption) (*Exists, error)
 	PreviousCertificateExists(ctx context.Context, in *PreviousCertificateExistsRequest, opts ...grpc.CallOption) (*Exists, error)
@@ -162,6 +168,24 @@ func (c *storageAuthorityReadOnlyClient) GetAuthorizations2(ctx context.Context,
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetBlockedNames(ctx context.Context, in *GetBlockedNamesRequest, opts ...grpc.CallOption) (*BlockedNames, error) {
+	out := new(BlockedNames)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetBlockedNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityReadOnlyClient) GetOrderReviews(ctx context.Context, in *GetOrderReviewsRequest, opts ...grpc.CallOption) (*OrderReviews, error) {
+	out := new(OrderReviews)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetOrderReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error) {
 	out := new(proto.Certificate)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetCertificate", in, out, opts...)
@@ -180,6 +204,15 @@ func (c *storageAuthorityReadOnlyClient) GetCertificateStatus(ctx context.Contex
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetIssuanceState(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*IssuanceState, error) {
+	out := new(IssuanceState)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetIssuanceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	out := new(timestamppb.Timestamp)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetMaxExpiration", in, out, opts...)
@@ -275,6 +308,15 @@ func (x *storageAuthorityReadOnlyGetRevokedCertsClient) Recv() (*proto.CRLEntry,
 	return m, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetStalledIssuances(ctx context.Context, in *GetStalledIssuancesRequest, opts ...grpc.CallOption) (*IssuanceStates, error) {
+	out := new(IssuanceStates)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetStalledIssuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error) {
 	out := new(SerialMetadata)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetSerialMetadata", in, out, opts...)
@@ -284,6 +326,15 @@ func (c *storageAuthorityReadOnlyClient) GetSerialMetadata(ctx context.Context,
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetTermsAgreement(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*TermsAgreement, error) {
+	out := new(TermsAgreement)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetTermsAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error) {
 	out := new(Authorizations)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetValidAuthorizations2", in, out, opts...)
@@ -302,6 +353,15 @@ func (c *storageAuthorityReadOnlyClient) GetValidOrderAuthorizations2(ctx contex
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetValidationPerspectives(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*ValidationPerspectives, error) {
+	out := new(ValidationPerspectives)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/GetValidationPerspectives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error) {
 	out := new(Incidents)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthorityReadOnly/IncidentsForSerial", in, out, opts...)
@@ -376,8 +436,11 @@ type StorageAuthorityReadOnlyServer interface {
 	FQDNSetTimestampsForWindow(context.Context, *CountFQDNSetsRequest) (*Timestamps, error)
 	GetAuthorization2(context.Context, *AuthorizationID2) (*proto.Authorization, error)
 	GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error)
+	GetBlockedNames(context.Context, *GetBlockedNamesRequest) (*BlockedNames, error)
+	GetOrderReviews(context.Context, *GetOrderReviewsRequest) (*OrderReviews, error)
 	GetCertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
+	GetIssuanceState(context.Context, *OrderRequest) (*IssuanceState, error)
 	GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
@@ -386,9 +449,12 @@ type StorageAuthorityReadOnlyServer interface {
 	GetRegistrationByKey(context.Context, *JSONWebKey) (*proto.Registration, error)
 	GetRevocationStatus(context.Context, *Serial) (*RevocationStatus, error)
 	GetRevokedCerts(*GetRevokedCertsRequest, StorageAuthorityReadOnly_GetRevokedCertsServer) error
+	GetStalledIssuances(context.Context, *GetStalledIssuancesRequest) (*IssuanceStates, error)
 	GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error)
+	GetTermsAgreement(context.Context, *RegistrationID) (*TermsAgreement, error)
 	GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error)
 	GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error)
+	GetValidationPerspectives(context.Context, *AuthorizationID2) (*ValidationPerspectives, error)
 	IncidentsForSerial(context.Context, *Serial) (*Incidents, error)
 	KeyBlocked(context.Context, *KeyBlockedRequest) (*Exists, error)
 	PreviousCertificateExists(context.Context, *PreviousCertificateExistsRequest) (*Exists, error)
@@ -433,12 +499,21 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetAuthorization2(context.Con
 func (UnimplementedStorageAuthorityReadOnlyServer) GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizations2 not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetBlockedNames(context.Context, *GetBlockedNamesRequest) (*BlockedNames, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedNames not implemented")
+}
+func (UnimplementedStorageAuthorityReadOnlyServer) GetOrderReviews(context.Context, *GetOrderReviewsRequest) (*OrderReviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderReviews not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetCertificate(context.Context, *Serial) (*proto.Certificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
 }
 func (UnimplementedStorageAuthorityReadOnlyServer) GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateStatus not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetIssuanceState(context.Context, *OrderRequest) (*IssuanceState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuanceState not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaxExpiration not implemented")
 }
@@ -463,15 +538,24 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetRevocationStatus(context.C
 func (UnimplementedStorageAuthorityReadOnlyServer) GetRevokedCerts(*GetRevokedCertsRequest, StorageAuthorityReadOnly_GetRevokedCertsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetRevokedCerts not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetStalledIssuances(context.Context, *GetStalledIssuancesRequest) (*IssuanceStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStalledIssuances not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSerialMetadata not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetTermsAgreement(context.Context, *RegistrationID) (*TermsAgreement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTermsAgreement not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
 }
 func (UnimplementedStorageAuthorityReadOnlyServer) GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidOrderAuthorizations2 not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetValidationPerspectives(context.Context, *AuthorizationID2) (*ValidationPerspectives, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationPerspectives not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) IncidentsForSerial(context.Context, *Serial) (*Incidents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncidentsForSerial not implemented")
 }
@@ -696,6 +780,42 @@ func _StorageAuthorityReadOnly_GetAuthorizations2_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetBlockedNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetBlockedNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetBlockedNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetBlockedNames(ctx, req.(*GetBlockedNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthorityReadOnly_GetOrderReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetOrderReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetOrderReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetOrderReviews(ctx, req.(*GetOrderReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -732,6 +852,24 @@ func _StorageAuthorityReadOnly_GetCertificateStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetIssuanceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetIssuanceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetIssuanceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetIssuanceState(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_GetMaxExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -879,6 +1017,24 @@ func (x *storageAuthorityReadOnlyGetRevokedCertsServer) Send(m *proto.CRLEntry)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _StorageAuthorityReadOnly_GetStalledIssuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStalledIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetStalledIssuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetStalledIssuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetStalledIssuances(ctx, req.(*GetStalledIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_GetSerialMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -897,6 +1053,24 @@ func _StorageAuthorityReadOnly_GetSerialMetadata_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetTermsAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetTermsAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetTermsAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetTermsAgreement(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidAuthorizationsRequest)
 	if err := dec(in); err != nil {
@@ -933,6 +1107,24 @@ func _StorageAuthorityReadOnly_GetValidOrderAuthorizations2_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetValidationPerspectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationID2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetValidationPerspectives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthorityReadOnly/GetValidationPerspectives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetValidationPerspectives(ctx, req.(*AuthorizationID2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_IncidentsForSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -1059,6 +1251,14 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthorizations2",
 			Handler:    _StorageAuthorityReadOnly_GetAuthorizations2_Handler,
 		},
+		{
+			MethodName: "GetBlockedNames",
+			Handler:    _StorageAuthorityReadOnly_GetBlockedNames_Handler,
+		},
+		{
+			MethodName: "GetOrderReviews",
+			Handler:    _StorageAuthorityReadOnly_GetOrderReviews_Handler,
+		},
 		{
 			MethodName: "GetCertificate",
 			Handler:    _StorageAuthorityReadOnly_GetCertificate_Handler,
@@ -1067,6 +1267,10 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCertificateStatus",
 			Handler:    _StorageAuthorityReadOnly_GetCertificateStatus_Handler,
 		},
+		{
+			MethodName: "GetIssuanceState",
+			Handler:    _StorageAuthorityReadOnly_GetIssuanceState_Handler,
+		},
 		{
 			MethodName: "GetMaxExpiration",
 			Handler:    _StorageAuthorityReadOnly_GetMaxExpiration_Handler,
@@ -1095,10 +1299,18 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRevocationStatus",
 			Handler:    _StorageAuthorityReadOnly_GetRevocationStatus_Handler,
 		},
+		{
+			MethodName: "GetStalledIssuances",
+			Handler:    _StorageAuthorityReadOnly_GetStalledIssuances_Handler,
+		},
 		{
 			MethodName: "GetSerialMetadata",
 			Handler:    _StorageAuthorityReadOnly_GetSerialMetadata_Handler,
 		},
+		{
+			MethodName: "GetTermsAgreement",
+			Handler:    _StorageAuthorityReadOnly_GetTermsAgreement_Handler,
+		},
 		{
 			MethodName: "GetValidAuthorizations2",
 			Handler:    _StorageAuthorityReadOnly_GetValidAuthorizations2_Handler,
@@ -1107,6 +1319,10 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidOrderAuthorizations2",
 			Handler:    _StorageAuthorityReadOnly_GetValidOrderAuthorizations2_Handler,
 		},
+		{
+			MethodName: "GetValidationPerspectives",
+			Handler:    _StorageAuthorityReadOnly_GetValidationPerspectives_Handler,
+		},
 		{
 			MethodName: "IncidentsForSerial",
 			Handler:    _StorageAuthorityReadOnly_IncidentsForSerial_Handler,
@@ -1151,8 +1367,11 @@ type StorageAuthorityClient interface {
 	FQDNSetTimestampsForWindow(ctx context.Context, in *CountFQDNSetsRequest, opts ...grpc.CallOption) (*Timestamps, error)
 	GetAuthorization2(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*proto.Authorization, error)
 	GetAuthorizations2(ctx context.Context, in *GetAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
+	GetBlockedNames(ctx context.Context, in *GetBlockedNamesRequest, opts ...grpc.CallOption) (*BlockedNames, error)
+	GetOrderReviews(ctx context.Context, in *GetOrderReviewsRequest, opts ...grpc.CallOption) (*OrderReviews, error)
 	GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
+	GetIssuanceState(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*IssuanceState, error)
 	GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
@@ -1161,18 +1380,25 @@ type StorageAuthorityClient interface {
 	GetRegistrationByKey(ctx context.Context, in *JSONWebKey, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRevocationStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*RevocationStatus, error)
 	GetRevokedCerts(ctx context.Context, in *GetRevokedCertsRequest, opts ...grpc.CallOption) (StorageAuthority_GetRevokedCertsClient, error)
+	GetStalledIssuances(ctx context.Context, in *GetStalledIssuancesRequest, opts ...grpc.CallOption) (*IssuanceStates, error)
 	GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error)
+	GetTermsAgreement(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*TermsAgreement, error)
 	GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetValidOrderAuthorizations2(ctx context.Context, in *GetValidOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
+	GetValidationPerspectives(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*ValidationPerspectives, error)
 	IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error)
 	KeyBlocked(ctx context.Context, in *KeyBlockedRequest, opts ...grpc.CallOption) (*Exists, error)
 	PreviousCertificateExists(ctx context.Context, in *PreviousCertificateExistsRequest, opts ...grpc.CallOption) (*Exists, error)
 	SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (StorageAuthority_SerialsForIncidentClient, error)
 	// Adders
 	AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddBlockedName(ctx context.Context, in *AddBlockedNameRequest, opts ...grpc.CallOption) (*BlockedName, error)
 	AddCertificate(ctx context.Context, in *AddCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddPrecertificate(ctx context.Context, in *AddCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddTermsAgreement(ctx context.Context, in *TermsAgreement, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetCertificateStatusReady(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetIssuanceState(ctx context.Context, in *IssuanceState, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddValidationPerspectives(ctx context.Context, in *AddValidationPerspectivesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddSerial(ctx context.Context, in *AddSerialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeactivateAuthorization2(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeactivateRegistration(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -1180,6 +1406,8 @@ type StorageAuthorityClient interface {
 	FinalizeOrder(ctx context.Context, in *FinalizeOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NewOrderAndAuthzs(ctx context.Context, in *NewOrderAndAuthzsRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	NewRegistration(ctx context.Context, in *proto.Registration, opts ...grpc.CallOption) (*proto.Registration, error)
+	RemoveBlockedName(ctx context.Context, in *RemoveBlockedNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecideOrderReview(ctx context.Context, in *DecideOrderReviewRequest, opts ...grpc.CallOption) (*OrderReview, error)
 	RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetOrderError(ctx context.Context, in *SetOrderErrorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetOrderProcessing(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -1294,6 +1522,24 @@ func (c *storageAuthorityClient) GetAuthorizations2(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetBlockedNames(ctx context.Context, in *GetBlockedNamesRequest, opts ...grpc.CallOption) (*BlockedNames, error) {
+	out := new(BlockedNames)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetBlockedNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetOrderReviews(ctx context.Context, in *GetOrderReviewsRequest, opts ...grpc.CallOption) (*OrderReviews, error) {
+	out := new(OrderReviews)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetOrderReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error) {
 	out := new(proto.Certificate)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetCertificate", in, out, opts...)
@@ -1312,6 +1558,15 @@ func (c *storageAuthorityClient) GetCertificateStatus(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetIssuanceState(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*IssuanceState, error) {
+	out := new(IssuanceState)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetIssuanceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	out := new(timestamppb.Timestamp)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetMaxExpiration", in, out, opts...)
@@ -1407,6 +1662,15 @@ func (x *storageAuthorityGetRevokedCertsClient) Recv() (*proto.CRLEntry, error)
 	return m, nil
 }
 
+func (c *storageAuthorityClient) GetStalledIssuances(ctx context.Context, in *GetStalledIssuancesRequest, opts ...grpc.CallOption) (*IssuanceStates, error) {
+	out := new(IssuanceStates)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetStalledIssuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error) {
 	out := new(SerialMetadata)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetSerialMetadata", in, out, opts...)
@@ -1416,6 +1680,15 @@ func (c *storageAuthorityClient) GetSerialMetadata(ctx context.Context, in *Seri
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetTermsAgreement(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*TermsAgreement, error) {
+	out := new(TermsAgreement)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetTermsAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error) {
 	out := new(Authorizations)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetValidAuthorizations2", in, out, opts...)
@@ -1434,6 +1707,15 @@ func (c *storageAuthorityClient) GetValidOrderAuthorizations2(ctx context.Contex
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetValidationPerspectives(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*ValidationPerspectives, error) {
+	out := new(ValidationPerspectives)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetValidationPerspectives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error) {
 	out := new(Incidents)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/IncidentsForSerial", in, out, opts...)
@@ -1502,6 +1784,15 @@ func (c *storageAuthorityClient) AddBlockedKey(ctx context.Context, in *AddBlock
 	return out, nil
 }
 
+func (c *storageAuthorityClient) AddBlockedName(ctx context.Context, in *AddBlockedNameRequest, opts ...grpc.CallOption) (*BlockedName, error) {
+	out := new(BlockedName)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddBlockedName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) AddCertificate(ctx context.Context, in *AddCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddCertificate", in, out, opts...)
@@ -1520,6 +1811,15 @@ func (c *storageAuthorityClient) AddPrecertificate(ctx context.Context, in *AddC
 	return out, nil
 }
 
+func (c *storageAuthorityClient) AddTermsAgreement(ctx context.Context, in *TermsAgreement, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddTermsAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) SetCertificateStatusReady(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/SetCertificateStatusReady", in, out, opts...)
@@ -1529,6 +1829,24 @@ func (c *storageAuthorityClient) SetCertificateStatusReady(ctx context.Context,
 	return out, nil
 }
 
+func (c *storageAuthorityClient) SetIssuanceState(ctx context.Context, in *IssuanceState, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/SetIssuanceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddValidationPerspectives(ctx context.Context, in *AddValidationPerspectivesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddValidationPerspectives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) AddSerial(ctx context.Context, in *AddSerialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddSerial", in, out, opts...)
@@ -1592,6 +1910,24 @@ func (c *storageAuthorityClient) NewRegistration(ctx context.Context, in *proto.
 	return out, nil
 }
 
+func (c *storageAuthorityClient) RemoveBlockedName(ctx context.Context, in *RemoveBlockedNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/RemoveBlockedName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) DecideOrderReview(ctx context.Context, in *DecideOrderReviewRequest, opts ...grpc.CallOption) (*OrderReview, error) {
+	out := new(OrderReview)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/DecideOrderReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/RevokeCertificate", in, out, opts...)
@@ -1653,8 +1989,11 @@ type StorageAuthorityServer interface {
 	FQDNSetTimestampsForWindow(context.Context, *CountFQDNSetsRequest) (*Timestamps, error)
 	GetAuthorization2(context.Context, *AuthorizationID2) (*proto.Authorization, error)
 	GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error)
+	GetBlockedNames(context.Context, *GetBlockedNamesRequest) (*BlockedNames, error)
+	GetOrderReviews(context.Context, *GetOrderReviewsRequest) (*OrderReviews, error)
 	GetCertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
+	GetIssuanceState(context.Context, *OrderRequest) (*IssuanceState, error)
 	GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
@@ -1663,18 +2002,25 @@ type StorageAuthorityServer interface {
 	GetRegistrationByKey(context.Context, *JSONWebKey) (*proto.Registration, error)
 	GetRevocationStatus(context.Context, *Serial) (*RevocationStatus, error)
 	GetRevokedCerts(*GetRevokedCertsRequest, StorageAuthority_GetRevokedCertsServer) error
+	GetStalledIssuances(context.Context, *GetStalledIssuancesRequest) (*IssuanceStates, error)
 	GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error)
+	GetTermsAgreement(context.Context, *RegistrationID) (*TermsAgreement, error)
 	GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error)
 	GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error)
+	GetValidationPerspectives(context.Context, *AuthorizationID2) (*ValidationPerspectives, error)
 	IncidentsForSerial(context.Context, *Serial) (*Incidents, error)
 	KeyBlocked(context.Context, *KeyBlockedRequest) (*Exists, error)
 	PreviousCertificateExists(context.Context, *PreviousCertificateExistsRequest) (*Exists, error)
 	SerialsForIncident(*SerialsForIncidentRequest, StorageAuthority_SerialsForIncidentServer) error
 	// Adders
 	AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error)
+	AddBlockedName(context.Context, *AddBlockedNameRequest) (*BlockedName, error)
 	AddCertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error)
 	AddPrecertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error)
+	AddTermsAgreement(context.Context, *TermsAgreement) (*emptypb.Empty, error)
 	SetCertificateStatusReady(context.Context, *Serial) (*emptypb.Empty, error)
+	SetIssuanceState(context.Context, *IssuanceState) (*emptypb.Empty, error)
+	AddValidationPerspectives(context.Context, *AddValidationPerspectivesRequest) (*emptypb.Empty, error)
 	AddSerial(context.Context, *AddSerialRequest) (*emptypb.Empty, error)
 	DeactivateAuthorization2(context.Context, *AuthorizationID2) (*emptypb.Empty, error)
 	DeactivateRegistration(context.Context, *RegistrationID) (*emptypb.Empty, error)
@@ -1682,6 +2028,8 @@ type StorageAuthorityServer interface {
 	FinalizeOrder(context.Context, *FinalizeOrderRequest) (*emptypb.Empty, error)
 	NewOrderAndAuthzs(context.Context, *NewOrderAndAuthzsRequest) (*proto.Order, error)
 	NewRegistration(context.Context, *proto.Registration) (*proto.Registration, error)
+	RemoveBlockedName(context.Context, *RemoveBlockedNameRequest) (*emptypb.Empty, error)
+	DecideOrderReview(context.Context, *DecideOrderReviewRequest) (*OrderReview, error)
 	RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error)
 	SetOrderError(context.Context, *SetOrderErrorRequest) (*emptypb.Empty, error)
 	SetOrderProcessing(context.Context, *OrderRequest) (*emptypb.Empty, error)
@@ -1727,12 +2075,21 @@ func (UnimplementedStorageAuthorityServer) GetAuthorization2(context.Context, *A
 func (UnimplementedStorageAuthorityServer) GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizations2 not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetBlockedNames(context.Context, *GetBlockedNamesRequest) (*BlockedNames, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedNames not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetOrderReviews(context.Context, *GetOrderReviewsRequest) (*OrderReviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderReviews not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetCertificate(context.Context, *Serial) (*proto.Certificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
 }
 func (UnimplementedStorageAuthorityServer) GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateStatus not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetIssuanceState(context.Context, *OrderRequest) (*IssuanceState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuanceState not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaxExpiration not implemented")
 }
@@ -1757,15 +2114,24 @@ func (UnimplementedStorageAuthorityServer) GetRevocationStatus(context.Context,
 func (UnimplementedStorageAuthorityServer) GetRevokedCerts(*GetRevokedCertsRequest, StorageAuthority_GetRevokedCertsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetRevokedCerts not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetStalledIssuances(context.Context, *GetStalledIssuancesRequest) (*IssuanceStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStalledIssuances not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSerialMetadata not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetTermsAgreement(context.Context, *RegistrationID) (*TermsAgreement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTermsAgreement not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
 }
 func (UnimplementedStorageAuthorityServer) GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidOrderAuthorizations2 not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetValidationPerspectives(context.Context, *AuthorizationID2) (*ValidationPerspectives, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationPerspectives not implemented")
+}
 func (UnimplementedStorageAuthorityServer) IncidentsForSerial(context.Context, *Serial) (*Incidents, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncidentsForSerial not implemented")
 }
@@ -1781,15 +2147,27 @@ func (UnimplementedStorageAuthorityServer) SerialsForIncident(*SerialsForInciden
 func (UnimplementedStorageAuthorityServer) AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedKey not implemented")
 }
+func (UnimplementedStorageAuthorityServer) AddBlockedName(context.Context, *AddBlockedNameRequest) (*BlockedName, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedName not implemented")
+}
 func (UnimplementedStorageAuthorityServer) AddCertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCertificate not implemented")
 }
 func (UnimplementedStorageAuthorityServer) AddPrecertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPrecertificate not implemented")
 }
+func (UnimplementedStorageAuthorityServer) AddTermsAgreement(context.Context, *TermsAgreement) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTermsAgreement not implemented")
+}
 func (UnimplementedStorageAuthorityServer) SetCertificateStatusReady(context.Context, *Serial) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetCertificateStatusReady not implemented")
 }
+func (UnimplementedStorageAuthorityServer) SetIssuanceState(context.Context, *IssuanceState) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIssuanceState not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddValidationPerspectives(context.Context, *AddValidationPerspectivesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddValidationPerspectives not implemented")
+}
 func (UnimplementedStorageAuthorityServer) AddSerial(context.Context, *AddSerialRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSerial not implemented")
 }
@@ -1811,6 +2189,12 @@ func (UnimplementedStorageAuthorityServer) NewOrderAndAuthzs(context.Context, *N
 func (UnimplementedStorageAuthorityServer) NewRegistration(context.Context, *proto.Registration) (*proto.Registration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewRegistration not implemented")
 }
+func (UnimplementedStorageAuthorityServer) RemoveBlockedName(context.Context, *RemoveBlockedNameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedName not implemented")
+}
+func (UnimplementedStorageAuthorityServer) DecideOrderReview(context.Context, *DecideOrderReviewRequest) (*OrderReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideOrderReview not implemented")
+}
 func (UnimplementedStorageAuthorityServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
 }
@@ -2037,6 +2421,42 @@ func _StorageAuthority_GetAuthorizations2_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetBlockedNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetBlockedNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetBlockedNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetBlockedNames(ctx, req.(*GetBlockedNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetOrderReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetOrderReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetOrderReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetOrderReviews(ctx, req.(*GetOrderReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -2073,6 +2493,24 @@ func _StorageAuthority_GetCertificateStatus_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetIssuanceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetIssuanceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetIssuanceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetIssuanceState(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_GetMaxExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2220,6 +2658,24 @@ func (x *storageAuthorityGetRevokedCertsServer) Send(m *proto.CRLEntry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _StorageAuthority_GetStalledIssuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStalledIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetStalledIssuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetStalledIssuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetStalledIssuances(ctx, req.(*GetStalledIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_GetSerialMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -2238,6 +2694,24 @@ func _StorageAuthority_GetSerialMetadata_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetTermsAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetTermsAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetTermsAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetTermsAgreement(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidAuthorizationsRequest)
 	if err := dec(in); err != nil {
@@ -2274,6 +2748,24 @@ func _StorageAuthority_GetValidOrderAuthorizations2_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetValidationPerspectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationID2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetValidationPerspectives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetValidationPerspectives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetValidationPerspectives(ctx, req.(*AuthorizationID2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_IncidentsForSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -2367,6 +2859,24 @@ func _StorageAuthority_AddBlockedKey_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_AddBlockedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddBlockedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/AddBlockedName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddBlockedName(ctx, req.(*AddBlockedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_AddCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCertificateRequest)
 	if err := dec(in); err != nil {
@@ -2403,6 +2913,24 @@ func _StorageAuthority_AddPrecertificate_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_AddTermsAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermsAgreement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddTermsAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/AddTermsAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddTermsAgreement(ctx, req.(*TermsAgreement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_SetCertificateStatusReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Serial)
 	if err := dec(in); err != nil {
@@ -2421,6 +2949,42 @@ func _StorageAuthority_SetCertificateStatusReady_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_SetIssuanceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuanceState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).SetIssuanceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/SetIssuanceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).SetIssuanceState(ctx, req.(*IssuanceState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_AddValidationPerspectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddValidationPerspectivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddValidationPerspectives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/AddValidationPerspectives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddValidationPerspectives(ctx, req.(*AddValidationPerspectivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_AddSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddSerialRequest)
 	if err := dec(in); err != nil {
@@ -2547,6 +3111,42 @@ func _StorageAuthority_NewRegistration_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_RemoveBlockedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBlockedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).RemoveBlockedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/RemoveBlockedName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).RemoveBlockedName(ctx, req.(*RemoveBlockedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_DecideOrderReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideOrderReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).DecideOrderReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/DecideOrderReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).DecideOrderReview(ctx, req.(*DecideOrderReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeCertificateRequest)
 	if err := dec(in); err != nil {
@@ -2688,6 +3288,14 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthorizations2",
 			Handler:    _StorageAuthority_GetAuthorizations2_Handler,
 		},
+		{
+			MethodName: "GetBlockedNames",
+			Handler:    _StorageAuthority_GetBlockedNames_Handler,
+		},
+		{
+			MethodName: "GetOrderReviews",
+			Handler:    _StorageAuthority_GetOrderReviews_Handler,
+		},
 		{
 			MethodName: "GetCertificate",
 			Handler:    _StorageAuthority_GetCertificate_Handler,
@@ -2696,6 +3304,10 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCertificateStatus",
 			Handler:    _StorageAuthority_GetCertificateStatus_Handler,
 		},
+		{
+			MethodName: "GetIssuanceState",
+			Handler:    _StorageAuthority_GetIssuanceState_Handler,
+		},
 		{
 			MethodName: "GetMaxExpiration",
 			Handler:    _StorageAuthority_GetMaxExpiration_Handler,
@@ -2724,10 +3336,18 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRevocationStatus",
 			Handler:    _StorageAuthority_GetRevocationStatus_Handler,
 		},
+		{
+			MethodName: "GetStalledIssuances",
+			Handler:    _StorageAuthority_GetStalledIssuances_Handler,
+		},
 		{
 			MethodName: "GetSerialMetadata",
 			Handler:    _StorageAuthority_GetSerialMetadata_Handler,
 		},
+		{
+			MethodName: "GetTermsAgreement",
+			Handler:    _StorageAuthority_GetTermsAgreement_Handler,
+		},
 		{
 			MethodName: "GetValidAuthorizations2",
 			Handler:    _StorageAuthority_GetValidAuthorizations2_Handler,
@@ -2736,6 +3356,10 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidOrderAuthorizations2",
 			Handler:    _StorageAuthority_GetValidOrderAuthorizations2_Handler,
 		},
+		{
+			MethodName: "GetValidationPerspectives",
+			Handler:    _StorageAuthority_GetValidationPerspectives_Handler,
+		},
 		{
 			MethodName: "IncidentsForSerial",
 			Handler:    _StorageAuthority_IncidentsForSerial_Handler,
@@ -2752,6 +3376,10 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddBlockedKey",
 			Handler:    _StorageAuthority_AddBlockedKey_Handler,
 		},
+		{
+			MethodName: "AddBlockedName",
+			Handler:    _StorageAuthority_AddBlockedName_Handler,
+		},
 		{
 			MethodName: "AddCertificate",
 			Handler:    _StorageAuthority_AddCertificate_Handler,
@@ -2760,10 +3388,22 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddPrecertificate",
 			Handler:    _StorageAuthority_AddPrecertificate_Handler,
 		},
+		{
+			MethodName: "AddTermsAgreement",
+			Handler:    _StorageAuthority_AddTermsAgreement_Handler,
+		},
 		{
 			MethodName: "SetCertificateStatusReady",
 			Handler:    _StorageAuthority_SetCertificateStatusReady_Handler,
 		},
+		{
+			MethodName: "SetIssuanceState",
+			Handler:    _StorageAuthority_SetIssuanceState_Handler,
+		},
+		{
+			MethodName: "AddValidationPerspectives",
+			Handler:    _StorageAuthority_AddValidationPerspectives_Handler,
+		},
 		{
 			MethodName: "AddSerial",
 			Handler:    _StorageAuthority_AddSerial_Handler,
@@ -2792,6 +3432,14 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewRegistration",
 			Handler:    _StorageAuthority_NewRegistration_Handler,
 		},
+		{
+			MethodName: "RemoveBlockedName",
+			Handler:    _StorageAuthority_RemoveBlockedName_Handler,
+		},
+		{
+			MethodName: "DecideOrderReview",
+			Handler:    _StorageAuthority_DecideOrderReview_Handler,
+		},
 		{
 			MethodName: "RevokeCertificate",
 			Handler:    _StorageAuthority_RevokeCertificate_Handler,
@@ -5,16 +5,16 @@ import (
 	"time"
 
 	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/iana"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
-	"github.com/weppos/publicsuffix-go/publicsuffix"
 )
 
 // baseDomain returns the eTLD+1 of a domain name for the purpose of rate
 // limiting. For a domain name that is itself an eTLD, it returns its input.
 func baseDomain(name string) string {
-	eTLDPlusOne, err := publicsuffix.Domain(name)
+	eTLDPlusOne, err := iana.Domain(name)
 	if err != nil {
-		// publicsuffix.Domain will return an error if the input name is itself a
+		// iana.Domain will return an error if the input name is itself a
 		// public suffix. In that case we use the input name as the key for rate
 		// limiting. Since all of its subdomains will have separate keys for rate
 		// limiting (e.g. "foo.bar.publicsuffix.com" will have
@@ -18,8 +18,10 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/probs"
 	"github.com/letsencrypt/boulder/revocation"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
@@ -107,7 +109,26 @@ func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, req *corepb
 
 	reg.CreatedAt = ssa.clk.Now()
 
-	err = ssa.dbMap.WithContext(ctx).Insert(reg)
+	_, err = db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		err := txWithCtx.Insert(reg)
+		if err != nil {
+			return nil, err
+		}
+		// The version of the terms of service the account agreed to when it
+		// was created is stored with it, so that neither exists without the
+		// other.
+		if req.TosVersion != "" && features.Enabled(features.TrackTermsAgreements) {
+			err = txWithCtx.Insert(&termsAgreementModel{
+				RegistrationID: reg.ID,
+				Version:        req.TosVersion,
+				Agreed:         reg.CreatedAt,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
 	if err != nil {
 		if db.IsDuplicate(err) {
 			// duplicate entry error can only happen when jwk_sha256 collides, indicate
@@ -273,6 +294,13 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 			return nil, err
 		}
 
+		// The alternate certificate of a dual certificate order shares its
+		// names with the primary, which has already been counted, so treat it
+		// as a renewal.
+		if req.Alternate {
+			isRenewal = true
+		}
+
 		err = addIssuedNames(txWithCtx, parsed, isRenewal)
 		if err != nil {
 			return nil, err
@@ -363,6 +391,13 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 		isRenewal = boolVal
 	}
 
+	// The alternate certificate of a dual certificate order shares its names
+	// with the primary certificate, which has already been counted towards the
+	// rate limits, so there's nothing more to do.
+	if req.Alternate {
+		return &emptypb.Empty{}, nil
+	}
+
 	// In a separate transaction perform the work required to update tables used
 	// for rate limits. Since the effects of failing these writes is slight
 	// miscalculation of rate limits we choose to not fail the AddCertificate
@@ -450,6 +485,11 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 	if req.NewOrder == nil {
 		return nil, errIncompleteRequest
 	}
+	// Refuse to create an order which requires review without recording the
+	// review, since the order would otherwise be issuable right away.
+	if len(req.ReviewReasons) > 0 && !features.Enabled(features.OrderReviews) {
+		return nil, berrors.InternalServerError("order requires review, but order reviews are not enabled")
+	}
 
 	output, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
 		// First, insert all of the new authorizations and record their IDs.
@@ -546,6 +586,28 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 			return nil, err
 		}
 
+		// Sixth, if the order requires manual review, queue it.
+		if len(req.ReviewReasons) > 0 {
+			reasons := make([]orderReviewReason, len(req.ReviewReasons))
+			for i, r := range req.ReviewReasons {
+				reasons[i] = orderReviewReason{Name: r.Name, Rule: r.Rule}
+			}
+			reasonsJSON, err := json.Marshal(reasons)
+			if err != nil {
+				return nil, err
+			}
+			err = txWithCtx.Insert(&orderReviewModel{
+				OrderID:        order.ID,
+				RegistrationID: order.RegistrationID,
+				Reasons:        reasonsJSON,
+				Status:         orderReviewPending,
+				Created:        order.Created,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// Finally, build the overall Order PB.
 		res := &corepb.Order{
 			// ID and Created were auto-populated on the order model when it was inserted.
@@ -661,12 +723,15 @@ func (ssa *SQLStorageAuthority) SetOrderError(ctx context.Context, req *sapb.Set
 
 // FinalizeOrder finalizes a provided *corepb.Order by persisting the
 // CertificateSerial and a valid status to the database. No fields other than
-// CertificateSerial and the order ID on the provided order are processed (e.g.
-// this is not a generic update RPC).
+// CertificateSerial, AlternateCertificateSerial, and the order ID on the
+// provided order are processed (e.g. this is not a generic update RPC).
 func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *sapb.FinalizeOrderRequest) (*emptypb.Empty, error) {
 	if req.Id == 0 || req.CertificateSerial == "" {
 		return nil, errIncompleteRequest
 	}
+	if req.AlternateCertificateSerial != "" && !features.Enabled(features.DualCertificateOrders) {
+		return nil, berrors.InternalServerError("alternate certificate serial provided but DualCertificateOrders is not enabled")
+	}
 	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
 		result, err := txWithCtx.Exec(`
 		UPDATE orders
@@ -691,6 +756,16 @@ func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *sapb.Fin
 			return nil, err
 		}
 
+		if req.AlternateCertificateSerial != "" {
+			err = txWithCtx.Insert(&orderAlternateCertificateModel{
+				OrderID:           req.Id,
+				CertificateSerial: req.AlternateCertificateSerial,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return nil, nil
 	})
 	if overallError != nil {
@@ -699,6 +774,85 @@ func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *sapb.Fin
 	return &emptypb.Empty{}, nil
 }
 
+// SetIssuanceState records how far the RA has progressed in issuing the
+// certificate for an order. The "started" state creates the order's row; every
+// later state updates it, and is only accepted if it moves the issuance
+// forward. Moving to the "precertificate" state requires the precertificate's
+// serial and DER, along with those of the alternate precertificate for a dual
+// certificate order.
+func (ssa *SQLStorageAuthority) SetIssuanceState(ctx context.Context, req *sapb.IssuanceState) (*emptypb.Empty, error) {
+	if req == nil || req.OrderID == 0 || req.RegistrationID == 0 || req.State == "" {
+		return nil, errIncompleteRequest
+	}
+	state := core.IssuanceState(req.State)
+	stateInt, ok := issuanceStateToUint[state]
+	if !ok {
+		return nil, fmt.Errorf("unrecognized issuance state %q", req.State)
+	}
+	now := ssa.clk.Now()
+
+	if state == core.IssuanceStarted {
+		err := ssa.dbMap.WithContext(ctx).Insert(&issuanceStateModel{
+			OrderID:        req.OrderID,
+			RegistrationID: req.RegistrationID,
+			State:          stateInt,
+			Created:        now,
+			Updated:        now,
+		})
+		if err != nil {
+			if db.IsDuplicate(err) {
+				return nil, berrors.DuplicateError("issuance for order %d has already started", req.OrderID)
+			}
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	setClause := "state = ?, updated = ?"
+	params := []interface{}{stateInt, now}
+	if state == core.IssuancePrecertificateIssued {
+		if req.Serial == "" || len(req.Precertificate) == 0 {
+			return nil, errIncompleteRequest
+		}
+		setClause += ", serial = ?, precertificate = ?"
+		params = append(params, req.Serial, req.Precertificate)
+		if req.AlternateSerial != "" || len(req.AlternatePrecertificate) != 0 {
+			if req.AlternateSerial == "" || len(req.AlternatePrecertificate) == 0 {
+				return nil, errIncompleteRequest
+			}
+			setClause += ", alternateSerial = ?, alternatePrecertificate = ?"
+			params = append(params, req.AlternateSerial, req.AlternatePrecertificate)
+		}
+	}
+
+	var fromStates []interface{}
+	for _, from := range issuanceStateTransitions[state] {
+		fromStates = append(fromStates, issuanceStateToUint[from])
+	}
+	params = append(params, req.OrderID, req.RegistrationID)
+	params = append(params, fromStates...)
+
+	res, err := ssa.dbMap.WithContext(ctx).Exec(
+		fmt.Sprintf(`UPDATE issuanceStates
+		SET %s
+		WHERE orderID = ? AND
+		registrationID = ? AND
+		state IN (%s)`, setClause, db.QuestionMarks(len(fromStates))),
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no issuance for order %d which can move to state %q", req.OrderID, req.State)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // FinalizeAuthorization2 moves a pending authorization to either the valid or invalid status. If
 // the authorization is being moved to invalid the validationError field must be set. If the
 // authorization is being moved to valid the validationRecord and expires fields must be set.
@@ -770,19 +924,73 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 		"validationError": veJSON,
 	}
 
-	res, err := ssa.dbMap.Exec(query, params)
-	if err != nil {
-		return nil, err
+	var perspectives []*validationPerspectiveModel
+	if features.Enabled(features.StoreValidationPerspectives) {
+		created := ssa.clk.Now()
+		for _, pr := range req.Perspectives {
+			m, err := perspectiveResultToModel(req.Id, pr, created)
+			if err != nil {
+				return nil, err
+			}
+			perspectives = append(perspectives, m)
+		}
 	}
-	rows, err := res.RowsAffected()
+
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		res, err := txWithCtx.Exec(query, params)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, berrors.NotFoundError("authorization with id %d not found", req.Id)
+		} else if rows > 1 {
+			return nil, berrors.InternalServerError("multiple rows updated for authorization id %d", req.Id)
+		}
+
+		for _, m := range perspectives {
+			err = txWithCtx.Insert(m)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if overallError != nil {
+		return nil, overallError
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AddValidationPerspectives stores the results of a validation of the given
+// authorization from network perspectives which only reported after the
+// validation's outcome had been decided, and so weren't stored along with the
+// others by FinalizeAuthorization2. Like FinalizeAuthorization2, it stores
+// nothing unless the StoreValidationPerspectives feature is enabled.
+func (ssa *SQLStorageAuthority) AddValidationPerspectives(ctx context.Context, req *sapb.AddValidationPerspectivesRequest) (*emptypb.Empty, error) {
+	if req == nil || req.AuthzID == 0 || len(req.Perspectives) == 0 {
+		return nil, errIncompleteRequest
+	}
+	if !features.Enabled(features.StoreValidationPerspectives) {
+		return &emptypb.Empty{}, nil
+	}
+
+	created := ssa.clk.Now()
+	var perspectives []interface{}
+	for _, pr := range req.Perspectives {
+		m, err := perspectiveResultToModel(req.AuthzID, pr, created)
+		if err != nil {
+			return nil, err
+		}
+		perspectives = append(perspectives, m)
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(perspectives...)
 	if err != nil {
 		return nil, err
 	}
-	if rows == 0 {
-		return nil, berrors.NotFoundError("authorization with id %d not found", req.Id)
-	} else if rows > 1 {
-		return nil, berrors.InternalServerError("multiple rows updated for authorization id %d", req.Id)
-	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -902,6 +1110,142 @@ func (ssa *SQLStorageAuthority) AddBlockedKey(ctx context.Context, req *sapb.Add
 	return &emptypb.Empty{}, nil
 }
 
+// AddBlockedName adds an entry to the hostname blocklist, and returns it with
+// its ID.
+func (ssa *SQLStorageAuthority) AddBlockedName(ctx context.Context, req *sapb.AddBlockedNameRequest) (*sapb.BlockedName, error) {
+	if core.IsAnyNilOrZero(req.Name, req.BlockType, req.Reason, req.Creator) {
+		return nil, errIncompleteRequest
+	}
+	if !blockedNameTypes[req.BlockType] {
+		return nil, fmt.Errorf("unknown block type %q", req.BlockType)
+	}
+	m := &blockedNameModel{
+		Name:      strings.ToLower(req.Name),
+		BlockType: req.BlockType,
+		Reason:    req.Reason,
+		Creator:   req.Creator,
+		Created:   ssa.clk.Now(),
+	}
+	if req.Expires != nil {
+		expires := req.Expires.AsTime()
+		m.Expires = &expires
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(m)
+	if err != nil {
+		return nil, err
+	}
+	return blockedNameModelToPB(*m), nil
+}
+
+// RemoveBlockedName removes an entry from the hostname blocklist, recording
+// when and by whom. The row itself is kept.
+func (ssa *SQLStorageAuthority) RemoveBlockedName(ctx context.Context, req *sapb.RemoveBlockedNameRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.Id, req.Remover) {
+		return nil, errIncompleteRequest
+	}
+	res, err := ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE blockedNames SET removedAt = ?, removedBy = ? WHERE id = ? AND removedAt IS NULL",
+		ssa.clk.Now(), req.Remover, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("blocked name with ID '%d' not found or already removed", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DecideOrderReview records an operator's decision about an order awaiting
+// manual review. If the order is approved it proceeds as usual. If it is
+// denied the order's error is set, making it invalid.
+func (ssa *SQLStorageAuthority) DecideOrderReview(ctx context.Context, req *sapb.DecideOrderReviewRequest) (*sapb.OrderReview, error) {
+	if core.IsAnyNilOrZero(req.OrderID, req.Reviewer, req.Reason) {
+		return nil, errIncompleteRequest
+	}
+	status := orderReviewDenied
+	if req.Approved {
+		status = orderReviewApproved
+	}
+
+	output, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var m orderReviewModel
+		err := txWithCtx.SelectOne(&m, "SELECT "+orderReviewFields+" FROM orderReviews WHERE orderID = ?", req.OrderID)
+		if err != nil {
+			if db.IsNoRows(err) {
+				return nil, berrors.NotFoundError("no review found for order %d", req.OrderID)
+			}
+			return nil, err
+		}
+		if m.Status != orderReviewPending {
+			return nil, berrors.MalformedError("review of order %d was already %s", req.OrderID, m.Status)
+		}
+
+		now := ssa.clk.Now()
+		res, err := txWithCtx.Exec(`
+			UPDATE orderReviews
+			SET status = ?, reviewer = ?, decisionReason = ?, decided = ?
+			WHERE id = ? AND status = ?`,
+			status, req.Reviewer, req.Reason, now, m.ID, orderReviewPending)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, berrors.MalformedError("review of order %d was decided concurrently", req.OrderID)
+		}
+
+		if !req.Approved {
+			prob, err := json.Marshal(probs.RejectedIdentifier(
+				"The ACME server refuses to issue a certificate for this order after manual review"))
+			if err != nil {
+				return nil, err
+			}
+			_, err = txWithCtx.Exec("UPDATE orders SET error = ? WHERE id = ?", prob, req.OrderID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		m.Status = status
+		m.Reviewer = req.Reviewer
+		m.DecisionReason = req.Reason
+		m.Decided = &now
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m, ok := output.(orderReviewModel)
+	if !ok {
+		return nil, fmt.Errorf("casting error in DecideOrderReview")
+	}
+	return orderReviewModelToPB(m)
+}
+
+// AddTermsAgreement records that an account agreed to a version of the terms
+// of service.
+func (ssa *SQLStorageAuthority) AddTermsAgreement(ctx context.Context, req *sapb.TermsAgreement) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Version) {
+		return nil, errIncompleteRequest
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(&termsAgreementModel{
+		RegistrationID: req.RegistrationID,
+		Version:        req.Version,
+		Agreed:         ssa.clk.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // Health implements the grpc.checker interface.
 func (ssa *SQLStorageAuthority) Health(ctx context.Context) error {
 	err := ssa.dbMap.WithContext(ctx).SelectOne(new(int), "SELECT 1")
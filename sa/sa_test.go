@@ -41,6 +41,7 @@ import (
 	"golang.org/x/crypto/ocsp"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/go-jose/go-jose.v2"
 )
 
@@ -1461,6 +1462,150 @@ func TestFinalizeOrder(t *testing.T) {
 	test.AssertEquals(t, updatedOrder.Status, string(core.StatusValid))
 }
 
+func TestFinalizeOrderAlternateCertificate(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires orderAlternateCertificates database table")
+	}
+
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg := createWorkingRegistration(t, sa)
+	authzID := createFinalizedAuthorization(t, sa, "example.com", fc.Now().Add(time.Hour), "valid", fc.Now())
+	order, err := sa.NewOrderAndAuthzs(ctx, &sapb.NewOrderAndAuthzsRequest{
+		NewOrder: &sapb.NewOrderRequest{
+			RegistrationID:   reg.Id,
+			Expires:          fc.Now().Add(365 * 24 * time.Hour).UnixNano(),
+			Names:            []string{"example.com"},
+			V2Authorizations: []int64{authzID},
+		},
+	})
+	test.AssertNotError(t, err, "NewOrderAndAuthzs failed")
+	_, err = sa.SetOrderProcessing(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "SetOrderProcessing failed")
+
+	req := &sapb.FinalizeOrderRequest{
+		Id:                         order.Id,
+		CertificateSerial:          "primary.serial",
+		AlternateCertificateSerial: "alternate.serial",
+	}
+
+	// Alternate certificates are rejected unless the feature is enabled.
+	_, err = sa.FinalizeOrder(ctx, req)
+	test.AssertError(t, err, "FinalizeOrder didn't fail with DualCertificateOrders disabled")
+
+	err = features.Set(map[string]bool{"DualCertificateOrders": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	_, err = sa.FinalizeOrder(ctx, req)
+	test.AssertNotError(t, err, "FinalizeOrder failed")
+
+	updatedOrder, err := sa.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "GetOrder failed")
+	test.AssertEquals(t, updatedOrder.CertificateSerial, "primary.serial")
+	test.AssertEquals(t, updatedOrder.AlternateCertificateSerial, "alternate.serial")
+	test.AssertEquals(t, updatedOrder.Status, string(core.StatusValid))
+}
+
+func TestIssuanceState(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires issuanceStates database table")
+	}
+
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg := createWorkingRegistration(t, sa)
+
+	// Nothing has been recorded yet.
+	_, err := sa.GetIssuanceState(ctx, &sapb.OrderRequest{Id: 1})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	// Issuance can't move forward before it has started.
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuanceComplete)})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuanceStarted)})
+	test.AssertNotError(t, err, "starting issuance")
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuanceStarted)})
+	test.AssertErrorIs(t, err, berrors.Duplicate)
+
+	// Recording a precertificate requires the precertificate.
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuancePrecertificateIssued)})
+	test.AssertError(t, err, "recording precertificate without DER should fail")
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{
+		OrderID:         1,
+		RegistrationID:  reg.Id,
+		State:           string(core.IssuancePrecertificateIssued),
+		Serial:          "000000000000000000000000000000000001",
+		Precertificate:  []byte{1, 2, 3},
+		AlternateSerial: "000000000000000000000000000000000002",
+	})
+	test.AssertError(t, err, "recording alternate precertificate without DER should fail")
+
+	fc.Add(time.Minute)
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{
+		OrderID:                 1,
+		RegistrationID:          reg.Id,
+		State:                   string(core.IssuancePrecertificateIssued),
+		Serial:                  "000000000000000000000000000000000001",
+		Precertificate:          []byte{1, 2, 3},
+		AlternateSerial:         "000000000000000000000000000000000002",
+		AlternatePrecertificate: []byte{4, 5, 6},
+	})
+	test.AssertNotError(t, err, "recording precertificate")
+
+	state, err := sa.GetIssuanceState(ctx, &sapb.OrderRequest{Id: 1})
+	test.AssertNotError(t, err, "getting issuance state")
+	test.AssertEquals(t, state.State, string(core.IssuancePrecertificateIssued))
+	test.AssertEquals(t, state.Serial, "000000000000000000000000000000000001")
+	test.AssertByteEquals(t, state.Precertificate, []byte{1, 2, 3})
+	test.AssertEquals(t, state.AlternateSerial, "000000000000000000000000000000000002")
+	test.AssertByteEquals(t, state.AlternatePrecertificate, []byte{4, 5, 6})
+	test.AssertEquals(t, state.Updated.AsTime(), fc.Now())
+
+	// A second issuance, started a minute later.
+	fc.Add(time.Minute)
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 2, RegistrationID: reg.Id, State: string(core.IssuanceStarted)})
+	test.AssertNotError(t, err, "starting issuance")
+
+	// Only the first issuance has gone without progress since a second ago.
+	stalled, err := sa.GetStalledIssuances(ctx, &sapb.GetStalledIssuancesRequest{
+		UpdatedBefore: timestamppb.New(fc.Now().Add(-time.Second)),
+		Limit:         10,
+	})
+	test.AssertNotError(t, err, "getting stalled issuances")
+	test.AssertEquals(t, len(stalled.States), 1)
+	test.AssertEquals(t, stalled.States[0].OrderID, int64(1))
+
+	// Both have gone without progress since a second from now, oldest first.
+	stalled, err = sa.GetStalledIssuances(ctx, &sapb.GetStalledIssuancesRequest{
+		UpdatedBefore: timestamppb.New(fc.Now().Add(time.Second)),
+		Limit:         10,
+	})
+	test.AssertNotError(t, err, "getting stalled issuances")
+	test.AssertEquals(t, len(stalled.States), 2)
+	test.AssertEquals(t, stalled.States[0].OrderID, int64(1))
+	test.AssertEquals(t, stalled.States[1].OrderID, int64(2))
+
+	// Completed and abandoned issuances are never stalled, and can't be revived.
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuanceComplete)})
+	test.AssertNotError(t, err, "completing issuance")
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 2, RegistrationID: reg.Id, State: string(core.IssuanceAbandoned)})
+	test.AssertNotError(t, err, "abandoning issuance")
+	_, err = sa.SetIssuanceState(ctx, &sapb.IssuanceState{OrderID: 1, RegistrationID: reg.Id, State: string(core.IssuanceAbandoned)})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	fc.Add(time.Hour)
+	stalled, err = sa.GetStalledIssuances(ctx, &sapb.GetStalledIssuancesRequest{
+		UpdatedBefore: timestamppb.New(fc.Now()),
+		Limit:         10,
+	})
+	test.AssertNotError(t, err, "getting stalled issuances")
+	test.AssertEquals(t, len(stalled.States), 0)
+}
+
 func TestOrder(t *testing.T) {
 	sa, fc, cleanup := initSA(t)
 	defer cleanup()
@@ -2407,6 +2552,60 @@ func TestFinalizeAuthorization2(t *testing.T) {
 	test.AssertDeepEquals(t, dbVer.Challenges[0].Error, prob)
 }
 
+func TestFinalizeAuthorization2Perspectives(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires validationPerspectives database table")
+	}
+
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"StoreValidationPerspectives": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	authzID := createPendingAuthorization(t, sa, "aaa", fc.Now().Add(time.Hour))
+	prob, _ := bgrpc.ProblemDetailsToPB(probs.Connection("it went bad captain"))
+	perspectives := []*corepb.PerspectiveResult{
+		{Perspective: "primary", Rir: "ARIN", Success: true},
+		{Perspective: "remoteA", Rir: "RIPE", Success: false, Problem: prob},
+	}
+	_, err = sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:           authzID,
+		Status:       string(core.StatusValid),
+		Expires:      fc.Now().Add(time.Hour * 2).UTC().UnixNano(),
+		Attempted:    string(core.ChallengeTypeDNS01),
+		AttemptedAt:  fc.Now().UnixNano(),
+		Perspectives: perspectives,
+	})
+	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+
+	res, err := sa.GetValidationPerspectives(context.Background(), &sapb.AuthorizationID2{Id: authzID})
+	test.AssertNotError(t, err, "sa.GetValidationPerspectives failed")
+	test.AssertEquals(t, len(res.Perspectives), 2)
+	for i, p := range res.Perspectives {
+		test.AssertDeepEquals(t, p, perspectives[i])
+	}
+
+	// Nothing is stored for an authorization which wasn't finalized.
+	res, err = sa.GetValidationPerspectives(context.Background(), &sapb.AuthorizationID2{Id: authzID + 1})
+	test.AssertNotError(t, err, "sa.GetValidationPerspectives failed")
+	test.AssertEquals(t, len(res.Perspectives), 0)
+
+	// Perspectives aren't stored if finalizing the authorization fails.
+	_, err = sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:           authzID,
+		Status:       string(core.StatusValid),
+		Expires:      fc.Now().Add(time.Hour * 2).UTC().UnixNano(),
+		Attempted:    string(core.ChallengeTypeDNS01),
+		Perspectives: perspectives,
+	})
+	test.AssertError(t, err, "finalized an authorization twice")
+	res, err = sa.GetValidationPerspectives(context.Background(), &sapb.AuthorizationID2{Id: authzID})
+	test.AssertNotError(t, err, "sa.GetValidationPerspectives failed")
+	test.AssertEquals(t, len(res.Perspectives), 2)
+}
+
 func TestRehydrateHostPort(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -3253,3 +3452,238 @@ func TestGetMaxExpiration(t *testing.T) {
 	test.AssertNotError(t, err, "getting last expriy should succeed")
 	test.Assert(t, lastExpiry.AsTime().Equal(eeCert.NotAfter), "times should be equal")
 }
+
+func TestBlockedNames(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires blockedNames database table")
+	}
+
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "example.com", BlockType: "exact"})
+	test.AssertError(t, err, "added a blocked name without a reason or creator")
+	_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{
+		Name:      "example.com",
+		BlockType: "bogus",
+		Reason:    "testing",
+		Creator:   "admin",
+	})
+	test.AssertError(t, err, "added a blocked name with an unknown block type")
+
+	exact, err := sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{
+		Name:      "Bad.Example.com",
+		BlockType: "exact",
+		Reason:    "testing",
+		Creator:   "admin",
+	})
+	test.AssertNotError(t, err, "adding exact blocked name")
+	test.AssertEquals(t, exact.Name, "bad.example.com")
+	test.Assert(t, exact.Id != 0, "blocked name has no ID")
+	test.Assert(t, exact.Expires == nil, "blocked name has an expiry")
+
+	expiring, err := sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{
+		Name:      "risky_name.example.net",
+		BlockType: "highRisk",
+		Reason:    "testing",
+		Creator:   "admin",
+		Expires:   timestamppb.New(fc.Now().Add(time.Hour)),
+	})
+	test.AssertNotError(t, err, "adding expiring blocked name")
+
+	names, err := sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{})
+	test.AssertNotError(t, err, "getting blocked names")
+	test.AssertEquals(t, len(names.Names), 2)
+	test.AssertEquals(t, names.Names[0].Id, exact.Id)
+	test.AssertEquals(t, names.Names[1].BlockType, "highRisk")
+
+	// Searching matches substrings, and LIKE wildcards are matched literally.
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{Search: "_name"})
+	test.AssertNotError(t, err, "searching blocked names")
+	test.AssertEquals(t, len(names.Names), 1)
+	test.AssertEquals(t, names.Names[0].Id, expiring.Id)
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{Search: "d_example"})
+	test.AssertNotError(t, err, "searching blocked names")
+	test.AssertEquals(t, len(names.Names), 0)
+
+	// Expired entries are only returned on request.
+	fc.Add(2 * time.Hour)
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{})
+	test.AssertNotError(t, err, "getting blocked names")
+	test.AssertEquals(t, len(names.Names), 1)
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{IncludeExpired: true})
+	test.AssertNotError(t, err, "getting blocked names")
+	test.AssertEquals(t, len(names.Names), 2)
+
+	// Removed entries are kept, and only returned on request.
+	_, err = sa.RemoveBlockedName(ctx, &sapb.RemoveBlockedNameRequest{Id: exact.Id})
+	test.AssertError(t, err, "removed a blocked name without a remover")
+	_, err = sa.RemoveBlockedName(ctx, &sapb.RemoveBlockedNameRequest{Id: exact.Id, Remover: "admin2"})
+	test.AssertNotError(t, err, "removing blocked name")
+	_, err = sa.RemoveBlockedName(ctx, &sapb.RemoveBlockedNameRequest{Id: exact.Id, Remover: "admin2"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{IncludeExpired: true})
+	test.AssertNotError(t, err, "getting blocked names")
+	test.AssertEquals(t, len(names.Names), 1)
+	names, err = sa.GetBlockedNames(ctx, &sapb.GetBlockedNamesRequest{IncludeExpired: true, IncludeRemoved: true})
+	test.AssertNotError(t, err, "getting blocked names")
+	test.AssertEquals(t, len(names.Names), 2)
+	test.AssertEquals(t, names.Names[0].Id, exact.Id)
+	test.AssertEquals(t, names.Names[0].RemovedBy, "admin2")
+	test.AssertEquals(t, names.Names[0].Removed.AsTime(), fc.Now())
+}
+
+func TestOrderReviews(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires orderReviews database table")
+	}
+
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := createWorkingRegistration(t, sa)
+	newOrder := func(name string, reasons []*sapb.OrderReviewReason) *corepb.Order {
+		t.Helper()
+		order, err := sa.NewOrderAndAuthzs(ctx, &sapb.NewOrderAndAuthzsRequest{
+			NewOrder: &sapb.NewOrderRequest{
+				RegistrationID: reg.Id,
+				Expires:        fc.Now().Add(time.Hour).UnixNano(),
+				Names:          []string{name},
+			},
+			NewAuthzs: []*corepb.Authorization{{
+				Identifier:     name,
+				RegistrationID: reg.Id,
+				Expires:        fc.Now().Add(time.Hour).UnixNano(),
+				Status:         "pending",
+				Challenges:     []*corepb.Challenge{{Token: core.NewToken()}},
+			}},
+			ReviewReasons: reasons,
+		})
+		test.AssertNotError(t, err, "creating order")
+		return order
+	}
+	reasons := []*sapb.OrderReviewReason{{Name: "bank.example", Rule: "ReviewRequiredNames:bank.example"}}
+
+	// Without the feature, orders can't be queued for review.
+	_, err := sa.NewOrderAndAuthzs(ctx, &sapb.NewOrderAndAuthzsRequest{
+		NewOrder: &sapb.NewOrderRequest{
+			RegistrationID:   reg.Id,
+			Expires:          fc.Now().Add(time.Hour).UnixNano(),
+			Names:            []string{"bank.example"},
+			V2Authorizations: []int64{createPendingAuthorization(t, sa, "bank.example", fc.Now().Add(time.Hour))},
+		},
+		ReviewReasons: reasons,
+	})
+	test.AssertError(t, err, "queued an order for review without the OrderReviews feature")
+
+	err = features.Set(map[string]bool{"OrderReviews": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	plain := newOrder("example.com", nil)
+	test.AssertEquals(t, plain.Status, string(core.StatusPending))
+	approved := newOrder("bank.example", reasons)
+	test.AssertEquals(t, approved.Status, string(core.StatusPendingReview))
+	denied := newOrder("www.bank.example", reasons)
+
+	reviews, err := sa.GetOrderReviews(ctx, &sapb.GetOrderReviewsRequest{Status: "pending"})
+	test.AssertNotError(t, err, "getting order reviews")
+	test.AssertEquals(t, len(reviews.Reviews), 2)
+	test.AssertEquals(t, reviews.Reviews[0].OrderID, approved.Id)
+	test.AssertEquals(t, reviews.Reviews[0].RegistrationID, reg.Id)
+	test.AssertEquals(t, reviews.Reviews[0].Reasons[0].Rule, "ReviewRequiredNames:bank.example")
+
+	_, err = sa.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{OrderID: approved.Id, Approved: true})
+	test.AssertError(t, err, "decided a review without a reviewer or reason")
+	_, err = sa.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{
+		OrderID: plain.Id, Approved: true, Reviewer: "alice", Reason: "fine"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	review, err := sa.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{
+		OrderID: approved.Id, Approved: true, Reviewer: "alice", Reason: "verified with the bank"})
+	test.AssertNotError(t, err, "approving order")
+	test.AssertEquals(t, review.Status, "approved")
+	test.AssertEquals(t, review.Reviewer, "alice")
+	test.AssertEquals(t, review.Decided.AsTime(), fc.Now())
+	order, err := sa.GetOrder(ctx, &sapb.OrderRequest{Id: approved.Id})
+	test.AssertNotError(t, err, "getting approved order")
+	test.AssertEquals(t, order.Status, string(core.StatusPending))
+
+	_, err = sa.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{
+		OrderID: approved.Id, Approved: false, Reviewer: "bob", Reason: "changed my mind"})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	_, err = sa.DecideOrderReview(ctx, &sapb.DecideOrderReviewRequest{
+		OrderID: denied.Id, Approved: false, Reviewer: "bob", Reason: "phishing"})
+	test.AssertNotError(t, err, "denying order")
+	order, err = sa.GetOrder(ctx, &sapb.OrderRequest{Id: denied.Id})
+	test.AssertNotError(t, err, "getting denied order")
+	test.AssertEquals(t, order.Status, string(core.StatusInvalid))
+	test.AssertEquals(t, order.Error.ProblemType, string(probs.RejectedIdentifierProblem))
+
+	reviews, err = sa.GetOrderReviews(ctx, &sapb.GetOrderReviewsRequest{Status: "pending"})
+	test.AssertNotError(t, err, "getting order reviews")
+	test.AssertEquals(t, len(reviews.Reviews), 0)
+	reviews, err = sa.GetOrderReviews(ctx, &sapb.GetOrderReviewsRequest{})
+	test.AssertNotError(t, err, "getting order reviews")
+	test.AssertEquals(t, len(reviews.Reviews), 2)
+}
+
+func TestTermsAgreements(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("Test requires termsAgreements database table")
+	}
+
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"TrackTermsAgreements": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	reg := createWorkingRegistration(t, sa)
+
+	_, err = sa.AddTermsAgreement(ctx, &sapb.TermsAgreement{RegistrationID: reg.Id})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+
+	_, err = sa.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	_, err = sa.AddTermsAgreement(ctx, &sapb.TermsAgreement{RegistrationID: reg.Id, Version: "2023-01-01"})
+	test.AssertNotError(t, err, "adding terms agreement")
+	fc.Add(time.Hour)
+	_, err = sa.AddTermsAgreement(ctx, &sapb.TermsAgreement{RegistrationID: reg.Id, Version: "2023-07-01"})
+	test.AssertNotError(t, err, "adding terms agreement")
+
+	// The most recent agreement is returned.
+	agreement, err := sa.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "getting terms agreement")
+	test.AssertEquals(t, agreement.RegistrationID, reg.Id)
+	test.AssertEquals(t, agreement.Version, "2023-07-01")
+	test.AssertEquals(t, agreement.Agreed.AsTime(), fc.Now())
+
+	// A new registration's agreement is stored along with it.
+	key, _ := jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(2), E: 3}}.MarshalJSON()
+	newReg, err := sa.NewRegistration(ctx, &corepb.Registration{
+		Key:        key,
+		InitialIP:  []byte{127, 0, 0, 1},
+		TosVersion: "2023-07-01",
+	})
+	test.AssertNotError(t, err, "creating registration")
+	agreement, err = sa.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: newReg.Id})
+	test.AssertNotError(t, err, "getting terms agreement")
+	test.AssertEquals(t, agreement.Version, "2023-07-01")
+	test.AssertEquals(t, agreement.Agreed.AsTime(), fc.Now())
+
+	// Without the TrackTermsAgreements feature, it isn't.
+	features.Reset()
+	key, _ = jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(3), E: 3}}.MarshalJSON()
+	newReg, err = sa.NewRegistration(ctx, &corepb.Registration{
+		Key:        key,
+		InitialIP:  []byte{127, 0, 0, 1},
+		TosVersion: "2023-07-01",
+	})
+	test.AssertNotError(t, err, "creating registration")
+	_, err = sa.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: newReg.Id})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
@@ -21,6 +21,7 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
@@ -699,6 +700,13 @@ func (ssa *SQLStorageAuthorityRO) GetOrder(ctx context.Context, req *sapb.OrderR
 		}
 		order.Names = reversedNames
 
+		if features.Enabled(features.DualCertificateOrders) && order.CertificateSerial != "" {
+			order.AlternateCertificateSerial, err = alternateCertificateForOrder(txWithCtx, order.Id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// Calculate the status for the order
 		status, err := statusForOrder(txWithCtx, order, ssa.clk.Now())
 		if err != nil {
@@ -796,9 +804,11 @@ func (ssa *SQLStorageAuthorityRO) GetOrderForNames(ctx context.Context, req *sap
 	if err != nil {
 		return nil, err
 	}
-	// Only return a pending or ready order
+	// Only return a pending, ready or pendingReview order. Reusing an order
+	// awaiting review keeps retries from queueing it for review again.
 	if order.Status != string(core.StatusPending) &&
-		order.Status != string(core.StatusReady) {
+		order.Status != string(core.StatusReady) &&
+		order.Status != string(core.StatusPendingReview) {
 		return nil, berrors.NotFoundError("no order matching request found")
 	}
 	return order, nil
@@ -1354,6 +1364,221 @@ func (ssa *SQLStorageAuthority) GetMaxExpiration(ctx context.Context, req *empty
 	return ssa.SQLStorageAuthorityRO.GetMaxExpiration(ctx, req)
 }
 
+// GetIssuanceState returns the recorded issuance state of the order with the
+// given ID, or a NotFound error if issuance of that order was never started.
+func (ssa *SQLStorageAuthorityRO) GetIssuanceState(ctx context.Context, req *sapb.OrderRequest) (*sapb.IssuanceState, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+
+	var model issuanceStateModel
+	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+		&model,
+		"SELECT "+issuanceStateFields+" FROM issuanceStates WHERE orderID = ?",
+		req.Id,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no issuance state found for order %d", req.Id)
+		}
+		return nil, err
+	}
+	return issuanceStateModelToPB(model)
+}
+
+func (ssa *SQLStorageAuthority) GetIssuanceState(ctx context.Context, req *sapb.OrderRequest) (*sapb.IssuanceState, error) {
+	return ssa.SQLStorageAuthorityRO.GetIssuanceState(ctx, req)
+}
+
+// GetStalledIssuances returns up to `req.Limit` issuances which are neither
+// complete nor abandoned, and which have not been updated since
+// `req.UpdatedBefore`. These are issuances which the RA started but, most
+// likely due to a crash, never finished. The oldest are returned first.
+func (ssa *SQLStorageAuthorityRO) GetStalledIssuances(ctx context.Context, req *sapb.GetStalledIssuancesRequest) (*sapb.IssuanceStates, error) {
+	if req == nil || req.UpdatedBefore == nil || req.Limit == 0 {
+		return nil, errIncompleteRequest
+	}
+
+	var models []issuanceStateModel
+	_, err := ssa.dbReadOnlyMap.WithContext(ctx).Select(
+		&models,
+		`SELECT `+issuanceStateFields+` FROM issuanceStates
+		WHERE state IN (?, ?)
+		AND updated < ?
+		ORDER BY updated ASC
+		LIMIT ?`,
+		issuanceStateToUint[core.IssuanceStarted],
+		issuanceStateToUint[core.IssuancePrecertificateIssued],
+		req.UpdatedBefore.AsTime(),
+		req.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*sapb.IssuanceState, 0, len(models))
+	for _, m := range models {
+		state, err := issuanceStateModelToPB(m)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return &sapb.IssuanceStates{States: states}, nil
+}
+
+func (ssa *SQLStorageAuthority) GetStalledIssuances(ctx context.Context, req *sapb.GetStalledIssuancesRequest) (*sapb.IssuanceStates, error) {
+	return ssa.SQLStorageAuthorityRO.GetStalledIssuances(ctx, req)
+}
+
+// GetValidationPerspectives returns the result of each validation of the
+// authorization with the given ID from each network perspective, in the order
+// they were recorded. Results from perspectives which reported after the
+// validation's outcome was decided may be recorded before the others.
+// Authorizations validated before results were stored have none.
+func (ssa *SQLStorageAuthorityRO) GetValidationPerspectives(ctx context.Context, req *sapb.AuthorizationID2) (*sapb.ValidationPerspectives, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+
+	var models []validationPerspectiveModel
+	_, err := ssa.dbReadOnlyMap.WithContext(ctx).Select(
+		&models,
+		"SELECT "+validationPerspectiveFields+" FROM validationPerspectives WHERE authzID = ? ORDER BY id ASC",
+		req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	perspectives := make([]*corepb.PerspectiveResult, 0, len(models))
+	for _, m := range models {
+		pr, err := validationPerspectiveModelToPB(m)
+		if err != nil {
+			return nil, err
+		}
+		perspectives = append(perspectives, pr)
+	}
+	return &sapb.ValidationPerspectives{Perspectives: perspectives}, nil
+}
+
+func (ssa *SQLStorageAuthority) GetValidationPerspectives(ctx context.Context, req *sapb.AuthorizationID2) (*sapb.ValidationPerspectives, error) {
+	return ssa.SQLStorageAuthorityRO.GetValidationPerspectives(ctx, req)
+}
+
+// likeEscaper escapes the wildcard characters of a MySQL LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetBlockedNames returns the entries in the hostname blocklist, optionally
+// only those whose name contains req.Search. Expired and removed entries are
+// omitted unless req.IncludeExpired or req.IncludeRemoved are set.
+func (ssa *SQLStorageAuthorityRO) GetBlockedNames(ctx context.Context, req *sapb.GetBlockedNamesRequest) (*sapb.BlockedNames, error) {
+	if req == nil {
+		return nil, errIncompleteRequest
+	}
+
+	var conditions []string
+	var args []interface{}
+	if req.Search != "" {
+		conditions = append(conditions, "name LIKE ?")
+		args = append(args, "%"+likeEscaper.Replace(strings.ToLower(req.Search))+"%")
+	}
+	if !req.IncludeExpired {
+		conditions = append(conditions, "(expires IS NULL OR expires > ?)")
+		args = append(args, ssa.clk.Now())
+	}
+	if !req.IncludeRemoved {
+		conditions = append(conditions, "removedAt IS NULL")
+	}
+	query := "SELECT " + blockedNameFields + " FROM blockedNames"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id ASC"
+
+	var models []blockedNameModel
+	_, err := ssa.dbReadOnlyMap.WithContext(ctx).Select(&models, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]*sapb.BlockedName, 0, len(models))
+	for _, m := range models {
+		names = append(names, blockedNameModelToPB(m))
+	}
+	return &sapb.BlockedNames{Names: names}, nil
+}
+
+func (ssa *SQLStorageAuthority) GetBlockedNames(ctx context.Context, req *sapb.GetBlockedNamesRequest) (*sapb.BlockedNames, error) {
+	return ssa.SQLStorageAuthorityRO.GetBlockedNames(ctx, req)
+}
+
+// GetOrderReviews returns the manual reviews of orders with the given status,
+// or all of them if no status is given, oldest first.
+func (ssa *SQLStorageAuthorityRO) GetOrderReviews(ctx context.Context, req *sapb.GetOrderReviewsRequest) (*sapb.OrderReviews, error) {
+	if req == nil {
+		return nil, errIncompleteRequest
+	}
+	query := "SELECT " + orderReviewFields + " FROM orderReviews"
+	var args []interface{}
+	if req.Status != "" {
+		query += " WHERE status = ?"
+		args = append(args, req.Status)
+	}
+	query += " ORDER BY created ASC, id ASC"
+
+	var models []orderReviewModel
+	_, err := ssa.dbReadOnlyMap.WithContext(ctx).Select(&models, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]*sapb.OrderReview, 0, len(models))
+	for _, m := range models {
+		pb, err := orderReviewModelToPB(m)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, pb)
+	}
+	return &sapb.OrderReviews{Reviews: reviews}, nil
+}
+
+func (ssa *SQLStorageAuthority) GetOrderReviews(ctx context.Context, req *sapb.GetOrderReviewsRequest) (*sapb.OrderReviews, error) {
+	return ssa.SQLStorageAuthorityRO.GetOrderReviews(ctx, req)
+}
+
+// GetTermsAgreement returns the most recent agreement to the terms of service
+// by the given account, or a NotFound error if it has never agreed to any
+// version the SA has recorded.
+func (ssa *SQLStorageAuthorityRO) GetTermsAgreement(ctx context.Context, req *sapb.RegistrationID) (*sapb.TermsAgreement, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	var m termsAgreementModel
+	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+		&m,
+		"SELECT "+termsAgreementFields+` FROM termsAgreements
+		WHERE registrationID = ?
+		ORDER BY agreed DESC, id DESC
+		LIMIT 1`,
+		req.Id,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no terms of service agreement for registration ID %d", req.Id)
+		}
+		return nil, err
+	}
+	return &sapb.TermsAgreement{
+		RegistrationID: m.RegistrationID,
+		Version:        m.Version,
+		Agreed:         timestamppb.New(m.Agreed),
+	}, nil
+}
+
+func (ssa *SQLStorageAuthority) GetTermsAgreement(ctx context.Context, req *sapb.RegistrationID) (*sapb.TermsAgreement, error) {
+	return ssa.SQLStorageAuthorityRO.GetTermsAgreement(ctx, req)
+}
+
 // Health implements the grpc.checker interface.
 func (ssa *SQLStorageAuthorityRO) Health(ctx context.Context) error {
 	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(new(int), "SELECT 1")
@@ -67,6 +67,47 @@ func rewriteConfig(path string, rewrites map[string]string) (string, error) {
 	return tmp.Name(), nil
 }
 
+// backupKey exercises backing up a key from one SoftHSM token to another, and
+// checking that both copies produce matching signatures.
+func backupKey() error {
+	sourceSlot, err := createSlot("backup source key (ecdsa)")
+	if err != nil {
+		return fmt.Errorf("failed to create softhsm2 slot for backup source key: %s", err)
+	}
+	backupSlot, err := createSlot("backup destination key (ecdsa)")
+	if err != nil {
+		return fmt.Errorf("failed to create softhsm2 slot for backup destination key: %s", err)
+	}
+	dir, err := os.MkdirTemp("", "key-backup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	rewrites := map[string]string{
+		"SourceSlotID": sourceSlot,
+		"BackupSlotID": backupSlot,
+		"Dir":          dir,
+	}
+	for _, path := range []string{
+		"test/cert-ceremonies/backup-source-key-ceremony-ecdsa.yaml",
+		"test/cert-ceremonies/backup-wrapping-key-ceremony.yaml",
+		"test/cert-ceremonies/backup-ceremony.yaml",
+		"test/cert-ceremonies/restore-ceremony.yaml",
+		"test/cert-ceremonies/compare-ceremony.yaml",
+	} {
+		tmpPath, err := rewriteConfig(path, rewrites)
+		if err != nil {
+			return err
+		}
+		output, err := exec.Command("bin/ceremony", "-config", tmpPath).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("error running ceremony for %s: %s:\n%s", tmpPath, err, string(output))
+		}
+	}
+	return nil
+}
+
 // genCert is used to run ceremony when we don't actually care about,
 // any of the output and only want to verify it exits cleanly
 func genCert(path string) error {
@@ -167,4 +208,8 @@ func main() {
 	cmd.FailOnError(err, "failed to rewrite ECDSA root CRL config with key ID")
 	err = genCert(ecdsaTmpCRLConfig)
 	cmd.FailOnError(err, "failed to generate ECDSA root CRL")
+
+	// Back up a key between tokens.
+	err = backupKey()
+	cmd.FailOnError(err, "failed to back up key between tokens")
 }
@@ -101,6 +101,18 @@ func (sa SA) FinalizeOrder(ctx context.Context, req *sapb.FinalizeOrderRequest,
 	return sa.Impl.FinalizeOrder(ctx, req)
 }
 
+func (sa SA) SetIssuanceState(ctx context.Context, req *sapb.IssuanceState, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.SetIssuanceState(ctx, req)
+}
+
+func (sa SA) GetIssuanceState(ctx context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*sapb.IssuanceState, error) {
+	return sa.Impl.GetIssuanceState(ctx, req)
+}
+
+func (sa SA) GetStalledIssuances(ctx context.Context, req *sapb.GetStalledIssuancesRequest, _ ...grpc.CallOption) (*sapb.IssuanceStates, error) {
+	return sa.Impl.GetStalledIssuances(ctx, req)
+}
+
 func (sa SA) AddPrecertificate(ctx context.Context, req *sapb.AddCertificateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return sa.Impl.AddPrecertificate(ctx, req)
 }
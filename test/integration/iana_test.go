@@ -0,0 +1,60 @@
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// TestIANARegistriesConsistent checks that each user of the PA, and each of
+// the VAs, load the same IANA registry files in place of the data compiled into
+// Boulder, so that they agree on which names and IP addresses are off limits.
+func TestIANARegistriesConsistent(t *testing.T) {
+	t.Parallel()
+
+	configDir := os.Getenv("BOULDER_CONFIG_DIR")
+	if !strings.Contains(configDir, "test/config-next") {
+		t.Skip("IANA registry files are only configured in config-next")
+	}
+
+	// Each config file, and the section of it holding ianaRegistries.
+	sections := map[string]string{
+		"ra.json":           "pa",
+		"would-issue.json":  "pa",
+		"ca-a.json":         "pa",
+		"ca-b.json":         "pa",
+		"cert-checker.json": "pa",
+		"va.json":           "va",
+		"va-remote-a.json":  "va",
+		"va-remote-b.json":  "va",
+	}
+	var want *cmd.IANARegistriesConfig
+	for file, section := range sections {
+		contents, err := os.ReadFile(path.Join(configDir, file))
+		test.AssertNotError(t, err, "reading config")
+		var config map[string]struct {
+			IANARegistries *cmd.IANARegistriesConfig
+		}
+		err = json.Unmarshal(contents, &config)
+		test.AssertNotError(t, err, "parsing config")
+
+		got := config[section].IANARegistries
+		test.Assert(t, got != nil, file+" has no ianaRegistries")
+		test.Assert(t, got.IPv4SpecialRegistryFile != "", file+" has no ipv4SpecialRegistryFile")
+		test.Assert(t, got.IPv6SpecialRegistryFile != "", file+" has no ipv6SpecialRegistryFile")
+		test.Assert(t, got.SpecialUseDomainsFile != "", file+" has no specialUseDomainsFile")
+		test.AssertNotError(t, got.Load(), "loading registries for "+file)
+		if want == nil {
+			want = got
+			continue
+		}
+		test.AssertDeepEquals(t, *got, *want)
+	}
+}
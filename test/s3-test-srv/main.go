@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,6 +20,9 @@ import (
 type s3TestSrv struct {
 	sync.RWMutex
 	allSerials map[string]revocation.Reason
+	// objects holds uploads other than CRLs, such as validation evidence,
+	// keyed by path.
+	objects map[string][]byte
 }
 
 func (srv *s3TestSrv) handleUpload(w http.ResponseWriter, r *http.Request) {
@@ -28,6 +33,15 @@ func (srv *s3TestSrv) handleUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasSuffix(r.URL.Path, ".json") {
+		srv.Lock()
+		srv.objects[r.URL.Path] = body
+		srv.Unlock()
+		w.WriteHeader(200)
+		w.Write([]byte("{}"))
+		return
+	}
+
 	crl, err := crl_x509.ParseRevocationList(body)
 	if err != nil {
 		w.WriteHeader(500)
@@ -58,6 +72,7 @@ func (srv *s3TestSrv) handleClear(w http.ResponseWriter, r *http.Request) {
 	srv.Lock()
 	defer srv.Unlock()
 	srv.allSerials = make(map[string]revocation.Reason)
+	srv.objects = make(map[string][]byte)
 }
 
 func (srv *s3TestSrv) handleQuery(w http.ResponseWriter, r *http.Request) {
@@ -84,15 +99,41 @@ func (srv *s3TestSrv) handleQuery(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(fmt.Sprintf("%d", reason)))
 }
 
+func (srv *s3TestSrv) handleObjects(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(405)
+		return
+	}
+
+	// List the paths of the uploaded objects with the given prefix.
+	prefix := r.URL.Query().Get("prefix")
+	srv.RLock()
+	defer srv.RUnlock()
+	var paths []string
+	for path := range srv.objects {
+		if strings.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	w.WriteHeader(200)
+	w.Write([]byte(strings.Join(paths, "\n")))
+}
+
 func main() {
 	listenAddr := flag.String("listen", "0.0.0.0:7890", "Address to listen on")
 	flag.Parse()
 
-	srv := s3TestSrv{allSerials: make(map[string]revocation.Reason)}
+	srv := s3TestSrv{
+		allSerials: make(map[string]revocation.Reason),
+		objects:    make(map[string][]byte),
+	}
 
 	http.HandleFunc("/", srv.handleUpload)
 	http.HandleFunc("/clear", srv.handleClear)
 	http.HandleFunc("/query", srv.handleQuery)
+	http.HandleFunc("/objects", srv.handleObjects)
 
 	s := http.Server{
 		ReadTimeout: 30 * time.Second,
@@ -115,6 +115,15 @@ SERVICES = (
         None),
 )
 
+if CONFIG_NEXT:
+    # The issuanceStates table it relies on only exists in db-next.
+    SERVICES += (
+        Service('issuance-resumer',
+            8022, None,
+            ('./bin/boulder', 'issuance-resumer', '--config', os.path.join(config_dir, 'issuance-resumer.json')),
+            ('boulder-ra-1', 'boulder-ra-2', 'boulder-sa-1', 'boulder-sa-2')),
+    )
+
 def _service_toposort(services):
     """Yields Service objects in topologically sorted order.
 
@@ -2,20 +2,28 @@ package va
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/rand"
 	"net/url"
 	"regexp"
 	"strings"
 	"sync"
 
+	"github.com/letsencrypt/boulder/bdns"
+	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/va/evidence"
+	"github.com/letsencrypt/boulder/va/iodef"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type caaParams struct {
@@ -41,52 +49,319 @@ func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsC
 		accountURIID:     req.AccountURIID,
 		validationMethod: validationMethod,
 	}
-	if prob := va.checkCAA(ctx, acmeID, params); prob != nil {
-		detail := fmt.Sprintf("While processing CAA for %s: %s", req.Domain, prob.Detail)
+	remoteResults := va.startRemoteCAACheck(ctx, req)
+	// IsCAAValid is how the RA rechecks CAA before issuance, and how remote
+	// VAs check it on behalf of the primary, so its refusals aren't reported:
+	// they would duplicate the report made when the primary VA's validation
+	// was refused.
+	prob, _ := va.checkCAA(ctx, acmeID, params)
+	if prob != nil {
+		prob.Detail = fmt.Sprintf("While processing CAA for %s: %s", req.Domain, prob.Detail)
+	}
+	prob = va.finishRemoteCAACheck(req, prob, remoteResults)
+	if prob != nil {
 		return &vapb.IsCAAValidResponse{
 			Problem: &corepb.ProblemDetails{
 				ProblemType: string(prob.Type),
-				Detail:      replaceInvalidUTF8([]byte(detail)),
+				Detail:      replaceInvalidUTF8([]byte(prob.Detail)),
 			},
 		}, nil
 	}
 	return &vapb.IsCAAValidResponse{}, nil
 }
 
+// startRemoteCAACheck asks each of the configured remote VAs to perform the
+// CAA check described by req, if either the EnforceMultiCAA or the
+// MultiCAAFullResults feature is enabled. It returns the channel on which the
+// remote results will be delivered, or nil if no remote checks were started.
+func (va *ValidationAuthorityImpl) startRemoteCAACheck(ctx context.Context, req *vapb.IsCAAValidRequest) chan *remoteValidationResult {
+	if len(va.remoteVAs) == 0 {
+		return nil
+	}
+	if !features.Enabled(features.EnforceMultiCAA) && !features.Enabled(features.MultiCAAFullResults) {
+		return nil
+	}
+	results := make(chan *remoteValidationResult, len(va.remoteVAs))
+	go va.performRemoteCAACheck(ctx, req, results)
+	return results
+}
+
+// finishRemoteCAACheck combines the result of the primary VA's CAA check with
+// the results from the remote VAs, if any were started by
+// startRemoteCAACheck. A primary failure is always returned as-is. Otherwise,
+// if EnforceMultiCAA is enabled the remote results must meet the configured
+// quorum, and if it is not they are only collected (and their differentials
+// logged) in the background.
+func (va *ValidationAuthorityImpl) finishRemoteCAACheck(
+	req *vapb.IsCAAValidRequest,
+	primaryResult *probs.ProblemDetails,
+	remoteResults chan *remoteValidationResult) *probs.ProblemDetails {
+	if remoteResults == nil || primaryResult != nil {
+		return primaryResult
+	}
+
+	if !features.Enabled(features.EnforceMultiCAA) {
+		go func() {
+			_ = va.processRemoteCAAResults(req, primaryResult, remoteResults)
+		}()
+		return nil
+	}
+
+	remoteProb := va.processRemoteCAAResults(req, primaryResult, remoteResults)
+	if remoteProb != nil {
+		va.log.Infof("CAA check failed due to remote failures: identifier=%v err=%s",
+			req.Domain, remoteProb)
+		va.metrics.remoteCAACheckFailures.Inc()
+	}
+	return remoteProb
+}
+
+// performRemoteCAACheck calls `IsCAAValid` for each of the configured remoteVAs
+// in a random order, in separate goroutines, and writes each of their results
+// to the provided `results` chan, which should have a size equal to the number
+// of remote VAs. Errors are converted to problems the same way as in
+// `performRemoteValidation`.
+func (va *ValidationAuthorityImpl) performRemoteCAACheck(
+	ctx context.Context,
+	req *vapb.IsCAAValidRequest,
+	results chan *remoteValidationResult) {
+	for _, i := range rand.Perm(len(va.remoteVAs)) {
+		remoteVA := va.remoteVAs[i]
+		go func(rva RemoteVA) {
+			result := &remoteValidationResult{
+				VAHostname: rva.Address,
+			}
+			res, err := rva.IsCAAValid(ctx, req)
+			if err != nil && canceled.Is(err) {
+				// As in performRemoteValidation, a cancellation just means we no
+				// longer care about this result, so don't log it.
+				result.Problem = probs.ServerInternal("Remote IsCAAValid RPC canceled")
+			} else if err != nil {
+				va.log.Errf("Remote VA %q.IsCAAValid failed: %s", rva.Address, err)
+				result.Problem = probs.ServerInternal("Remote IsCAAValid RPC failed")
+			} else if res.Problem != nil {
+				prob, err := bgrpc.PBToProblemDetails(res.Problem)
+				if err != nil {
+					va.log.Infof("Remote VA %q.IsCAAValid returned malformed problem: %s", rva.Address, err)
+					result.Problem = probs.ServerInternal(
+						fmt.Sprintf("Remote IsCAAValid RPC returned malformed result: %s", err))
+				} else {
+					va.log.Infof("Remote VA %q.IsCAAValid returned problem: %s", rva.Address, prob)
+					result.Problem = prob
+				}
+			}
+			results <- result
+		}(remoteVA)
+	}
+}
+
+// processRemoteCAAResults evaluates the primary VA's CAA result and a channel
+// of remote VA results to produce a single overall CAA result, based on the
+// configured `maxRemoteCAAFailures`. It mirrors `processRemoteResults`: if the
+// `MultiCAAFullResults` feature is enabled it waits for every remote VA and
+// logs the differentials with `logRemoteCAADifferentials`, otherwise it returns
+// as soon as the success or failure threshold is met.
+func (va *ValidationAuthorityImpl) processRemoteCAAResults(
+	req *vapb.IsCAAValidRequest,
+	primaryResult *probs.ProblemDetails,
+	remoteResultsChan chan *remoteValidationResult) *probs.ProblemDetails {
+
+	state := "failure"
+	start := va.clk.Now()
+
+	defer func() {
+		va.metrics.remoteCAACheckTime.With(prometheus.Labels{
+			"result": state,
+		}).Observe(va.clk.Since(start).Seconds())
+	}()
+
+	numRemoteVAs := len(va.remoteVAs)
+	required := numRemoteVAs - va.maxRemoteCAAFailures
+	good := 0
+	bad := 0
+
+	var remoteResults []*remoteValidationResult
+	var firstProb *probs.ProblemDetails
+	// As in processRemoteResults, we rely on gRPC honoring the context deadline
+	// to prevent this from blocking indefinitely.
+	for result := range remoteResultsChan {
+		remoteResults = append(remoteResults, result)
+		if result.Problem == nil {
+			good++
+		} else {
+			bad++
+		}
+
+		if firstProb == nil && result.Problem != nil {
+			firstProb = result.Problem
+		}
+
+		if !features.Enabled(features.MultiCAAFullResults) {
+			if good >= required {
+				state = "success"
+				return nil
+			} else if bad > va.maxRemoteCAAFailures {
+				modifiedProblem := *firstProb
+				modifiedProblem.Detail = "During secondary CAA check: " + firstProb.Detail
+				return &modifiedProblem
+			}
+		}
+
+		if len(remoteResults) == numRemoteVAs {
+			break
+		}
+	}
+
+	va.logRemoteCAADifferentials(req, primaryResult, remoteResults)
+
+	if good >= required {
+		state = "success"
+		return nil
+	} else if bad > va.maxRemoteCAAFailures {
+		modifiedProblem := *firstProb
+		modifiedProblem.Detail = "During secondary CAA check: " + firstProb.Detail
+		return &modifiedProblem
+	}
+
+	// This condition should not occur - it indicates the good/bad counts didn't
+	// meet either the required threshold or the maxRemoteCAAFailures threshold.
+	return probs.ServerInternal("Too few remote IsCAAValid RPC results")
+}
+
+// logRemoteCAADifferentials is called by `processRemoteCAAResults` when the
+// `MultiCAAFullResults` feature flag is enabled. Like
+// `logRemoteValidationDifferentials`, it produces a JSON log line containing
+// the primary VA result and the results each remote VA returned, unless they
+// all agree.
+func (va *ValidationAuthorityImpl) logRemoteCAADifferentials(
+	req *vapb.IsCAAValidRequest,
+	primaryResult *probs.ProblemDetails,
+	remoteResults []*remoteValidationResult) {
+
+	var successes []*remoteValidationResult
+	var failures []*remoteValidationResult
+
+	allEqual := true
+	for _, result := range remoteResults {
+		if result.Problem != primaryResult {
+			allEqual = false
+		}
+		if result.Problem == nil {
+			successes = append(successes, result)
+		} else {
+			failures = append(failures, result)
+		}
+	}
+	if allEqual {
+		return
+	}
+
+	// If the primary result was OK and there were more failures than the allowed
+	// threshold increment a stat that indicates this overall CAA check will have
+	// failed if features.EnforceMultiCAA is enabled.
+	if primaryResult == nil && len(failures) > va.maxRemoteCAAFailures {
+		va.metrics.prospectiveRemoteCAACheckFailures.Inc()
+	}
+
+	logOb := struct {
+		Domain           string
+		AccountID        int64
+		ValidationMethod string
+		PrimaryResult    *probs.ProblemDetails
+		RemoteSuccesses  int
+		RemoteFailures   []*remoteValidationResult
+	}{
+		Domain:           req.Domain,
+		AccountID:        req.AccountURIID,
+		ValidationMethod: req.ValidationMethod,
+		PrimaryResult:    primaryResult,
+		RemoteSuccesses:  len(successes),
+		RemoteFailures:   failures,
+	}
+
+	logJSON, err := json.Marshal(logOb)
+	if err != nil {
+		va.log.Warningf("Could not marshal log object in "+
+			"logRemoteCAADifferentials: %s", err)
+		return
+	}
+
+	va.log.Infof("remoteCAADifferentials JSON=%s", string(logJSON))
+}
+
+// caaIncident is a refusal of issuance by CAA records, to be reported to the
+// iodef URLs published alongside them.
+type caaIncident struct {
+	incident iodef.Incident
+	urls     []string
+}
+
 // checkCAA performs a CAA lookup & validation for the provided identifier. If
-// the CAA lookup & validation fail a problem is returned.
+// the CAA lookup & validation fail a problem is returned, along with the
+// incident to report if the CAA records which forbade issuance have iodef
+// URLs. It doesn't report the incident itself: only the primary VA's final
+// decision during validation is reported, by reportCAAIncident.
 func (va *ValidationAuthorityImpl) checkCAA(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
-	params *caaParams) *probs.ProblemDetails {
+	params *caaParams) (*probs.ProblemDetails, *caaIncident) {
 	if params == nil || params.validationMethod == "" || params.accountURIID == 0 {
-		return probs.ServerInternal("expected validationMethod or accountURIID not provided to checkCAA")
+		return probs.ServerInternal("expected validationMethod or accountURIID not provided to checkCAA"), nil
 	}
 
-	foundAt, valid, response, err := va.checkCAARecords(ctx, identifier, params)
+	foundAt, valid, caaSet, chain, err := va.checkCAARecords(ctx, identifier, params)
 	if err != nil {
-		return probs.DNS(err.Error())
+		return probs.DNS(err.Error()), nil
+	}
+	response := ""
+	if caaSet != nil {
+		response = caaSet.dig
 	}
 
-	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %d, Challenge: %s, Valid for issuance: %t, Found at: %q] Response=%q",
-		identifier.Value, foundAt != "", params.accountURIID, params.validationMethod, valid, foundAt, response)
+	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %d, Challenge: %s, Valid for issuance: %t, Found at: %q, CNAME chain: %q] Response=%q",
+		identifier.Value, foundAt != "", params.accountURIID, params.validationMethod, valid, foundAt, chain, response)
 	if !valid {
-		return probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", foundAt))
+		var incident *caaIncident
+		if caaSet != nil && len(caaSet.iodef) > 0 {
+			incident = &caaIncident{
+				incident: iodef.Incident{
+					Identifier:       identifier.Value,
+					FoundAt:          foundAt,
+					Records:          caaSet.records(),
+					AccountID:        params.accountURIID,
+					ValidationMethod: string(params.validationMethod),
+					Time:             va.clk.Now(),
+				},
+				urls: caaSet.iodef,
+			}
+		}
+		return probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", foundAt)), incident
 	}
-	return nil
+	return nil, nil
+}
+
+// reportCAAIncident sends incident to its iodef URLs, if reports are enabled.
+func (va *ValidationAuthorityImpl) reportCAAIncident(incident *caaIncident) {
+	if va.iodefReporter == nil || incident == nil {
+		return
+	}
+	va.iodefReporter.Report(incident.incident, incident.urls)
 }
 
 // caaResult represents the result of querying CAA for a single name. It breaks
 // the CAA resource records down by category, keeping only the issue and
 // issuewild records. It also records whether any unrecognized RRs were marked
-// critical, and stores the raw response text for logging and debugging.
+// critical and the URLs of any iodef records, and stores the raw response text
+// and the aliases followed to reach the records for logging and debugging.
 type caaResult struct {
 	name            string
 	present         bool
 	issue           []*dns.CAA
 	issuewild       []*dns.CAA
 	criticalUnknown bool
+	iodef           []string
 	dig             string
+	chain           []string
 	err             error
 }
 
@@ -105,10 +380,9 @@ func filterCAA(rrs []*dns.CAA) ([]*dns.CAA, []*dns.CAA, bool) {
 		case "issuewild":
 			issuewild = append(issuewild, caaRecord)
 		case "iodef":
-			// We support the iodef property tag insofar as we recognize it, but we
-			// never choose to send notifications to the specified addresses. So we
-			// do not store the contents of the property tag, but also avoid setting
-			// the criticalUnknown bit if there are critical iodef tags.
+			// We support the iodef property tag, so we avoid setting the
+			// criticalUnknown bit if there are critical iodef tags. Its contents
+			// are picked out separately by iodefURLs.
 			continue
 		default:
 			// The critical flag is the bit with significance 128. However, many CAA
@@ -126,6 +400,28 @@ func filterCAA(rrs []*dns.CAA) ([]*dns.CAA, []*dns.CAA, bool) {
 	return issue, issuewild, criticalUnknown
 }
 
+// iodefURLs returns the values of the iodef records in a set of CAA resource
+// records, which are URLs at which incident reports may be submitted.
+func iodefURLs(rrs []*dns.CAA) []string {
+	var urls []string
+	for _, caaRecord := range rrs {
+		if strings.ToLower(caaRecord.Tag) == "iodef" {
+			urls = append(urls, caaRecord.Value)
+		}
+	}
+	return urls
+}
+
+// records returns the issue and issuewild records of the result in
+// presentation format.
+func (r *caaResult) records() []string {
+	var out []string
+	for _, rr := range append(append([]*dns.CAA{}, r.issue...), r.issuewild...) {
+		out = append(out, rr.String())
+	}
+	return out
+}
+
 // parallelCAALookup makes parallel requests for the target name and all parent
 // names. It returns a slice of CAA results, with the results from querying the
 // FQDN in the zeroth index, and the results from querying the TLD in the last
@@ -141,11 +437,15 @@ func (va *ValidationAuthorityImpl) parallelCAALookup(ctx context.Context, name s
 		go func(name string, r *caaResult) {
 			r.name = name
 			var records []*dns.CAA
-			records, r.dig, r.err = va.dnsClient.LookupCAA(ctx, name)
+			var info bdns.LookupInfo
+			records, r.dig, info, r.err = va.dnsClient.LookupCAA(ctx, name)
+			evidence.FromContext(ctx).AddDNS(info.Responses...)
+			r.chain = info.Chain
 			if len(records) > 0 {
 				r.present = true
 			}
 			r.issue, r.issuewild, r.criticalUnknown = filterCAA(records)
+			r.iodef = iodefURLs(records)
 			wg.Done()
 		}(strings.Join(labels[i:], "."), &results[i])
 	}
@@ -175,10 +475,12 @@ func selectCAA(rrs []caaResult) (*caaResult, error) {
 // first CAA RRSet found by traversing upwards from the FQDN by removing the
 // leftmost label. It returns nil if no RRSet is found on any parent of the
 // given FQDN. The returned result also contains the raw CAA response, and an
-// error if one is encountered while querying or parsing the records.
+// error if one is encountered while querying or parsing the records. The CNAME
+// chain followed to reach the RRSet, or if there is none the chain followed
+// from the FQDN itself, is returned too.
 //
 // [1]: https://datatracker.ietf.org/doc/html/rfc8659#name-relevant-resource-record-se
-func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string) (*caaResult, error) {
+func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string) (*caaResult, []string, error) {
 	hostname = strings.TrimRight(hostname, ".")
 
 	// See RFC 6844 "Certification Authority Processing" for pseudocode, as
@@ -191,23 +493,30 @@ func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string)
 	//
 	// We depend on our resolver to snap CNAME and DNAME records.
 	results := va.parallelCAALookup(ctx, hostname)
-	return selectCAA(results)
+	caaSet, err := selectCAA(results)
+	if err != nil {
+		return nil, nil, err
+	}
+	if caaSet != nil {
+		return caaSet, caaSet.chain, nil
+	}
+	return nil, results[0].chain, nil
 }
 
 // checkCAARecords fetches the CAA records for the given identifier and then
 // validates them. If the identifier argument's value has a wildcard prefix then
 // the prefix is stripped and validation will be performed against the base
 // domain, honouring any issueWild CAA records encountered as appropriate.
-// checkCAARecords returns four values: the first is a string indicating at
+// checkCAARecords returns five values: the first is a string indicating at
 // which name (i.e. FQDN or parent thereof) CAA records were found, if any. The
 // second is a bool indicating whether issuance for the identifier is valid. The
-// unmodified *dns.CAA records that were processed/filtered are returned as the
-// third argument. Any  errors encountered are returned as the fourth return
-// value (or nil).
+// relevant CAA RRSet, if any, is returned as the third argument. The CNAME
+// chain followed to find the records is the fourth, and any errors encountered
+// are returned as the fifth return value (or nil).
 func (va *ValidationAuthorityImpl) checkCAARecords(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
-	params *caaParams) (string, bool, string, error) {
+	params *caaParams) (string, bool, *caaResult, []string, error) {
 	hostname := strings.ToLower(identifier.Value)
 	// If this is a wildcard name, remove the prefix
 	var wildcard bool
@@ -215,16 +524,12 @@ func (va *ValidationAuthorityImpl) checkCAARecords(
 		hostname = strings.TrimPrefix(identifier.Value, `*.`)
 		wildcard = true
 	}
-	caaSet, err := va.getCAA(ctx, hostname)
+	caaSet, chain, err := va.getCAA(ctx, hostname)
 	if err != nil {
-		return "", false, "", err
-	}
-	raw := ""
-	if caaSet != nil {
-		raw = caaSet.dig
+		return "", false, nil, nil, err
 	}
 	valid, foundAt := va.validateCAA(caaSet, wildcard, params)
-	return foundAt, valid, raw, nil
+	return foundAt, valid, caaSet, chain, nil
 }
 
 // validateCAA checks a provided *caaResult. When the wildcard argument is true
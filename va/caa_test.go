@@ -7,16 +7,21 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
 	"github.com/letsencrypt/boulder/probs"
 	"github.com/letsencrypt/boulder/test"
 
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/va/iodef"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 )
 
@@ -24,21 +29,21 @@ import (
 // answers for CAA queries.
 type caaMockDNS struct{}
 
-func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, error) {
-	return nil, nil
+func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, bdns.LookupInfo, error) {
+	return nil, bdns.LookupInfo{}, nil
 }
 
-func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
+func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.LookupInfo, error) {
 	ip := net.ParseIP("127.0.0.1")
-	return []net.IP{ip}, nil
+	return []net.IP{ip}, bdns.LookupInfo{}, nil
 }
 
-func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, string, error) {
+func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, string, bdns.LookupInfo, error) {
 	var results []*dns.CAA
 	var record dns.CAA
 	switch strings.TrimRight(domain, ".") {
 	case "caa-timeout.com":
-		return nil, "", fmt.Errorf("error")
+		return nil, "", bdns.LookupInfo{}, fmt.Errorf("error")
 	case "reserved.com":
 		record.Tag = "issue"
 		record.Value = "ca.com"
@@ -52,15 +57,19 @@ func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA,
 		record.Tag = "issue"
 		record.Value = "ca.com"
 		results = append(results, &record)
+	case "iodef.com":
+		record.Tag = "issue"
+		record.Value = "ca.com"
+		results = append(results, &record, &dns.CAA{Tag: "iodef", Value: "mailto:security@iodef.com"})
 	case "present.com", "present.servfail.com":
 		record.Tag = "issue"
 		record.Value = "letsencrypt.org"
 		results = append(results, &record)
 	case "com":
 		// com has no CAA records.
-		return nil, "", nil
+		return nil, "", bdns.LookupInfo{}, nil
 	case "servfail.com", "servfail.present.com":
-		return results, "", fmt.Errorf("SERVFAIL")
+		return results, "", bdns.LookupInfo{}, fmt.Errorf("SERVFAIL")
 	case "multi-crit-present.com":
 		record.Flag = 1
 		record.Tag = "issue"
@@ -182,7 +191,63 @@ func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA,
 	if len(results) > 0 {
 		response = "foo"
 	}
-	return results, response, nil
+	return results, response, bdns.LookupInfo{}, nil
+}
+
+func TestCAAIodefReport(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.dnsClient = caaMockDNS{}
+	mailer := &mocks.Mailer{}
+	va.iodefReporter = iodef.New("letsencrypt.org", mailer, nil, time.Hour, 10, 1,
+		va.clk, metrics.NoopRegisterer, blog.NewMock())
+
+	params := &caaParams{
+		accountURIID:     12345,
+		validationMethod: core.ChallengeTypeHTTP01,
+	}
+
+	// Permitted issuance isn't reported.
+	prob, incident := va.checkCAA(ctx, identifier.DNSIdentifier("present.com"), params)
+	test.Assert(t, prob == nil, "CAA check failed")
+	test.Assert(t, incident == nil, "incident returned for permitted issuance")
+
+	prob, incident = va.checkCAA(ctx, identifier.DNSIdentifier("www.iodef.com"), params)
+	test.Assert(t, prob != nil, "CAA check succeeded")
+	test.AssertEquals(t, prob.Type, probs.CAAProblem)
+	test.Assert(t, incident != nil, "no incident returned for refused issuance")
+
+	// Neither checkCAA nor the rechecks made through IsCAAValid report the
+	// incident themselves.
+	resp, err := va.IsCAAValid(ctx, &vapb.IsCAAValidRequest{
+		Domain:           "www.iodef.com",
+		ValidationMethod: string(core.ChallengeTypeHTTP01),
+		AccountURIID:     12345,
+	})
+	test.AssertNotError(t, err, "IsCAAValid failed")
+	test.Assert(t, resp.Problem != nil, "IsCAAValid succeeded")
+	time.Sleep(100 * time.Millisecond)
+	mailer.Lock()
+	test.AssertEquals(t, len(mailer.Messages), 0)
+	mailer.Unlock()
+
+	va.reportCAAIncident(incident)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mailer.Lock()
+		sent := len(mailer.Messages)
+		mailer.Unlock()
+		if sent > 0 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	mailer.Lock()
+	defer mailer.Unlock()
+	test.AssertEquals(t, len(mailer.Messages), 1)
+	test.AssertEquals(t, mailer.Messages[0].To, "security@iodef.com")
+	test.AssertEquals(t, mailer.Messages[0].Subject, "CAA incident report for www.iodef.com")
+	test.AssertContains(t, mailer.Messages[0].Body, "<AdditionalData dtype=\"integer\" meaning=\"acme-account-id\">12345</AdditionalData>")
 }
 
 func TestCAATimeout(t *testing.T) {
@@ -194,7 +259,7 @@ func TestCAATimeout(t *testing.T) {
 		validationMethod: core.ChallengeTypeHTTP01,
 	}
 
-	err := va.checkCAA(ctx, identifier.DNSIdentifier("caa-timeout.com"), params)
+	err, _ := va.checkCAA(ctx, identifier.DNSIdentifier("caa-timeout.com"), params)
 	if err.Type != probs.DNSProblem {
 		t.Errorf("Expected timeout error type %s, got %s", probs.DNSProblem, err.Type)
 	}
@@ -421,7 +486,7 @@ func TestCAAChecking(t *testing.T) {
 		mockLog.Clear()
 		t.Run(caaTest.Name, func(t *testing.T) {
 			ident := identifier.DNSIdentifier(caaTest.Domain)
-			foundAt, valid, _, err := va.checkCAARecords(ctx, ident, params)
+			foundAt, valid, _, _, err := va.checkCAARecords(ctx, ident, params)
 			if err != nil {
 				t.Errorf("checkCAARecords error for %s: %s", caaTest.Domain, err)
 			}
@@ -439,41 +504,41 @@ func TestCAAChecking(t *testing.T) {
 
 	// present-dns-only.com should now be valid even with http-01
 	ident := identifier.DNSIdentifier("present-dns-only.com")
-	foundAt, valid, _, err := va.checkCAARecords(ctx, ident, params)
+	foundAt, valid, _, _, err := va.checkCAARecords(ctx, ident, params)
 	test.AssertNotError(t, err, "present-dns-only.com")
 	test.AssertEquals(t, foundAt, "present-dns-only.com")
 	test.Assert(t, valid, "Valid should be true")
 
 	// present-incorrect-accounturi.com should now be also be valid
 	ident = identifier.DNSIdentifier("present-incorrect-accounturi.com")
-	foundAt, valid, _, err = va.checkCAARecords(ctx, ident, params)
+	foundAt, valid, _, _, err = va.checkCAARecords(ctx, ident, params)
 	test.AssertNotError(t, err, "present-incorrect-accounturi.com")
 	test.AssertEquals(t, foundAt, "present-incorrect-accounturi.com")
 	test.Assert(t, valid, "Valid should be true")
 
 	// nil params should be valid, too
-	foundAt, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	foundAt, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertNotError(t, err, "present-incorrect-accounturi.com")
 	test.AssertEquals(t, foundAt, "present-incorrect-accounturi.com")
 	test.Assert(t, valid, "Valid should be true")
 
 	ident.Value = "servfail.com"
-	foundAt, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	foundAt, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertError(t, err, "servfail.com")
 	test.AssertEquals(t, foundAt, "")
 	test.Assert(t, !valid, "Valid should be false")
 
-	if _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
+	if _, _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
 		t.Errorf("Should have returned error on CAA lookup, but did not: %s", ident.Value)
 	}
 
 	ident.Value = "servfail.present.com"
-	foundAt, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	foundAt, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertError(t, err, "servfail.present.com")
 	test.AssertEquals(t, foundAt, "")
 	test.Assert(t, !valid, "Valid should be false")
 
-	if _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
+	if _, _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
 		t.Errorf("Should have returned error on CAA lookup, but did not: %s", ident.Value)
 	}
 }
@@ -493,55 +558,55 @@ func TestCAALogging(t *testing.T) {
 			Domain:          "reserved.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for reserved.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"reserved.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for reserved.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"reserved.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "reserved.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeDNS01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for reserved.com, [Present: true, Account ID: 12345, Challenge: dns-01, Valid for issuance: false, Found at: \"reserved.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for reserved.com, [Present: true, Account ID: 12345, Challenge: dns-01, Valid for issuance: false, Found at: \"reserved.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "mixedcase.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for mixedcase.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"mixedcase.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for mixedcase.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"mixedcase.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "critical.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for critical.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"critical.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for critical.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"critical.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "present.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "not.here.but.still.present.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for not.here.but.still.present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for not.here.but.still.present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "multi-crit-present.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for multi-crit-present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"multi-crit-present.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for multi-crit-present.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"multi-crit-present.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "present-with-parameter.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for present-with-parameter.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present-with-parameter.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for present-with-parameter.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: true, Found at: \"present-with-parameter.com\", CNAME chain: []] Response=\"foo\"",
 		},
 		{
 			Domain:          "satisfiable-wildcard-override.com",
 			AccountURIID:    12345,
 			ChallengeType:   core.ChallengeTypeHTTP01,
-			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for satisfiable-wildcard-override.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"satisfiable-wildcard-override.com\"] Response=\"foo\"",
+			ExpectedLogline: "INFO: [AUDIT] Checked CAA records for satisfiable-wildcard-override.com, [Present: true, Account ID: 12345, Challenge: http-01, Valid for issuance: false, Found at: \"satisfiable-wildcard-override.com\", CNAME chain: []] Response=\"foo\"",
 		},
 	}
 
@@ -554,7 +619,7 @@ func TestCAALogging(t *testing.T) {
 				accountURIID:     tc.AccountURIID,
 				validationMethod: tc.ChallengeType,
 			}
-			_ = va.checkCAA(ctx, identifier.ACMEIdentifier{Type: identifier.DNS, Value: tc.Domain}, params)
+			_, _ = va.checkCAA(ctx, identifier.ACMEIdentifier{Type: identifier.DNS, Value: tc.Domain}, params)
 
 			caaLogLines := mockLog.GetAllMatching(`Checked CAA records for`)
 			if len(caaLogLines) != 1 {
@@ -637,6 +702,141 @@ func TestCAAFailure(t *testing.T) {
 	test.AssertEquals(t, prob.Type, probs.CAAProblem)
 }
 
+// caaHijackedDNS is a caaMockDNS which returns no CAA records at all, as a
+// resolver whose responses had been tampered with might.
+type caaHijackedDNS struct {
+	caaMockDNS
+}
+
+func (mock caaHijackedDNS) LookupCAA(_ context.Context, _ string) ([]*dns.CAA, string, bdns.LookupInfo, error) {
+	return nil, "", bdns.LookupInfo{}, nil
+}
+
+// setupRemoteCAA returns a RemoteVA backed by a local VA using the given DNS
+// client for its CAA lookups.
+func setupRemoteCAA(dnsClient bdns.Client, name string) RemoteVA {
+	innerVA, _ := setup(nil, 0, name, nil)
+	innerVA.dnsClient = dnsClient
+	lrva := &localRemoteVA{remote: *innerVA}
+	return RemoteVA{RemoteClients{lrva, lrva}, name}
+}
+
+func TestMultiCAA(t *testing.T) {
+	remoteOK := setupRemoteCAA(caaMockDNS{}, "remote 1")
+	remoteOK2 := setupRemoteCAA(caaMockDNS{}, "remote 2")
+	remoteHijacked := setupRemoteCAA(caaHijackedDNS{}, "remote 3")
+	remoteBroken := RemoteVA{RemoteClients{&brokenRemoteVA{}, &brokenRemoteVA{}}, "broken"}
+
+	secondaryCAAFailure := "During secondary CAA check: While processing CAA for reserved.com: " +
+		"CAA record for reserved.com prevents issuance"
+
+	testCases := []struct {
+		Name              string
+		RemoteVAs         []RemoteVA
+		MaxRemoteFailures int
+		Features          map[string]bool
+		ExpectedDetail    string
+		ExpectedLog       string
+	}{
+		{
+			// Without either feature, the remote VAs aren't consulted and the
+			// hijacked primary VA's result stands.
+			Name:      "Remote VAs see CAA, no features",
+			RemoteVAs: []RemoteVA{remoteOK, remoteOK2},
+		},
+		{
+			// When only logging, the result is unchanged.
+			Name:      "Remote VAs see CAA, full results only",
+			RemoteVAs: []RemoteVA{remoteOK, remoteOK2},
+			Features:  map[string]bool{"MultiCAAFullResults": true},
+		},
+		{
+			Name:           "Remote VAs see CAA, enforce multi CAA",
+			RemoteVAs:      []RemoteVA{remoteOK, remoteOK2},
+			Features:       map[string]bool{"EnforceMultiCAA": true},
+			ExpectedDetail: secondaryCAAFailure,
+		},
+		{
+			// One remote VA is hijacked too, but one failure is allowed.
+			Name:              "One remote VA sees CAA, one failure allowed, enforce multi CAA",
+			RemoteVAs:         []RemoteVA{remoteOK, remoteHijacked},
+			MaxRemoteFailures: 1,
+			Features:          map[string]bool{"EnforceMultiCAA": true},
+		},
+		{
+			Name:           "One remote VA sees CAA, no failures allowed, enforce multi CAA",
+			RemoteVAs:      []RemoteVA{remoteOK, remoteHijacked},
+			Features:       map[string]bool{"EnforceMultiCAA": true, "MultiCAAFullResults": true},
+			ExpectedDetail: secondaryCAAFailure,
+			ExpectedLog:    `INFO: remoteCAADifferentials JSON={"Domain":"reserved.com","AccountID":12345,"ValidationMethod":"http-01","PrimaryResult":null,"RemoteSuccesses":1,"RemoteFailures":[{"VAHostname":"remote 1","Problem":{"type":"caa","detail":"While processing CAA for reserved.com: CAA record for reserved.com prevents issuance"}}]}`,
+		},
+		{
+			Name:           "Remote VA broken, enforce multi CAA",
+			RemoteVAs:      []RemoteVA{remoteHijacked, remoteBroken},
+			Features:       map[string]bool{"EnforceMultiCAA": true},
+			ExpectedDetail: "During secondary CAA check: Remote IsCAAValid RPC failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			va, mockLog := setup(nil, 0, "", tc.RemoteVAs)
+			va.dnsClient = caaHijackedDNS{}
+			va.maxRemoteCAAFailures = tc.MaxRemoteFailures
+
+			err := features.Set(tc.Features)
+			test.AssertNotError(t, err, "setting feature flags")
+			defer features.Reset()
+
+			resp, err := va.IsCAAValid(ctx, &vapb.IsCAAValidRequest{
+				Domain:           "reserved.com",
+				ValidationMethod: string(core.ChallengeTypeHTTP01),
+				AccountURIID:     12345,
+			})
+			test.AssertNotError(t, err, "IsCAAValid failed")
+			if tc.ExpectedDetail == "" {
+				test.Assert(t, resp.Problem == nil, fmt.Sprintf("unexpected problem: %v", resp.Problem))
+			} else {
+				test.AssertNotNil(t, resp.Problem, "expected a problem")
+				test.AssertEquals(t, resp.Problem.Detail, tc.ExpectedDetail)
+			}
+
+			if tc.ExpectedLog != "" {
+				lines := mockLog.GetAllMatching("remoteCAADifferentials JSON=.*")
+				test.AssertEquals(t, len(lines), 1)
+				test.AssertEquals(t, lines[0], tc.ExpectedLog)
+			}
+		})
+	}
+}
+
+// TestMultiCAAValidation tests that the remote CAA quorum is also applied to
+// the CAA check performed during validation.
+func TestMultiCAAValidation(t *testing.T) {
+	chall := createChallenge(core.ChallengeTypeHTTP01)
+	hs := httpSrv(t, chall.Token)
+	defer hs.Close()
+
+	remoteVAs := []RemoteVA{
+		setupRemoteCAA(caaMockDNS{}, "remote 1"),
+		setupRemoteCAA(caaMockDNS{}, "remote 2"),
+	}
+	va, _ := setup(hs, 0, "", remoteVAs)
+	va.dnsClient = caaHijackedDNS{}
+
+	_, prob := va.validate(ctx, dnsi("reserved.com"), 1, chall)
+	test.Assert(t, prob == nil, fmt.Sprintf("unexpected problem without EnforceMultiCAA: %v", prob))
+
+	err := features.Set(map[string]bool{"EnforceMultiCAA": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	_, prob = va.validate(ctx, dnsi("reserved.com"), 1, chall)
+	test.AssertNotNil(t, prob, "expected a CAA problem with EnforceMultiCAA")
+	test.AssertEquals(t, prob.Type, probs.CAAProblem)
+	test.AssertContains(t, prob.Detail, "During secondary CAA check")
+}
+
 func TestFilterCAA(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -719,9 +919,9 @@ func TestSelectCAA(t *testing.T) {
 
 	// A slice of empty caaResults should return nil, "", nil
 	r = []caaResult{
-		{"", false, nil, nil, false, "", nil},
-		{"", false, nil, nil, false, "", nil},
-		{"", false, nil, nil, false, "", nil},
+		{"", false, nil, nil, false, nil, "", nil, nil},
+		{"", false, nil, nil, false, nil, "", nil, nil},
+		{"", false, nil, nil, false, nil, "", nil, nil},
 	}
 	s, err = selectCAA(r)
 	test.Assert(t, s == nil, "set is not nil")
@@ -730,8 +930,8 @@ func TestSelectCAA(t *testing.T) {
 	// A slice of caaResults containing an error followed by a CAA
 	// record should return the error
 	r = []caaResult{
-		{"foo.com", false, nil, nil, false, "", errors.New("oops")},
-		{"com", true, []*dns.CAA{&expected}, nil, false, "foo", nil},
+		{"foo.com", false, nil, nil, false, nil, "", nil, errors.New("oops")},
+		{"com", true, []*dns.CAA{&expected}, nil, false, nil, "foo", nil, nil},
 	}
 	s, err = selectCAA(r)
 	test.Assert(t, s == nil, "set is not nil")
@@ -741,8 +941,8 @@ func TestSelectCAA(t *testing.T) {
 	//  A slice of caaResults containing a good record that precedes an
 	//  error, should return that good record, not the error
 	r = []caaResult{
-		{"foo.com", true, []*dns.CAA{&expected}, nil, false, "foo", nil},
-		{"com", false, nil, nil, false, "", errors.New("")},
+		{"foo.com", true, []*dns.CAA{&expected}, nil, false, nil, "foo", nil, nil},
+		{"com", false, nil, nil, false, nil, "", nil, errors.New("")},
 	}
 	s, err = selectCAA(r)
 	test.AssertEquals(t, len(s.issue), 1)
@@ -753,9 +953,9 @@ func TestSelectCAA(t *testing.T) {
 	// A slice of caaResults containing multiple CAA records should
 	// return the first non-empty CAA record
 	r = []caaResult{
-		{"bar.foo.com", false, []*dns.CAA{}, []*dns.CAA{}, false, "", nil},
-		{"foo.com", true, []*dns.CAA{&expected}, nil, false, "foo", nil},
-		{"com", true, []*dns.CAA{&expected}, nil, false, "bar", nil},
+		{"bar.foo.com", false, []*dns.CAA{}, []*dns.CAA{}, false, nil, "", nil, nil},
+		{"foo.com", true, []*dns.CAA{&expected}, nil, false, nil, "foo", nil, nil},
+		{"com", true, []*dns.CAA{&expected}, nil, false, nil, "bar", nil, nil},
 	}
 	s, err = selectCAA(r)
 	test.AssertEquals(t, len(s.issue), 1)
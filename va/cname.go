@@ -0,0 +1,79 @@
+package va
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/iana"
+	"github.com/letsencrypt/boulder/probs"
+)
+
+// CNAMEPolicy restricts the CNAME and DNAME chains which may be followed from
+// the DNS-01 challenge record of identifiers validated by selected accounts.
+type CNAMEPolicy struct {
+	// AccountIDs are the accounts to which the policy applies.
+	AccountIDs []int64 `validate:"min=1"`
+	// MaxChainLength is the greatest number of aliases which may be followed
+	// from the challenge record. Zero means no limit.
+	MaxChainLength int `validate:"min=0"`
+	// ForbidCrossZone forbids aliases to names outside the registered domain
+	// (the public suffix plus one label) of the identifier being validated.
+	ForbidCrossZone bool
+}
+
+// newCNAMEPolicies indexes policies by account ID. An account may be subject
+// to at most one policy.
+func newCNAMEPolicies(policies []CNAMEPolicy) (map[int64]CNAMEPolicy, error) {
+	byAccount := make(map[int64]CNAMEPolicy)
+	for _, p := range policies {
+		for _, id := range p.AccountIDs {
+			if _, present := byAccount[id]; present {
+				return nil, fmt.Errorf("account %d has more than one CNAME policy", id)
+			}
+			byAccount[id] = p
+		}
+	}
+	return byAccount, nil
+}
+
+// registeredDomain returns the public suffix of name plus one label, or name
+// itself if it has no registered domain, e.g. because it is a public suffix.
+// It uses the same Public Suffix List as the rest of Boulder, which may have
+// been loaded from a file in place of the compiled-in one.
+func registeredDomain(name string) string {
+	domain, err := iana.Domain(name)
+	if err != nil {
+		return name
+	}
+	return domain
+}
+
+// checkCNAMEPolicy returns a problem if the CNAME chain in any of the DNS-01
+// validation records for the given domain violates the policy for the account
+// with ID regID, if there is one.
+func (va *ValidationAuthorityImpl) checkCNAMEPolicy(regID int64, domain string, records []core.ValidationRecord) *probs.ProblemDetails {
+	policy, ok := va.cnamePolicies[regID]
+	if !ok {
+		return nil
+	}
+	zone := registeredDomain(strings.ToLower(domain))
+	for _, record := range records {
+		if policy.MaxChainLength > 0 && len(record.CNAMEChain) > policy.MaxChainLength {
+			return probs.Unauthorized(fmt.Sprintf(
+				"CNAME chain from %s.%s is %d aliases long, exceeding the limit of %d for this account",
+				core.DNSPrefix, domain, len(record.CNAMEChain), policy.MaxChainLength))
+		}
+		if !policy.ForbidCrossZone {
+			continue
+		}
+		for _, alias := range record.CNAMEChain {
+			if registeredDomain(alias) != zone {
+				return probs.Unauthorized(fmt.Sprintf(
+					"CNAME chain from %s.%s leaves %s for %s, which is not allowed for this account",
+					core.DNSPrefix, domain, zone, alias))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package va
+
+import (
+	"testing"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestNewCNAMEPolicies(t *testing.T) {
+	policies, err := newCNAMEPolicies([]CNAMEPolicy{
+		{AccountIDs: []int64{1, 2}, MaxChainLength: 1},
+		{AccountIDs: []int64{3}, ForbidCrossZone: true},
+	})
+	test.AssertNotError(t, err, "indexing CNAME policies")
+	test.AssertEquals(t, len(policies), 3)
+	test.AssertEquals(t, policies[2].MaxChainLength, 1)
+	test.Assert(t, policies[3].ForbidCrossZone, "account 3 should forbid cross-zone aliases")
+
+	_, err = newCNAMEPolicies([]CNAMEPolicy{
+		{AccountIDs: []int64{1}, MaxChainLength: 1},
+		{AccountIDs: []int64{1}, ForbidCrossZone: true},
+	})
+	test.AssertError(t, err, "indexed an account with two CNAME policies")
+}
+
+func TestCheckCNAMEPolicy(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.cnamePolicies, _ = newCNAMEPolicies([]CNAMEPolicy{
+		{AccountIDs: []int64{10}, MaxChainLength: 1},
+		{AccountIDs: []int64{20}, ForbidCrossZone: true},
+		{AccountIDs: []int64{30}, MaxChainLength: 2, ForbidCrossZone: true},
+	})
+	sameZone := []core.ValidationRecord{{CNAMEChain: []string{"_acme-challenge.www.example.co.uk", "acme.example.co.uk"}}}
+	crossZone := []core.ValidationRecord{{CNAMEChain: []string{"example.co.uk.validation.example.net"}}}
+
+	testCases := []struct {
+		name    string
+		regID   int64
+		records []core.ValidationRecord
+		problem string
+	}{
+		{"no policy", 1, sameZone, ""},
+		{"no aliases", 10, []core.ValidationRecord{{}}, ""},
+		{"too long", 10, sameZone, "is 2 aliases long, exceeding the limit of 1"},
+		{"within length", 10, crossZone, ""},
+		{"same zone", 20, sameZone, ""},
+		{"cross zone", 20, crossZone, "leaves example.co.uk for example.co.uk.validation.example.net"},
+		{"both within limits", 30, sameZone, ""},
+		{"both cross zone", 30, crossZone, "leaves example.co.uk"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prob := va.checkCNAMEPolicy(tc.regID, "www.example.co.uk", tc.records)
+			if tc.problem == "" {
+				test.Assert(t, prob == nil, "unexpected problem")
+				return
+			}
+			test.AssertNotNil(t, prob, "expected a problem")
+			test.AssertEquals(t, prob.Type, probs.UnauthorizedProblem)
+			test.AssertContains(t, prob.Detail, tc.problem)
+		})
+	}
+}
+
+func TestValidateCNAMEPolicy(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.cnamePolicies, _ = newCNAMEPolicies([]CNAMEPolicy{{AccountIDs: []int64{1}, ForbidCrossZone: true}})
+
+	// Only the selected account is subject to the policy.
+	_, prob := va.validate(ctx, dnsi("cname-dns01.com"), 2, dnsChallenge())
+	test.Assert(t, prob == nil, "validation failed for an account without a policy")
+
+	_, prob = va.validate(ctx, dnsi("cname-dns01.com"), 1, dnsChallenge())
+	test.AssertNotNil(t, prob, "validation succeeded despite a cross-zone alias")
+	test.AssertContains(t, prob.Detail, "cname-dns01.validation.example.net")
+}
@@ -0,0 +1,100 @@
+package va
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/probs"
+)
+
+// validationFlight is a local validation which is in progress on behalf of one
+// or more identical requests. records and prob must only be read once done is
+// closed.
+type validationFlight struct {
+	done    chan struct{}
+	records []core.ValidationRecord
+	prob    *probs.ProblemDetails
+}
+
+// validationGroup collapses concurrent identical validations into a single
+// network attempt, whose result is shared by all of them.
+type validationGroup struct {
+	mu      sync.Mutex
+	flights map[string]*validationFlight
+}
+
+func newValidationGroup() *validationGroup {
+	return &validationGroup{flights: make(map[string]*validationFlight)}
+}
+
+// validationKey identifies validations which are guaranteed to produce the same
+// result. The account ID is included alongside the key authorization because
+// CAA and CNAME policy checks depend on it.
+func validationKey(ident identifier.ACMEIdentifier, regID int64, challenge core.Challenge) string {
+	return strings.Join([]string{
+		string(ident.Type),
+		strings.ToLower(ident.Value),
+		string(challenge.Type),
+		challenge.Token,
+		challenge.ProvidedKeyAuthorization,
+		strconv.FormatInt(regID, 10),
+	}, "\x00")
+}
+
+// do calls fn and returns its result, unless a call with the same key is
+// already in progress, in which case it waits for and returns that call's
+// result instead. The returned bool is true if the result was shared.
+//
+// Since the call is shared, it mustn't be cut short when the request which
+// started it is cancelled, so fn is given a context which keeps ctx's values
+// but not its cancellation, and is bounded by timeout instead. Each caller,
+// including the one which started the call, waits only as long as its own
+// ctx allows; one which stops waiting receives a connection problem, but does
+// not interrupt the shared call.
+func (g *validationGroup) do(
+	ctx context.Context,
+	key string,
+	timeout time.Duration,
+	fn func(ctx context.Context) ([]core.ValidationRecord, *probs.ProblemDetails),
+) ([]core.ValidationRecord, *probs.ProblemDetails, bool) {
+	g.mu.Lock()
+	f, shared := g.flights[key]
+	if !shared {
+		// Waiting callers must never mistake a missing result for a
+		// successful validation.
+		f = &validationFlight{
+			done: make(chan struct{}),
+			prob: probs.ServerInternal("Identical validation in progress failed"),
+		}
+		g.flights[key] = f
+	}
+	g.mu.Unlock()
+
+	if !shared {
+		go func() {
+			defer func() {
+				g.mu.Lock()
+				delete(g.flights, key)
+				g.mu.Unlock()
+				close(f.done)
+			}()
+			flightCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+			defer cancel()
+			f.records, f.prob = fn(flightCtx)
+		}()
+	}
+
+	select {
+	case <-f.done:
+		records := make([]core.ValidationRecord, len(f.records))
+		copy(records, f.records)
+		return records, f.prob, shared
+	case <-ctx.Done():
+		return nil, probs.Connection("Timed out waiting for an identical validation in progress"), shared
+	}
+}
@@ -0,0 +1,146 @@
+package va
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestValidationKey(t *testing.T) {
+	chall := createChallenge(core.ChallengeTypeHTTP01)
+	key := validationKey(identifier.DNSIdentifier("example.com"), 1, chall)
+
+	test.AssertEquals(t, validationKey(identifier.DNSIdentifier("EXAMPLE.com"), 1, chall), key)
+	test.AssertNotEquals(t, validationKey(identifier.DNSIdentifier("example.net"), 1, chall), key)
+	test.AssertNotEquals(t, validationKey(identifier.DNSIdentifier("example.com"), 2, chall), key)
+
+	other := chall
+	other.Type = core.ChallengeTypeTLSALPN01
+	test.AssertNotEquals(t, validationKey(identifier.DNSIdentifier("example.com"), 1, other), key)
+	other = chall
+	other.Token = "other"
+	test.AssertNotEquals(t, validationKey(identifier.DNSIdentifier("example.com"), 1, other), key)
+	other = chall
+	other.ProvidedKeyAuthorization = "other"
+	test.AssertNotEquals(t, validationKey(identifier.DNSIdentifier("example.com"), 1, other), key)
+}
+
+func TestValidationGroupShares(t *testing.T) {
+	g := newValidationGroup()
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	var calls int
+	var flightCtx context.Context
+	fn := func(ctx context.Context) ([]core.ValidationRecord, *probs.ProblemDetails) {
+		calls++
+		flightCtx = ctx
+		close(started)
+		<-finish
+		return []core.ValidationRecord{{Hostname: "example.com"}}, probs.Unauthorized("nope")
+	}
+
+	type result struct {
+		records []core.ValidationRecord
+		prob    *probs.ProblemDetails
+		shared  bool
+	}
+	results := make(chan result, 3)
+
+	// The caller which starts the call can give up on it without cancelling
+	// it for everyone else.
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan *probs.ProblemDetails)
+	go func() {
+		_, prob, shared := g.do(leaderCtx, "key", time.Minute, fn)
+		test.Assert(t, !shared, "expected the first caller to start the call")
+		leaderDone <- prob
+	}()
+	<-started
+	cancelLeader()
+	test.AssertEquals(t, (<-leaderDone).Type, probs.ConnectionProblem)
+	test.AssertNotError(t, flightCtx.Err(), "shared call was cancelled with the caller which started it")
+	_, hasDeadline := flightCtx.Deadline()
+	test.Assert(t, hasDeadline, "shared call has no deadline of its own")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			records, prob, shared := g.do(context.Background(), "key", time.Minute, fn)
+			results <- result{records, prob, shared}
+		}()
+	}
+
+	// A caller which gives up waiting doesn't affect the shared call.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, prob, shared := g.do(ctx, "key", time.Minute, fn)
+	test.Assert(t, shared, "expected a waiting caller to share the call")
+	test.AssertEquals(t, prob.Type, probs.ConnectionProblem)
+
+	close(finish)
+	wg.Wait()
+	var sharedCount int
+	for i := 0; i < 2; i++ {
+		r := <-results
+		if r.shared {
+			sharedCount++
+		}
+		test.AssertEquals(t, len(r.records), 1)
+		test.AssertEquals(t, r.records[0].Hostname, "example.com")
+		test.AssertEquals(t, r.prob.Type, probs.UnauthorizedProblem)
+	}
+	test.AssertEquals(t, calls, 1)
+	test.AssertEquals(t, sharedCount, 2)
+
+	// Once the call is finished, the next caller makes a new one.
+	_, _, shared = g.do(context.Background(), "key", time.Minute, func(context.Context) ([]core.ValidationRecord, *probs.ProblemDetails) {
+		return nil, nil
+	})
+	test.Assert(t, !shared, "expected a new call once the previous one finished")
+}
+
+func TestPerformValidationCollapsed(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	err := features.Set(map[string]bool{"DeduplicateValidations": true})
+	test.AssertNotError(t, err, "enabling DeduplicateValidations")
+	defer features.Reset()
+
+	// Pretend an identical validation is already in progress.
+	req := createValidationRequest("good-dns01.com", core.ChallengeTypeDNS01)
+	key := validationKey(identifier.DNSIdentifier("good-dns01.com"), 1, createChallenge(core.ChallengeTypeDNS01))
+	flight := &validationFlight{done: make(chan struct{})}
+	va.inflight.flights[key] = flight
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		res, err := va.PerformValidation(context.Background(), req)
+		test.AssertNotError(t, err, "PerformValidation failed")
+		test.Assert(t, res.Problems != nil, "expected the shared problem")
+		test.AssertEquals(t, res.Problems.Detail, "shared result")
+	}()
+	flight.prob = probs.Unauthorized("shared result")
+	close(flight.done)
+	<-done
+
+	test.AssertMetricWithLabelsEquals(t, va.metrics.collapsedValidations, prometheus.Labels{"type": "dns-01"}, 1)
+
+	// With the flight finished, the same request validates on its own.
+	delete(va.inflight.flights, key)
+	res, err := va.PerformValidation(context.Background(), req)
+	test.AssertNotError(t, err, "PerformValidation failed")
+	test.Assert(t, res.Problems == nil, "validation failed")
+	test.AssertMetricWithLabelsEquals(t, va.metrics.collapsedValidations, prometheus.Labels{"type": "dns-01"}, 1)
+}
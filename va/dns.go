@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/va/evidence"
 )
 
 // getAddr will query for all A/AAAA records associated with hostname and return
@@ -19,20 +21,22 @@ import (
 // resolved. This is the same choice made by the Go internal resolution library
 // used by net/http. If there is an error resolving the hostname, or if no
 // usable IP addresses are available then a berrors.DNSError instance is
-// returned with a nil net.IP slice.
-func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, error) {
-	addrs, err := va.dnsClient.LookupHost(ctx, hostname)
+// returned with a nil net.IP slice. The DNSSEC status of the lookup is also
+// returned, for inclusion in validation records.
+func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, bdns.DNSSECStatus, error) {
+	addrs, info, err := va.dnsClient.LookupHost(ctx, hostname)
+	evidence.FromContext(ctx).AddDNS(info.Responses...)
 	if err != nil {
-		return nil, berrors.DNSError("%v", err)
+		return nil, "", berrors.DNSError("%v", err)
 	}
 
 	if len(addrs) == 0 {
 		// This should be unreachable, as no valid IP addresses being found results
 		// in an error being returned from LookupHost.
-		return nil, berrors.DNSError("No valid IP addresses found for %s", hostname)
+		return nil, "", berrors.DNSError("No valid IP addresses found for %s", hostname)
 	}
 	va.log.Debugf("Resolved addresses for %s: %s", hostname, addrs)
-	return addrs, nil
+	return addrs, info.DNSSEC, nil
 }
 
 // availableAddresses takes a ValidationRecord and splits the AddressesResolved
@@ -61,7 +65,8 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 
 	// Look for the required record in the DNS
 	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
-	txts, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
+	txts, info, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
+	evidence.FromContext(ctx).AddDNS(info.Responses...)
 	if err != nil {
 		return nil, probs.DNS(err.Error())
 	}
@@ -76,7 +81,11 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 	for _, element := range txts {
 		if subtle.ConstantTimeCompare([]byte(element), []byte(authorizedKeysDigest)) == 1 {
 			// Successful challenge validation
-			return []core.ValidationRecord{{Hostname: ident.Value}}, nil
+			return []core.ValidationRecord{{
+				Hostname:   ident.Value,
+				DNSSEC:     string(info.DNSSEC),
+				CNAMEChain: info.Chain,
+			}}, nil
 		}
 	}
 
@@ -145,13 +145,35 @@ func TestDNSValidationNoServer(t *testing.T) {
 		metrics.NoopRegisterer,
 		clock.New(),
 		1,
-		log)
+		log,
+		nil)
 
 	_, prob := va.validateChallenge(ctx, dnsi("localhost"), dnsChallenge())
 
 	test.AssertEquals(t, prob.Type, probs.DNSProblem)
 }
 
+func TestDNSValidationDNSSEC(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	records, prob := va.validateChallenge(ctx, dnsi("dnssec-secure-dns01.com"), dnsChallenge())
+	test.Assert(t, prob == nil, "Should be valid.")
+	test.AssertEquals(t, records[0].DNSSEC, "secure")
+
+	_, prob = va.validateChallenge(ctx, dnsi("dnssec-bogus.com"), dnsChallenge())
+	test.AssertNotNil(t, prob, "Should be invalid.")
+	test.AssertEquals(t, prob.Type, probs.DNSProblem)
+	test.AssertContains(t, prob.Detail, "DNSSEC: Bogus")
+}
+
+func TestDNSValidationCNAMEChain(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	records, prob := va.validateChallenge(ctx, dnsi("cname-dns01.com"), dnsChallenge())
+	test.Assert(t, prob == nil, "Should be valid.")
+	test.AssertDeepEquals(t, records[0].CNAMEChain, []string{"_acme-challenge.www.cname-dns01.com", "cname-dns01.validation.example.net"})
+}
+
 func TestDNSValidationOK(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
@@ -0,0 +1,220 @@
+// Package evidence captures what the VA saw while validating a challenge, raw
+// DNS responses, HTTP responses and TLS certificates, and archives it so that
+// validations can be audited and investigated after the fact.
+package evidence
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// Evidence is everything a single perspective saw while validating a
+// challenge for an authorization. It is archived as JSON.
+type Evidence struct {
+	AuthzID     string
+	Perspective string
+	Identifier  string
+	Challenge   core.AcmeChallenge
+	ValidatedAt time.Time
+	// Problem is the problem the perspective found with the validation, if
+	// any.
+	Problem string `json:",omitempty"`
+
+	DNS     []DNSResponse  `json:",omitempty"`
+	HTTP    []HTTPResponse `json:",omitempty"`
+	TLSALPN []TLSPeer      `json:",omitempty"`
+}
+
+// DNSResponse is a DNS response, including those for CAA and TXT queries.
+type DNSResponse struct {
+	Name string
+	Type string
+	// Wire is the response in DNS wire format.
+	Wire []byte
+}
+
+// HTTPResponse is an HTTP response received during HTTP-01 validation,
+// either a redirect or the final response.
+type HTTPResponse struct {
+	URL        string
+	StatusCode int
+	Header     http.Header
+	// Body is the prefix of the body read by the VA, which is bounded by the
+	// VA's maximum response size. It is empty for redirects.
+	Body []byte `json:",omitempty"`
+}
+
+// TLSPeer is the certificate chain presented by a server during TLS-ALPN-01
+// validation.
+type TLSPeer struct {
+	Address string
+	// Certificates are the DER encoded certificates presented, leaf first.
+	Certificates [][]byte
+}
+
+// Collector accumulates evidence during a validation. It is carried in the
+// validation's context so that each step of the validation can add what it
+// saw. All methods are safe for concurrent use, and do nothing on a nil
+// Collector, so callers needn't check whether evidence is being collected.
+type Collector struct {
+	sync.Mutex
+	dns     []DNSResponse
+	http    []HTTPResponse
+	tlsALPN []TLSPeer
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a context carrying a new Collector, along with the
+// Collector.
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	c := &Collector{}
+	return context.WithValue(ctx, collectorKey{}, c), c
+}
+
+// FromContext returns the Collector carried by ctx, or nil if there is none.
+func FromContext(ctx context.Context) *Collector {
+	c, _ := ctx.Value(collectorKey{}).(*Collector)
+	return c
+}
+
+// AddDNS records DNS responses. Responses which can't be packed into wire
+// format are skipped.
+func (c *Collector) AddDNS(msgs ...*dns.Msg) {
+	if c == nil {
+		return
+	}
+	for _, m := range msgs {
+		if m == nil || len(m.Question) == 0 {
+			continue
+		}
+		// Pack a copy, since the response may be shared with other lookups
+		// through a cache and packing can modify it.
+		wire, err := m.Copy().Pack()
+		if err != nil {
+			continue
+		}
+		c.Lock()
+		c.dns = append(c.dns, DNSResponse{
+			Name: m.Question[0].Name,
+			Type: dns.TypeToString[m.Question[0].Qtype],
+			Wire: wire,
+		})
+		c.Unlock()
+	}
+}
+
+// AddHTTP records an HTTP response along with the prefix of its body that was
+// read, if any.
+func (c *Collector) AddHTTP(resp *http.Response, body []byte) {
+	if c == nil || resp == nil {
+		return
+	}
+	r := HTTPResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       append([]byte(nil), body...),
+	}
+	if resp.Request != nil {
+		r.URL = resp.Request.URL.String()
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.http = append(c.http, r)
+}
+
+// AddTLSPeer records the certificates presented by the server at address.
+func (c *Collector) AddTLSPeer(address string, certs []*x509.Certificate) {
+	if c == nil {
+		return
+	}
+	peer := TLSPeer{Address: address}
+	for _, cert := range certs {
+		peer.Certificates = append(peer.Certificates, cert.Raw)
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.tlsALPN = append(c.tlsALPN, peer)
+}
+
+// Archive stores the evidence collected by a perspective in a Store.
+type Archive struct {
+	store       Store
+	perspective string
+	clk         clock.Clock
+	log         blog.Logger
+	saveCounter *prometheus.CounterVec
+}
+
+// NewArchive returns an Archive which saves evidence to store, labelled with
+// the name of the perspective which collected it.
+func NewArchive(store Store, perspective string, clk clock.Clock, stats prometheus.Registerer, log blog.Logger) *Archive {
+	saveCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "validation_evidence_saves",
+		Help: "Counter of attempts to archive validation evidence, sliced by result",
+	}, []string{"result"})
+	stats.MustRegister(saveCounter)
+	return &Archive{
+		store:       store,
+		perspective: perspective,
+		clk:         clk,
+		log:         log,
+		saveCounter: saveCounter,
+	}
+}
+
+// Save archives the evidence accumulated by c for a validation of the given
+// challenge type for the identifier in an authorization. prob is the problem
+// the validation found, if any. The evidence is stored under the key
+// "<authzID>/<perspective>/<timestamp>.json".
+func (a *Archive) Save(ctx context.Context, c *Collector, authzID string, ident string, challenge core.AcmeChallenge, prob error) error {
+	ev := Evidence{
+		AuthzID:     authzID,
+		Perspective: a.perspective,
+		Identifier:  ident,
+		Challenge:   challenge,
+		ValidatedAt: a.clk.Now().UTC(),
+	}
+	if prob != nil {
+		ev.Problem = prob.Error()
+	}
+	c.Lock()
+	ev.DNS = append(ev.DNS, c.dns...)
+	ev.HTTP = append(ev.HTTP, c.http...)
+	ev.TLSALPN = append(ev.TLSALPN, c.tlsALPN...)
+	c.Unlock()
+
+	err := a.save(ctx, ev)
+	if err != nil {
+		a.saveCounter.WithLabelValues("failed").Inc()
+		a.log.Errf("Saving validation evidence: authzID=[%s] err=[%s]", authzID, err)
+		return err
+	}
+	a.saveCounter.WithLabelValues("success").Inc()
+	return nil
+}
+
+func (a *Archive) save(ctx context.Context, ev Evidence) error {
+	key := fmt.Sprintf("%s/%s/%s.json", ev.AuthzID, ev.Perspective, ev.ValidatedAt.Format("20060102T150405.000000000Z"))
+	if !filepath.IsLocal(key) {
+		return fmt.Errorf("invalid evidence key %q", key)
+	}
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("marshalling evidence: %w", err)
+	}
+	return a.store.Put(ctx, key, body)
+}
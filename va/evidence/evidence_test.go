@@ -0,0 +1,124 @@
+package evidence
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestCollector(t *testing.T) {
+	// A nil Collector does nothing.
+	FromContext(context.Background()).AddDNS(new(dns.Msg))
+
+	ctx, c := WithCollector(context.Background())
+	test.AssertEquals(t, FromContext(ctx), c)
+
+	m := new(dns.Msg)
+	m.SetQuestion("_acme-challenge.example.com.", dns.TypeTXT)
+	c.AddDNS(m, nil, new(dns.Msg))
+	test.AssertEquals(t, len(c.dns), 1)
+	test.AssertEquals(t, c.dns[0].Type, "TXT")
+	unpacked := new(dns.Msg)
+	err := unpacked.Unpack(c.dns[0].Wire)
+	test.AssertNotError(t, err, "unpacking recorded DNS response")
+	test.AssertEquals(t, unpacked.Question[0].Name, "_acme-challenge.example.com.")
+
+	u, _ := url.Parse("http://example.com/.well-known/acme-challenge/token")
+	resp := &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Server": {"test"}},
+		Request:    &http.Request{URL: u},
+	}
+	c.AddHTTP(resp, []byte("token.thumbprint"))
+	test.AssertDeepEquals(t, c.http, []HTTPResponse{{
+		URL:        u.String(),
+		StatusCode: 200,
+		Header:     http.Header{"Server": {"test"}},
+		Body:       []byte("token.thumbprint"),
+	}})
+
+	c.AddTLSPeer("127.0.0.1:443", []*x509.Certificate{{Raw: []byte{1, 2, 3}}})
+	test.AssertDeepEquals(t, c.tlsALPN, []TLSPeer{{Address: "127.0.0.1:443", Certificates: [][]byte{{1, 2, 3}}}})
+}
+
+func TestArchiveFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(filepath.Join(dir, "evidence"))
+	test.AssertNotError(t, err, "creating file store")
+	clk := clock.NewFake()
+	archive := NewArchive(store, "primary", clk, metrics.NoopRegisterer, blog.NewMock())
+
+	_, c := WithCollector(context.Background())
+	m := new(dns.Msg)
+	m.SetQuestion("example.com.", dns.TypeCAA)
+	c.AddDNS(m)
+	err = archive.Save(context.Background(), c, "1234", "example.com", core.ChallengeTypeDNS01, errors.New("no TXT record"))
+	test.AssertNotError(t, err, "saving evidence")
+	test.AssertMetricWithLabelsEquals(t, archive.saveCounter, prometheus.Labels{"result": "success"}, 1)
+
+	matches, err := filepath.Glob(filepath.Join(dir, "evidence", "1234", "primary", "*.json"))
+	test.AssertNotError(t, err, "listing evidence")
+	test.AssertEquals(t, len(matches), 1)
+	body, err := os.ReadFile(matches[0])
+	test.AssertNotError(t, err, "reading evidence")
+	var ev Evidence
+	err = json.Unmarshal(body, &ev)
+	test.AssertNotError(t, err, "unmarshalling evidence")
+	test.AssertEquals(t, ev.AuthzID, "1234")
+	test.AssertEquals(t, ev.Perspective, "primary")
+	test.AssertEquals(t, ev.Challenge, core.ChallengeTypeDNS01)
+	test.AssertEquals(t, ev.Problem, "no TXT record")
+	test.AssertEquals(t, len(ev.DNS), 1)
+	test.AssertEquals(t, ev.DNS[0].Type, "CAA")
+
+	// Keys must stay within the store.
+	err = archive.Save(context.Background(), c, "../..", "example.com", core.ChallengeTypeDNS01, nil)
+	test.AssertError(t, err, "saved evidence outside of the store")
+	test.AssertMetricWithLabelsEquals(t, archive.saveCounter, prometheus.Labels{"result": "failed"}, 1)
+}
+
+type mockS3Putter struct {
+	puts map[string][]byte
+	err  error
+}
+
+func (p *mockS3Putter) PutObject(_ context.Context, params *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	body, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	p.puts[*params.Bucket+"/"+*params.Key] = body
+	return &s3.PutObjectOutput{}, nil
+}
+
+func TestS3Store(t *testing.T) {
+	putter := &mockS3Putter{puts: map[string][]byte{}}
+	store := NewS3Store(putter, "bucket", "evidence")
+	err := store.Put(context.Background(), "1234/primary/now.json", []byte("{}"))
+	test.AssertNotError(t, err, "putting evidence")
+	test.AssertDeepEquals(t, putter.puts, map[string][]byte{"bucket/evidence/1234/primary/now.json": []byte("{}")})
+
+	putter.err = errors.New("oops")
+	err = store.Put(context.Background(), "1234/primary/later.json", []byte("{}"))
+	test.AssertError(t, err, "putting evidence succeeded despite S3 error")
+}
@@ -0,0 +1,86 @@
+package evidence
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// Store is somewhere to archive evidence. Keys are slash-separated paths.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// fileStore stores evidence as files under a directory.
+type fileStore struct {
+	dir string
+}
+
+var _ Store = &fileStore{}
+
+// NewFileStore returns a Store which writes evidence to files under dir,
+// creating subdirectories as needed.
+func NewFileStore(dir string) (*fileStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("creating evidence directory: %w", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (fs *fileStore) Put(_ context.Context, key string, data []byte) error {
+	name := filepath.Join(fs.dir, filepath.FromSlash(key))
+	err := os.MkdirAll(filepath.Dir(name), 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, data, 0600)
+}
+
+// s3Putter matches the subset of the s3.Client interface which we use, to allow
+// simpler mocking in tests.
+type s3Putter interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+// s3Store stores evidence as objects in an S3 bucket.
+type s3Store struct {
+	client s3Putter
+	bucket string
+	prefix string
+}
+
+var _ Store = &s3Store{}
+
+// NewS3Store returns a Store which uploads evidence to the given S3 bucket,
+// with keys under the given prefix, which may be empty.
+func NewS3Store(client s3Putter, bucket string, prefix string) *s3Store {
+	return &s3Store{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (ss *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	key = path.Join(ss.prefix, key)
+	checksum := sha256.Sum256(data)
+	checksumb64 := base64.StdEncoding.EncodeToString(checksum[:])
+	contentType := "application/json"
+	_, err := ss.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:            &ss.bucket,
+		Key:               &key,
+		Body:              bytes.NewReader(data),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    &checksumb64,
+		ContentType:       &contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("uploading to S3: %w", err)
+	}
+	return nil
+}
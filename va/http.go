@@ -14,11 +14,13 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/va/evidence"
 )
 
 const (
@@ -45,6 +47,10 @@ type preresolvedDialer struct {
 	port     int
 	hostname string
 	timeout  time.Duration
+	// limiter, if not nil, bounds the number of concurrent connections to ip.
+	limiter *hostLimiter
+	// proxy, if not nil, is the egress proxy through which to connect.
+	proxy *egressProxy
 }
 
 // a dialerMismatchError is produced when a preresolvedDialer is used to dial
@@ -130,7 +136,21 @@ func (d *preresolvedDialer) DialContext(
 		// Default KeepAlive - see Golang src/net/http/transport.go DefaultTransport
 		KeepAlive: 30 * time.Second,
 	}
-	return throwAwayDialer.DialContext(ctx, network, targetAddr)
+	release, err := d.limiter.acquire(ctx, d.ip.String())
+	if err != nil {
+		return nil, err
+	}
+	var conn net.Conn
+	if d.proxy != nil {
+		conn, err = d.proxy.dial(ctx, throwAwayDialer, network, targetAddr)
+	} else {
+		conn, err = throwAwayDialer.DialContext(ctx, network, targetAddr)
+	}
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &limitedConn{Conn: conn, release: release}, nil
 }
 
 // a dialerFunc meets the function signature requirements of
@@ -177,6 +197,8 @@ type httpValidationTarget struct {
 	next []net.IP
 	// the current IP address being used for validation (if any)
 	cur net.IP
+	// the DNSSEC status of the lookup of the host's IP addresses
+	dnssec bdns.DNSSECStatus
 }
 
 // nextIP changes the cur IP by removing the first entry from the next slice and
@@ -212,7 +234,7 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 	path string,
 	query string) (*httpValidationTarget, error) {
 	// Resolve IP addresses for the hostname
-	addrs, err := va.getAddrs(ctx, host)
+	addrs, dnssec, err := va.getAddrs(ctx, host)
 	if err != nil {
 		return nil, err
 	}
@@ -223,6 +245,7 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 		path:      path,
 		query:     query,
 		available: addrs,
+		dnssec:    dnssec,
 	}
 
 	// Separate the addresses into the available v4 and v6 addresses
@@ -332,6 +355,10 @@ func (va *ValidationAuthorityImpl) extractRequestTarget(req *http.Request) (stri
 		return "", 0, berrors.ConnectionFailureError("Invalid hostname in redirect target, must end in IANA registered TLD")
 	}
 
+	if _, ok := iana.SpecialUseDomain(reqHost); ok {
+		return "", 0, berrors.ConnectionFailureError("Invalid hostname in redirect target, must not be a special-use domain name")
+	}
+
 	return reqHost, reqPort, nil
 }
 
@@ -362,6 +389,7 @@ func (va *ValidationAuthorityImpl) setupHTTPValidation(
 		Port:              strconv.Itoa(target.port),
 		AddressesResolved: target.available,
 		URL:               reqURL,
+		DNSSEC:            string(target.dnssec),
 	}
 
 	// Get the target IP to build a preresolved dialer with
@@ -380,6 +408,8 @@ func (va *ValidationAuthorityImpl) setupHTTPValidation(
 		port:     target.port,
 		hostname: target.host,
 		timeout:  va.singleDialTimeout,
+		limiter:  va.ipLimiter,
+		proxy:    va.egressProxy,
 	}
 	return dialer, record, nil
 }
@@ -498,6 +528,7 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 	numRedirects := 0
 	processRedirect := func(req *http.Request, via []*http.Request) error {
 		va.log.Debugf("processing a HTTP redirect from the server to %q", req.URL.String())
+		evidence.FromContext(ctx).AddHTTP(req.Response, nil)
 		// Only process up to maxRedirect redirects
 		if numRedirects > maxRedirect {
 			return berrors.ConnectionFailureError("Too many redirects")
@@ -624,6 +655,7 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 	}
 
 	if httpResponse.StatusCode != 200 {
+		evidence.FromContext(ctx).AddHTTP(httpResponse, nil)
 		return nil, records, newIPError(target, berrors.UnauthorizedError("Invalid response from %s: %d",
 			records[len(records)-1].URL, httpResponse.StatusCode))
 	}
@@ -632,6 +664,7 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 	// otherwise) and can read and process the response body.
 	body, err := io.ReadAll(&io.LimitedReader{R: httpResponse.Body, N: maxResponseSize})
 	closeErr := httpResponse.Body.Close()
+	evidence.FromContext(ctx).AddHTTP(httpResponse, body)
 	if err == nil {
 		err = closeErr
 	}
@@ -1346,8 +1346,8 @@ type dnsMockReturnsUnroutable struct {
 	*bdns.MockClient
 }
 
-func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
-	return []net.IP{net.ParseIP("198.51.100.1")}, nil
+func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.LookupInfo, error) {
+	return []net.IP{net.ParseIP("198.51.100.1")}, bdns.LookupInfo{}, nil
 }
 
 // TestHTTPDialTimeout tests that we give the proper "Timeout during connect"
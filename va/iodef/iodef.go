@@ -0,0 +1,307 @@
+// Package iodef reports certificate requests refused because of CAA to the
+// iodef URLs published alongside the CAA records, as described in RFC 8659
+// Section 4.4. Reports are RFC 7970 IODEF documents, sent by email for mailto:
+// URLs or POSTed for https: URLs.
+package iodef
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/iana"
+	blog "github.com/letsencrypt/boulder/log"
+	bmail "github.com/letsencrypt/boulder/mail"
+)
+
+// maxURLs is the greatest number of iodef URLs from a single CAA RRSet to which
+// a report will be delivered.
+const maxURLs = 3
+
+// report is a single delivery of an incident report to an iodef URL.
+type report struct {
+	id       string
+	incident Incident
+	url      *url.URL
+	body     []byte
+}
+
+// Reporter delivers incident reports in the background. Reports about the CAA
+// records under any one registered domain, and reports to any one destination
+// (email address or HTTPS host), are each sent at most once per minimum
+// interval. Reports which can't be queued are dropped rather than delaying
+// validation.
+type Reporter struct {
+	issuerDomain string
+	mailer       bmail.Mailer
+	client       *http.Client
+	minInterval  time.Duration
+	clk          clock.Clock
+	log          blog.Logger
+	queue        chan report
+	reports      *prometheus.CounterVec
+
+	mu sync.Mutex
+	// lastReport holds the time of the latest report about each registered
+	// domain, keyed by "domain:" and the domain, and to each destination,
+	// keyed by "mailto:" and the address or "https:" and the host.
+	lastReport map[string]time.Time
+}
+
+// NewHTTPClient returns a client for POSTing reports to https: iodef URLs. Since
+// the URLs are chosen by whoever controls the CAA records, the client refuses
+// to connect to reserved IP addresses, checking the address actually dialed
+// rather than the result of a separate lookup. It also doesn't follow
+// redirects, and ignores any proxy configured in the environment.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: refuseReservedIP,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			ForceAttemptHTTP2:   true,
+		},
+		// Reports are delivered only to the URL the domain owner published.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refuseReservedIP is a net.Dialer Control function which refuses to connect
+// to IP addresses in the IANA special-purpose address registries.
+func refuseReservedIP(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("dialing %q: not an IP address", address)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		if iana.IsReservedIPv4(ip4) {
+			return fmt.Errorf("refusing to connect to reserved IP address %s", ip)
+		}
+		return nil
+	}
+	if iana.IsReservedIPv6(ip) {
+		return fmt.Errorf("refusing to connect to reserved IP address %s", ip)
+	}
+	return nil
+}
+
+// New returns a Reporter which identifies itself as issuerDomain and starts
+// workers goroutines to deliver queued reports. If mailer is nil, mailto: iodef
+// URLs are ignored. HTTPS reports are sent with client, which should be made by
+// NewHTTPClient outside of tests.
+func New(
+	issuerDomain string,
+	mailer bmail.Mailer,
+	client *http.Client,
+	minInterval time.Duration,
+	queueSize int,
+	workers int,
+	clk clock.Clock,
+	stats prometheus.Registerer,
+	log blog.Logger,
+) *Reporter {
+	reports := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "caa_iodef_reports",
+		Help: "Count of CAA iodef incident reports, sliced by URL scheme and result",
+	}, []string{"scheme", "result"})
+	stats.MustRegister(reports)
+
+	r := &Reporter{
+		issuerDomain: issuerDomain,
+		mailer:       mailer,
+		client:       client,
+		minInterval:  minInterval,
+		clk:          clk,
+		log:          log,
+		queue:        make(chan report, queueSize),
+		reports:      reports,
+		lastReport:   make(map[string]time.Time),
+	}
+	for i := 0; i < workers; i++ {
+		go r.deliverLoop()
+	}
+	return r
+}
+
+// allow returns true if no report has been sent with the given rate limit key
+// within the minimum interval, and if so records that one is being sent now.
+func (r *Reporter) allow(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clk.Now()
+	last, ok := r.lastReport[key]
+	if ok && now.Sub(last) < r.minInterval {
+		return false
+	}
+	r.lastReport[key] = now
+	// Forget keys which are no longer limited, so the map doesn't grow
+	// without bound.
+	if len(r.lastReport) > 10000 {
+		for n, t := range r.lastReport {
+			if now.Sub(t) >= r.minInterval {
+				delete(r.lastReport, n)
+			}
+		}
+	}
+	return true
+}
+
+// registeredDomain returns the registered domain of the name at which CAA
+// records were found, so that reports about CAA records at many names in one
+// domain share a rate limit. If name is itself a public suffix, it's returned
+// unchanged.
+func registeredDomain(name string) string {
+	name = strings.ToLower(name)
+	domain, err := iana.Domain(name)
+	if err != nil {
+		return name
+	}
+	return domain
+}
+
+// destination returns the rate limit key for reports sent to u: the email
+// address of a mailto: URL, or the host of an https: URL. Any number of iodef
+// URLs, published at any number of names, can point to the same destination.
+func destination(u *url.URL) string {
+	if u.Scheme == "mailto" {
+		addr, err := mail.ParseAddress(u.Opaque)
+		if err != nil {
+			return "mailto:" + strings.ToLower(u.Opaque)
+		}
+		return "mailto:" + strings.ToLower(addr.Address)
+	}
+	return u.Scheme + ":" + strings.ToLower(u.Hostname())
+}
+
+// Report queues delivery of a report about the incident to each of the given
+// iodef URLs. It never blocks.
+func (r *Reporter) Report(incident Incident, iodefURLs []string) {
+	if len(iodefURLs) == 0 {
+		return
+	}
+	domain := registeredDomain(incident.FoundAt)
+	if !r.allow("domain:" + domain) {
+		r.reports.WithLabelValues("", "ratelimited").Inc()
+		r.log.Infof("Not sending CAA iodef report for %s: rate limited for %s", incident.FoundAt, domain)
+		return
+	}
+
+	id := core.RandomString(16)
+	body, err := incident.build(r.issuerDomain, id)
+	if err != nil {
+		r.log.AuditErrf("Building CAA iodef report for %s: %s", incident.Identifier, err)
+		return
+	}
+
+	if len(iodefURLs) > maxURLs {
+		iodefURLs = iodefURLs[:maxURLs]
+	}
+	for _, raw := range iodefURLs {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "https") {
+			r.reports.WithLabelValues("", "unsupported").Inc()
+			r.log.Infof("Not sending CAA iodef report for %s to unsupported URL %q", incident.Identifier, raw)
+			continue
+		}
+		if !r.allow(destination(u)) {
+			r.reports.WithLabelValues(u.Scheme, "ratelimited").Inc()
+			r.log.Infof("Not sending CAA iodef report for %s to %s: rate limited", incident.Identifier, u)
+			continue
+		}
+		select {
+		case r.queue <- report{id: id, incident: incident, url: u, body: body}:
+			r.log.AuditInfof("Queued CAA iodef report %s for %s to %s", id, incident.Identifier, u)
+		default:
+			r.reports.WithLabelValues(u.Scheme, "dropped").Inc()
+			r.log.AuditErrf("Dropped CAA iodef report %s for %s to %s: queue full", id, incident.Identifier, u)
+		}
+	}
+}
+
+func (r *Reporter) deliverLoop() {
+	for rep := range r.queue {
+		err := r.deliver(rep)
+		if err != nil {
+			r.reports.WithLabelValues(rep.url.Scheme, "failed").Inc()
+			r.log.AuditErrf("Sending CAA iodef report %s for %s to %s: %s", rep.id, rep.incident.Identifier, rep.url, err)
+			continue
+		}
+		r.reports.WithLabelValues(rep.url.Scheme, "sent").Inc()
+		r.log.AuditInfof("Sent CAA iodef report %s for %s to %s", rep.id, rep.incident.Identifier, rep.url)
+	}
+}
+
+func (r *Reporter) deliver(rep report) error {
+	switch rep.url.Scheme {
+	case "mailto":
+		return r.sendMail(rep)
+	case "https":
+		return r.post(rep)
+	}
+	return fmt.Errorf("unsupported scheme %q", rep.url.Scheme)
+}
+
+func (r *Reporter) sendMail(rep report) error {
+	if r.mailer == nil {
+		return fmt.Errorf("no mailer configured")
+	}
+	addr, err := mail.ParseAddress(rep.url.Opaque)
+	if err != nil {
+		return fmt.Errorf("parsing address: %w", err)
+	}
+	conn, err := r.mailer.Connect()
+	if err != nil {
+		return fmt.Errorf("connecting to mail server: %w", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	subject := fmt.Sprintf("CAA incident report for %s", rep.incident.Identifier)
+	msg := fmt.Sprintf("%s\n\nThis report was sent because the CAA records at %s request incident reports at %s.\n\n%s",
+		rep.incident.description(r.issuerDomain), rep.incident.FoundAt, rep.url, rep.body)
+	return conn.SendMail([]string{addr.Address}, subject, msg)
+}
+
+func (r *Reporter) post(rep report) error {
+	if rep.url.Scheme != "https" {
+		return fmt.Errorf("refusing to POST report to non-https URL")
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, rep.url.String(), bytes.NewReader(rep.body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package iodef
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	"github.com/letsencrypt/boulder/test"
+)
+
+var testIncident = Incident{
+	Identifier:       "www.example.com",
+	FoundAt:          "example.com",
+	Records:          []string{"example.com.\t3600\tIN\tCAA\t0 issue \"other-ca.example\""},
+	AccountID:        1234,
+	ValidationMethod: "http-01",
+	Time:             time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+}
+
+// newTestReporter returns a Reporter with no workers, so that tests can
+// deliver queued reports themselves.
+func newTestReporter(mailer *mocks.Mailer, client *http.Client) (*Reporter, clock.FakeClock) {
+	fc := clock.NewFake()
+	r := New("ca.example", nil, client, time.Hour, 10, 0, fc, metrics.NoopRegisterer, blog.NewMock())
+	if mailer != nil {
+		r.mailer = mailer
+	}
+	return r, fc
+}
+
+func TestBuild(t *testing.T) {
+	body, err := testIncident.build("ca.example", "abc123")
+	test.AssertNotError(t, err, "building report")
+	test.Assert(t, strings.HasPrefix(string(body), xml.Header), "report lacks an XML header")
+
+	var doc document
+	err = xml.Unmarshal(body, &doc)
+	test.AssertNotError(t, err, "parsing report")
+	test.AssertEquals(t, doc.XMLName.Space, "urn:ietf:params:xml:ns:iodef-2.0")
+	test.AssertEquals(t, doc.Version, "2.00")
+	test.AssertEquals(t, doc.Incident.Purpose, "reporting")
+	test.AssertEquals(t, doc.Incident.IncidentID.Name, "ca.example")
+	test.AssertEquals(t, doc.Incident.IncidentID.Value, "abc123")
+	test.AssertEquals(t, doc.Incident.GenerationTime, "2023-07-01T12:00:00Z")
+	test.AssertEquals(t, doc.Incident.Contact.Role, "creator")
+	test.AssertEquals(t, len(doc.Incident.AdditionalData), 5)
+	test.AssertEquals(t, doc.Incident.AdditionalData[0].Value, "www.example.com")
+	test.AssertEquals(t, doc.Incident.AdditionalData[3].Value, "1234")
+}
+
+func TestReportMailto(t *testing.T) {
+	mailer := &mocks.Mailer{}
+	r, _ := newTestReporter(mailer, nil)
+
+	r.Report(testIncident, []string{"mailto:security@example.com"})
+	test.AssertEquals(t, len(r.queue), 1)
+	err := r.deliver(<-r.queue)
+	test.AssertNotError(t, err, "delivering report")
+
+	test.AssertEquals(t, len(mailer.Messages), 1)
+	msg := mailer.Messages[0]
+	test.AssertEquals(t, msg.To, "security@example.com")
+	test.AssertEquals(t, msg.Subject, "CAA incident report for www.example.com")
+	test.AssertContains(t, msg.Body, "ca.example refused a request for a certificate for www.example.com")
+	test.AssertContains(t, msg.Body, "<IODEF-Document")
+
+	// Without a mailer, mailto: reports fail to deliver.
+	r.mailer = nil
+	r.clk.(clock.FakeClock).Add(2 * time.Hour)
+	r.Report(testIncident, []string{"mailto:security@example.com"})
+	err = r.deliver(<-r.queue)
+	test.AssertError(t, err, "delivered a mailto: report without a mailer")
+}
+
+func TestReportHTTPS(t *testing.T) {
+	var gotType, gotBody string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		gotType = req.Header.Get("Content-Type")
+		gotBody = string(body)
+		if req.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	r, fc := newTestReporter(nil, srv.Client())
+	r.Report(testIncident, []string{srv.URL + "/iodef"})
+	err := r.deliver(<-r.queue)
+	test.AssertNotError(t, err, "delivering report")
+	test.AssertEquals(t, gotType, "application/xml")
+	test.AssertContains(t, gotBody, "<IODEF-Document")
+
+	fc.Add(2 * time.Hour)
+	r.Report(testIncident, []string{srv.URL + "/fail"})
+	err = r.deliver(<-r.queue)
+	test.AssertError(t, err, "delivered a report to a failing server")
+}
+
+func TestPostRestrictions(t *testing.T) {
+	var posted bool
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		posted = true
+	}))
+	defer srv.Close()
+
+	// The test server listens on a loopback address, which NewHTTPClient
+	// refuses to connect to.
+	r, _ := newTestReporter(nil, NewHTTPClient(time.Second))
+	u, err := url.Parse(srv.URL)
+	test.AssertNotError(t, err, "parsing test server URL")
+	err = r.post(report{incident: testIncident, url: u, body: []byte("<IODEF-Document/>")})
+	test.AssertError(t, err, "posted a report to a loopback address")
+	test.AssertContains(t, err.Error(), "reserved IP address")
+	test.Assert(t, !posted, "test server received a report")
+
+	// Plain http: URLs are never posted to, even with a permissive client.
+	r, _ = newTestReporter(nil, srv.Client())
+	u.Scheme = "http"
+	err = r.post(report{incident: testIncident, url: u, body: []byte("<IODEF-Document/>")})
+	test.AssertError(t, err, "posted a report to an http: URL")
+	test.Assert(t, !posted, "test server received a report")
+}
+
+func TestReportFiltering(t *testing.T) {
+	r, fc := newTestReporter(&mocks.Mailer{}, nil)
+
+	// Unsupported schemes are skipped, and at most maxURLs are queued.
+	r.Report(testIncident, []string{
+		"http://example.com/iodef",
+		"mailto:a@example.com",
+		"mailto:b@example.com",
+		"mailto:c@example.com",
+	})
+	test.AssertEquals(t, len(r.queue), 2)
+	test.AssertMetricWithLabelsEquals(t, r.reports, prometheus.Labels{"scheme": "", "result": "unsupported"}, 1)
+	<-r.queue
+	<-r.queue
+
+	// Reports about the same registered domain are rate limited, but not
+	// other domains.
+	r.Report(testIncident, []string{"mailto:a@example.com"})
+	test.AssertEquals(t, len(r.queue), 0)
+	test.AssertMetricWithLabelsEquals(t, r.reports, prometheus.Labels{"scheme": "", "result": "ratelimited"}, 1)
+	sub := testIncident
+	sub.FoundAt = "sub.example.com"
+	r.Report(sub, []string{"mailto:z@example.com"})
+	test.AssertEquals(t, len(r.queue), 0)
+	test.AssertMetricWithLabelsEquals(t, r.reports, prometheus.Labels{"scheme": "", "result": "ratelimited"}, 2)
+	other := testIncident
+	other.FoundAt = "example.net"
+	r.Report(other, []string{"mailto:a@example.net"})
+	test.AssertEquals(t, len(r.queue), 1)
+	<-r.queue
+
+	fc.Add(2 * time.Hour)
+	r.Report(testIncident, []string{"mailto:a@example.com"})
+	test.AssertEquals(t, len(r.queue), 1)
+}
+
+func TestReportSharedDestination(t *testing.T) {
+	r, fc := newTestReporter(&mocks.Mailer{}, nil)
+
+	// CAA records at many unrelated names can all request reports at the same
+	// address or host, which receives only one report per interval.
+	for i, foundAt := range []string{"example.com", "example.net", "example.org"} {
+		incident := testIncident
+		incident.FoundAt = foundAt
+		r.Report(incident, []string{"mailto:Victim@example.com", "https://victim.example/iodef"})
+		if i == 0 {
+			test.AssertEquals(t, len(r.queue), 2)
+			<-r.queue
+			<-r.queue
+			continue
+		}
+		test.AssertEquals(t, len(r.queue), 0)
+	}
+	test.AssertMetricWithLabelsEquals(t, r.reports, prometheus.Labels{"scheme": "mailto", "result": "ratelimited"}, 2)
+	test.AssertMetricWithLabelsEquals(t, r.reports, prometheus.Labels{"scheme": "https", "result": "ratelimited"}, 2)
+
+	// Other addresses and hosts aren't limited, and the same destinations
+	// can be reported to again after the interval.
+	incident := testIncident
+	incident.FoundAt = "example.edu"
+	r.Report(incident, []string{"mailto:victim@example.com", "https://victim.example/other", "mailto:other@example.com"})
+	test.AssertEquals(t, len(r.queue), 1)
+	<-r.queue
+
+	fc.Add(2 * time.Hour)
+	incident.FoundAt = "example.info"
+	r.Report(incident, []string{"mailto:victim@example.com", "https://VICTIM.example/iodef"})
+	test.AssertEquals(t, len(r.queue), 2)
+}
@@ -0,0 +1,105 @@
+package iodef
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Incident describes a certificate request which was refused because the CAA
+// records for the identifier did not permit issuance.
+type Incident struct {
+	// Identifier is the name for which issuance was requested.
+	Identifier string
+	// FoundAt is the name at which the relevant CAA RRSet was found.
+	FoundAt string
+	// Records is the relevant CAA RRSet, in presentation format.
+	Records []string
+	// AccountID is the ACME account which requested issuance.
+	AccountID int64
+	// ValidationMethod is the ACME challenge type used by the request.
+	ValidationMethod string
+	// Time is when the CAA check failed.
+	Time time.Time
+}
+
+// The types below are a minimal subset of the RFC 7970 IODEF data model,
+// sufficient to carry the information in an Incident.
+//
+// https://www.rfc-editor.org/rfc/rfc7970
+
+type document struct {
+	XMLName  xml.Name `xml:"urn:ietf:params:xml:ns:iodef-2.0 IODEF-Document"`
+	Version  string   `xml:"version,attr"`
+	Lang     string   `xml:"xml:lang,attr"`
+	Incident incident `xml:"Incident"`
+}
+
+type incident struct {
+	Purpose        string           `xml:"purpose,attr"`
+	IncidentID     incidentID       `xml:"IncidentID"`
+	GenerationTime string           `xml:"GenerationTime"`
+	Description    string           `xml:"Description"`
+	Contact        contact          `xml:"Contact"`
+	AdditionalData []additionalData `xml:"AdditionalData"`
+}
+
+type incidentID struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type contact struct {
+	Role        string `xml:"role,attr"`
+	Type        string `xml:"type,attr"`
+	ContactName string `xml:"ContactName"`
+}
+
+type additionalData struct {
+	DType   string `xml:"dtype,attr"`
+	Meaning string `xml:"meaning,attr"`
+	Value   string `xml:",chardata"`
+}
+
+// description returns a human-readable account of the incident.
+func (i Incident) description(issuerDomain string) string {
+	return fmt.Sprintf(
+		"%s refused a request for a certificate for %s because the CAA records at %s do not permit it to issue.",
+		issuerDomain, i.Identifier, i.FoundAt)
+}
+
+// build returns the RFC 7970 IODEF document reporting the incident, which is
+// identified by id and attributed to the CA identified by issuerDomain.
+func (i Incident) build(issuerDomain string, id string) ([]byte, error) {
+	doc := document{
+		Version: "2.00",
+		Lang:    "en",
+		Incident: incident{
+			Purpose: "reporting",
+			IncidentID: incidentID{
+				Name:  issuerDomain,
+				Value: id,
+			},
+			GenerationTime: i.Time.UTC().Format(time.RFC3339),
+			Description:    i.description(issuerDomain),
+			Contact: contact{
+				Role:        "creator",
+				Type:        "organization",
+				ContactName: issuerDomain,
+			},
+			AdditionalData: []additionalData{
+				{DType: "string", Meaning: "identifier", Value: i.Identifier},
+				{DType: "string", Meaning: "caa-found-at", Value: i.FoundAt},
+				{DType: "string", Meaning: "caa-records", Value: strings.Join(i.Records, "\n")},
+				{DType: "integer", Meaning: "acme-account-id", Value: fmt.Sprintf("%d", i.AccountID)},
+				{DType: "string", Meaning: "acme-validation-method", Value: i.ValidationMethod},
+			},
+		},
+	}
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,125 @@
+package va
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+)
+
+// ConcurrencyLimits bounds the number of validations the VA will perform at
+// once against a single host. Validations in excess of a limit wait for a slot
+// to free up until their deadline rather than failing immediately.
+type ConcurrencyLimits struct {
+	// PerIP is the greatest number of connections which may be open to a
+	// single IP address for HTTP-01 and TLS-ALPN-01 validation. Zero means no
+	// limit.
+	PerIP int `validate:"min=0"`
+	// PerDomain is the greatest number of HTTP-01 and TLS-ALPN-01 validations
+	// which may be in progress for identifiers sharing a registered domain (the
+	// public suffix plus one label). Zero means no limit.
+	PerDomain int `validate:"min=0"`
+}
+
+// keySlots is the semaphore for a single key of a hostLimiter. refs counts the
+// holders and waiters of the semaphore so that it can be discarded once idle.
+type keySlots struct {
+	sem  chan struct{}
+	refs int
+}
+
+// hostLimiter limits the number of concurrent holders of a slot for any one
+// key, such as an IP address or registered domain. A nil hostLimiter, or one
+// with a max of zero, imposes no limit.
+type hostLimiter struct {
+	kind  string
+	max   int
+	clk   clock.Clock
+	depth prometheus.Gauge
+	wait  prometheus.Observer
+
+	mu    sync.Mutex
+	slots map[string]*keySlots
+}
+
+func newHostLimiter(kind string, max int, clk clock.Clock, depth *prometheus.GaugeVec, wait *prometheus.HistogramVec) *hostLimiter {
+	return &hostLimiter{
+		kind:  kind,
+		max:   max,
+		clk:   clk,
+		depth: depth.WithLabelValues(kind),
+		wait:  wait.WithLabelValues(kind),
+		slots: make(map[string]*keySlots),
+	}
+}
+
+// acquire blocks until a slot for key is available or ctx is done. On success
+// it returns a function which must be called to release the slot; the function
+// may safely be called more than once.
+func (l *hostLimiter) acquire(ctx context.Context, key string) (func(), error) {
+	if l == nil || l.max <= 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	s, ok := l.slots[key]
+	if !ok {
+		s = &keySlots{sem: make(chan struct{}, l.max)}
+		l.slots[key] = s
+	}
+	s.refs++
+	l.mu.Unlock()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			<-s.sem
+			l.unref(key, s)
+		})
+	}
+
+	select {
+	case s.sem <- struct{}{}:
+		l.wait.Observe(0)
+		return release, nil
+	default:
+	}
+
+	l.depth.Inc()
+	defer l.depth.Dec()
+	start := l.clk.Now()
+	select {
+	case s.sem <- struct{}{}:
+		l.wait.Observe(l.clk.Since(start).Seconds())
+		return release, nil
+	case <-ctx.Done():
+		l.wait.Observe(l.clk.Since(start).Seconds())
+		l.unref(key, s)
+		return nil, berrors.ConnectionFailureError(
+			"Timed out waiting to validate: too many concurrent validations for %s %s", l.kind, key)
+	}
+}
+
+func (l *hostLimiter) unref(key string, s *keySlots) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s.refs--
+	if s.refs == 0 {
+		delete(l.slots, key)
+	}
+}
+
+// limitedConn is a net.Conn which releases a hostLimiter slot when closed.
+type limitedConn struct {
+	net.Conn
+	release func()
+}
+
+func (c *limitedConn) Close() error {
+	defer c.release()
+	return c.Conn.Close()
+}
@@ -0,0 +1,127 @@
+package va
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func testHostLimiter(kind string, max int) *hostLimiter {
+	m := initMetrics(metrics.NoopRegisterer)
+	return newHostLimiter(kind, max, clock.NewFake(), m.validationQueueDepth, m.validationQueueWaitTime)
+}
+
+func TestHostLimiterUnlimited(t *testing.T) {
+	var nilLimiter *hostLimiter
+	release, err := nilLimiter.acquire(context.Background(), "example.com")
+	test.AssertNotError(t, err, "acquiring from a nil limiter")
+	release()
+
+	l := testHostLimiter("domain", 0)
+	for i := 0; i < 10; i++ {
+		_, err := l.acquire(context.Background(), "example.com")
+		test.AssertNotError(t, err, "acquiring from an unlimited limiter")
+	}
+}
+
+func TestHostLimiterWaits(t *testing.T) {
+	l := testHostLimiter("ip", 1)
+
+	release, err := l.acquire(context.Background(), "10.0.0.1")
+	test.AssertNotError(t, err, "acquiring the only slot")
+
+	// Other keys are unaffected.
+	otherRelease, err := l.acquire(context.Background(), "10.0.0.2")
+	test.AssertNotError(t, err, "acquiring a slot for another key")
+	otherRelease()
+
+	acquired := make(chan error)
+	go func() {
+		secondRelease, err := l.acquire(context.Background(), "10.0.0.1")
+		if err == nil {
+			secondRelease()
+		}
+		acquired <- err
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired a slot while the only slot was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+	test.AssertMetricWithLabelsEquals(t, l.depth.(prometheus.Collector), prometheus.Labels{}, 1)
+
+	// Releasing more than once must not free a second slot.
+	release()
+	release()
+	test.AssertNotError(t, <-acquired, "waiting for a released slot")
+	test.AssertMetricWithLabelsEquals(t, l.depth.(prometheus.Collector), prometheus.Labels{}, 0)
+	test.AssertEquals(t, len(l.slots), 0)
+}
+
+func TestHostLimiterDeadline(t *testing.T) {
+	l := testHostLimiter("domain", 1)
+
+	release, err := l.acquire(context.Background(), "example.com")
+	test.AssertNotError(t, err, "acquiring the only slot")
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, "example.com")
+	test.AssertError(t, err, "acquired a slot while the only slot was held")
+	test.AssertErrorIs(t, err, berrors.ConnectionFailure)
+	test.AssertEquals(t, l.slots["example.com"].refs, 1)
+}
+
+func TestValidateHTTPConcurrencyLimits(t *testing.T) {
+	chall := core.HTTPChallenge01("")
+	setChallengeToken(&chall, core.NewToken())
+
+	hs := httpSrv(t, chall.Token)
+	defer hs.Close()
+
+	va, _ := setup(hs, 0, "", nil)
+	va.ipLimiter = testHostLimiter("ip", 1)
+	va.domainLimiter = testHostLimiter("domain", 1)
+
+	for _, tc := range []struct {
+		limiter *hostLimiter
+		key     string
+		detail  string
+	}{
+		{va.ipLimiter, "127.0.0.1", "127.0.0.1: Fetching http://localhost/.well-known/acme-challenge/"},
+		{va.domainLimiter, "localhost", "Timed out waiting to validate: too many concurrent validations for domain localhost"},
+	} {
+		release, err := tc.limiter.acquire(context.Background(), tc.key)
+		test.AssertNotError(t, err, "acquiring the only slot")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+		cancel()
+		test.Assert(t, prob != nil, "validated while the only slot was held")
+		test.AssertEquals(t, prob.Type, probs.ConnectionProblem)
+		test.Assert(t, strings.HasPrefix(prob.Detail, tc.detail), prob.Detail)
+		release()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	test.Assert(t, prob == nil, "validation failed once slots were released")
+	// The per-IP slot is released asynchronously when the HTTP transport
+	// closes its connection, so only the per-domain slot is checked here.
+	va.domainLimiter.mu.Lock()
+	defer va.domainLimiter.mu.Unlock()
+	test.AssertEquals(t, len(va.domainLimiter.slots), 0)
+}
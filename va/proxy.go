@@ -0,0 +1,268 @@
+package va
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+)
+
+// egressProxy tunnels HTTP-01 and TLS-ALPN-01 validation connections through
+// a SOCKS5 or HTTP CONNECT proxy. The proxy is always asked to connect to the
+// IP address the VA resolved, never to a hostname, so that the proxy can't
+// substitute its own DNS resolution for the VA's.
+type egressProxy struct {
+	url *url.URL
+}
+
+// newEgressProxy parses a proxy URL of the form socks5://host:port or
+// http://host:port, optionally with credentials in the userinfo. An empty URL
+// returns a nil proxy, meaning connections are made directly.
+func newEgressProxy(rawURL string) (*egressProxy, error) {
+	if rawURL == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing egress proxy URL: %w", err)
+	}
+	if u.Scheme != "socks5" && u.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported egress proxy scheme %q", u.Scheme)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("egress proxy URL %q has no port", u.Redacted())
+	}
+	return &egressProxy{url: u}, nil
+}
+
+// String returns the proxy URL without any password, for logging.
+func (p *egressProxy) String() string {
+	if p == nil {
+		return ""
+	}
+	return p.url.Redacted()
+}
+
+// dial uses dialer to connect to the proxy and asks it to connect to addr,
+// which must be an IP address and port. The whole exchange is bounded by the
+// dialer's timeout, if it has one, as well as by ctx.
+func (p *egressProxy) dial(ctx context.Context, dialer *net.Dialer, network, addr string) (net.Conn, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, fmt.Errorf("egress proxy target %q is not an IP address", host)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q: %w", portStr, err)
+	}
+
+	if dialer.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dialer.Timeout)
+		defer cancel()
+	}
+	conn, err := dialer.DialContext(ctx, network, p.url.Host)
+	if err != nil {
+		return nil, err
+	}
+	// The handshake with the proxy is bounded by the context's deadline.
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	var tunnel net.Conn
+	switch p.url.Scheme {
+	case "socks5":
+		tunnel, err = p.socks5Connect(conn, ip, uint16(port))
+	case "http":
+		tunnel, err = p.httpConnect(conn, net.JoinHostPort(ip.String(), portStr))
+	default:
+		err = fmt.Errorf("unsupported egress proxy scheme %q", p.url.Scheme)
+	}
+	if err != nil {
+		_ = conn.Close()
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			// Report a timeout talking to the proxy as a timeout during
+			// connect, since that's what it looks like to the validation.
+			return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+		}
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return tunnel, nil
+}
+
+// socks5Errors describes the SOCKS5 reply codes (RFC 1928 Section 6) which
+// concern the validation target rather than the proxy itself.
+var socks5Errors = map[byte]string{
+	0x03: "Network unreachable",
+	0x04: "Host unreachable",
+	0x05: "Connection refused",
+	0x06: "Timeout during connect (likely firewall problem)",
+}
+
+// socks5Connect performs the RFC 1928 handshake, with RFC 1929
+// username/password authentication if the proxy URL has credentials, and asks
+// the proxy to CONNECT to ip and port.
+func (p *egressProxy) socks5Connect(conn net.Conn, ip net.IP, port uint16) (net.Conn, error) {
+	methods := []byte{0x00}
+	if p.url.User != nil {
+		methods = []byte{0x02}
+	}
+	_, err := conn.Write(append([]byte{0x05, byte(len(methods))}, methods...))
+	if err != nil {
+		return nil, fmt.Errorf("writing SOCKS5 greeting: %w", err)
+	}
+	var choice [2]byte
+	_, err = io.ReadFull(conn, choice[:])
+	if err != nil {
+		return nil, fmt.Errorf("reading SOCKS5 method: %w", err)
+	}
+	if choice[0] != 0x05 {
+		return nil, fmt.Errorf("unexpected SOCKS version %d", choice[0])
+	}
+	switch choice[1] {
+	case 0x00:
+	case 0x02:
+		if p.url.User == nil {
+			return nil, errors.New("SOCKS5 proxy requires credentials")
+		}
+		user := p.url.User.Username()
+		pass, _ := p.url.User.Password()
+		if len(user) > 255 || len(pass) > 255 {
+			return nil, errors.New("SOCKS5 credentials are too long")
+		}
+		auth := []byte{0x01, byte(len(user))}
+		auth = append(auth, user...)
+		auth = append(auth, byte(len(pass)))
+		auth = append(auth, pass...)
+		_, err = conn.Write(auth)
+		if err != nil {
+			return nil, fmt.Errorf("writing SOCKS5 credentials: %w", err)
+		}
+		var status [2]byte
+		_, err = io.ReadFull(conn, status[:])
+		if err != nil {
+			return nil, fmt.Errorf("reading SOCKS5 authentication status: %w", err)
+		}
+		if status[1] != 0x00 {
+			return nil, errors.New("SOCKS5 proxy rejected credentials")
+		}
+	default:
+		return nil, errors.New("SOCKS5 proxy accepted no offered authentication method")
+	}
+
+	req := []byte{0x05, 0x01, 0x00}
+	if ip4 := ip.To4(); ip4 != nil {
+		req = append(req, 0x01)
+		req = append(req, ip4...)
+	} else {
+		req = append(req, 0x04)
+		req = append(req, ip.To16()...)
+	}
+	req = binary.BigEndian.AppendUint16(req, port)
+	_, err = conn.Write(req)
+	if err != nil {
+		return nil, fmt.Errorf("writing SOCKS5 request: %w", err)
+	}
+
+	var reply [4]byte
+	_, err = io.ReadFull(conn, reply[:])
+	if err != nil {
+		return nil, fmt.Errorf("reading SOCKS5 reply: %w", err)
+	}
+	if reply[1] != 0x00 {
+		if msg, ok := socks5Errors[reply[1]]; ok {
+			return nil, berrors.ConnectionFailureError(msg)
+		}
+		return nil, fmt.Errorf("SOCKS5 proxy failed to connect: reply code %d", reply[1])
+	}
+	// Discard the bound address, whose length depends on its type.
+	var addrLen int
+	switch reply[3] {
+	case 0x01:
+		addrLen = net.IPv4len
+	case 0x04:
+		addrLen = net.IPv6len
+	case 0x03:
+		var l [1]byte
+		_, err = io.ReadFull(conn, l[:])
+		if err != nil {
+			return nil, fmt.Errorf("reading SOCKS5 bound address: %w", err)
+		}
+		addrLen = int(l[0])
+	default:
+		return nil, fmt.Errorf("unexpected SOCKS5 address type %d", reply[3])
+	}
+	_, err = io.CopyN(io.Discard, conn, int64(addrLen+2))
+	if err != nil {
+		return nil, fmt.Errorf("reading SOCKS5 bound address: %w", err)
+	}
+	return conn, nil
+}
+
+// httpConnect asks an HTTP proxy to open a tunnel to hostPort, which is an IP
+// address and port, using the CONNECT method.
+func (p *egressProxy) httpConnect(conn net.Conn, hostPort string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: hostPort},
+		Host:   hostPort,
+		Header: make(http.Header),
+	}
+	if p.url.User != nil {
+		pass, _ := p.url.User.Password()
+		creds := base64.StdEncoding.EncodeToString([]byte(p.url.User.Username() + ":" + pass))
+		req.Header.Set("Proxy-Authorization", "Basic "+creds)
+	}
+	err := req.Write(conn)
+	if err != nil {
+		return nil, fmt.Errorf("writing CONNECT request: %w", err)
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, fmt.Errorf("reading CONNECT response: %w", err)
+	}
+	_ = resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadGateway:
+		return nil, berrors.ConnectionFailureError("Connection failed")
+	case http.StatusGatewayTimeout:
+		return nil, berrors.ConnectionFailureError("Timeout during connect (likely firewall problem)")
+	default:
+		return nil, fmt.Errorf("HTTP proxy refused CONNECT: %s", resp.Status)
+	}
+	if br.Buffered() > 0 {
+		// The target spoke first, and some of what it said was read along
+		// with the proxy's response.
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn whose first reads are served from a buffer.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/va/evidence"
 )
 
 const (
@@ -60,12 +61,13 @@ func (va *ValidationAuthorityImpl) tryGetChallengeCert(ctx context.Context,
 	identifier identifier.ACMEIdentifier, challenge core.Challenge,
 	tlsConfig *tls.Config) (*x509.Certificate, *tls.ConnectionState, []core.ValidationRecord, *probs.ProblemDetails) {
 
-	allAddrs, err := va.getAddrs(ctx, identifier.Value)
+	allAddrs, dnssec, err := va.getAddrs(ctx, identifier.Value)
 	validationRecords := []core.ValidationRecord{
 		{
 			Hostname:          identifier.Value,
 			AddressesResolved: allAddrs,
 			Port:              strconv.Itoa(va.tlsPort),
+			DNSSEC:            string(dnssec),
 		},
 	}
 	if err != nil {
@@ -149,6 +151,7 @@ func (va *ValidationAuthorityImpl) getChallengeCert(
 
 	cs := conn.ConnectionState()
 	certs := cs.PeerCertificates
+	evidence.FromContext(ctx).AddTLSPeer(hostPort, certs)
 	if len(certs) == 0 {
 		va.log.Infof("%s challenge for %s resulted in no certificates", challenge.Type, identifier.Value)
 		return nil, nil, probs.Unauthorized(fmt.Sprintf("No certs presented for %s challenge", challenge.Type))
@@ -163,13 +166,30 @@ func (va *ValidationAuthorityImpl) getChallengeCert(
 // tlsDial does the equivalent of tls.Dial, but obeying a context. Once
 // tls.DialContextWithDialer is available, switch to that.
 func (va *ValidationAuthorityImpl) tlsDial(ctx context.Context, hostPort string, config *tls.Config) (*tls.Conn, error) {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for a connection slot within the overall request deadline, before
+	// the single dial timeout starts.
+	release, err := va.ipLimiter.acquire(ctx, host)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithTimeout(ctx, va.singleDialTimeout)
 	defer cancel()
 	dialer := &net.Dialer{}
-	netConn, err := dialer.DialContext(ctx, "tcp", hostPort)
+	var rawConn net.Conn
+	if va.egressProxy != nil {
+		rawConn, err = va.egressProxy.dial(ctx, dialer, "tcp", hostPort)
+	} else {
+		rawConn, err = dialer.DialContext(ctx, "tcp", hostPort)
+	}
 	if err != nil {
+		release()
 		return nil, err
 	}
+	netConn := &limitedConn{Conn: rawConn, release: release}
 	deadline, ok := ctx.Deadline()
 	if !ok {
 		va.log.AuditErr("tlsDial was called without a deadline")
@@ -179,6 +199,7 @@ func (va *ValidationAuthorityImpl) tlsDial(ctx context.Context, hostPort string,
 	conn := tls.Client(netConn, config)
 	err = conn.Handshake()
 	if err != nil {
+		_ = conn.Close()
 		return nil, err
 	}
 	return conn, nil
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
@@ -27,10 +29,26 @@ import (
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/probs"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/va/evidence"
+	"github.com/letsencrypt/boulder/va/iodef"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// evidenceSaveTimeout is how long saving the evidence collected during a
+// validation may take.
+const evidenceSaveTimeout = 30 * time.Second
+
+// lateResultsStoreTimeout is how long storing the results of remote VAs which
+// reported after a validation's outcome was decided may take.
+const lateResultsStoreTimeout = 30 * time.Second
+
+// sharedValidationTimeout bounds a validation which is shared by identical
+// requests, since it can't be bounded by the deadline of any one of them. It is
+// comparable to the deadline the RA gives its calls to the VA.
+const sharedValidationTimeout = 20 * time.Second
+
 var (
 	// badTLSHeader contains the string 'HTTP /' which is returned when
 	// we try to talk TLS to a server that only talks HTTP
@@ -65,12 +83,20 @@ var (
 	h2SettingsFrameErrRegex = regexp.MustCompile(`(?:net\/http\: HTTP\/1\.x transport connection broken: )?malformed HTTP response \"\\x00\\x00\\x[a-f0-9]{2}\\x04\\x00\\x00\\x00\\x00\\x00.*"`)
 )
 
-// RemoteVA wraps the vapb.VAClient interface and adds a field containing the
-// address of the remote gRPC server since the underlying gRPC client doesn't
-// provide a way to extract this metadata which is useful for debugging gRPC
-// connection issues.
-type RemoteVA struct {
+// RemoteClients wraps the vapb.VAClient and vapb.CAAClient interfaces, which
+// are served by every VA and which a primary VA uses to ask a remote VA for
+// confirmation of its validations and CAA checks respectively.
+type RemoteClients struct {
 	vapb.VAClient
+	vapb.CAAClient
+}
+
+// RemoteVA wraps the RemoteClients and adds a field containing the address of
+// the remote gRPC server since the underlying gRPC client doesn't provide a way
+// to extract this metadata which is useful for debugging gRPC connection
+// issues.
+type RemoteVA struct {
+	RemoteClients
 	Address string
 }
 
@@ -80,11 +106,17 @@ type vaMetrics struct {
 	remoteValidationTime                *prometheus.HistogramVec
 	remoteValidationFailures            prometheus.Counter
 	prospectiveRemoteValidationFailures prometheus.Counter
+	remoteCAACheckTime                  *prometheus.HistogramVec
+	remoteCAACheckFailures              prometheus.Counter
+	prospectiveRemoteCAACheckFailures   prometheus.Counter
 	tlsALPNOIDCounter                   *prometheus.CounterVec
 	http01Fallbacks                     prometheus.Counter
 	http01Redirects                     prometheus.Counter
 	caaCounter                          *prometheus.CounterVec
 	ipv4FallbackCounter                 prometheus.Counter
+	validationQueueDepth                *prometheus.GaugeVec
+	validationQueueWaitTime             *prometheus.HistogramVec
+	collapsedValidations                *prometheus.CounterVec
 }
 
 func initMetrics(stats prometheus.Registerer) *vaMetrics {
@@ -124,6 +156,26 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 			Help: "Number of validations that would have failed due to remote VAs returning failure if consesus were enforced",
 		})
 	stats.MustRegister(prospectiveRemoteValidationFailures)
+	remoteCAACheckTime := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_caa_check_time",
+			Help:    "Time taken to remotely check CAA",
+			Buckets: metrics.InternetFacingBuckets,
+		},
+		[]string{"result"})
+	stats.MustRegister(remoteCAACheckTime)
+	remoteCAACheckFailures := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "remote_caa_check_failures",
+			Help: "Number of CAA checks failed due to remote VAs returning failure when consensus is enforced",
+		})
+	stats.MustRegister(remoteCAACheckFailures)
+	prospectiveRemoteCAACheckFailures := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "prospective_remote_caa_check_failures",
+			Help: "Number of CAA checks that would have failed due to remote VAs returning failure if consensus were enforced",
+		})
+	stats.MustRegister(prospectiveRemoteCAACheckFailures)
 	tlsALPNOIDCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "tls_alpn_oid_usage",
@@ -154,6 +206,24 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 		Help: "A counter of IPv4 fallbacks during TLS ALPN validation",
 	})
 	stats.MustRegister(ipv4FallbackCounter)
+	validationQueueDepth := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validation_queue_depth",
+		Help: "Number of validations waiting for a per-IP or per-domain concurrency slot",
+	}, []string{"kind"})
+	stats.MustRegister(validationQueueDepth)
+	validationQueueWaitTime := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "validation_queue_wait_time",
+			Help:    "Time spent waiting for a per-IP or per-domain concurrency slot",
+			Buckets: metrics.InternetFacingBuckets,
+		},
+		[]string{"kind"})
+	stats.MustRegister(validationQueueWaitTime)
+	collapsedValidations := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "collapsed_validations",
+		Help: "Number of validations which shared the result of an identical validation already in progress",
+	}, []string{"type"})
+	stats.MustRegister(collapsedValidations)
 
 	return &vaMetrics{
 		validationTime:                      validationTime,
@@ -161,11 +231,17 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 		localValidationTime:                 localValidationTime,
 		remoteValidationFailures:            remoteValidationFailures,
 		prospectiveRemoteValidationFailures: prospectiveRemoteValidationFailures,
+		remoteCAACheckTime:                  remoteCAACheckTime,
+		remoteCAACheckFailures:              remoteCAACheckFailures,
+		prospectiveRemoteCAACheckFailures:   prospectiveRemoteCAACheckFailures,
 		tlsALPNOIDCounter:                   tlsALPNOIDCounter,
 		http01Fallbacks:                     http01Fallbacks,
 		http01Redirects:                     http01Redirects,
 		caaCounter:                          caaCounter,
 		ipv4FallbackCounter:                 ipv4FallbackCounter,
+		validationQueueDepth:                validationQueueDepth,
+		validationQueueWaitTime:             validationQueueWaitTime,
+		collapsedValidations:                collapsedValidations,
 	}
 }
 
@@ -200,18 +276,46 @@ func newDefaultPortConfig() *portConfig {
 type ValidationAuthorityImpl struct {
 	vapb.UnimplementedVAServer
 	vapb.UnimplementedCAAServer
-	log                blog.Logger
-	dnsClient          bdns.Client
-	issuerDomain       string
-	httpPort           int
-	httpsPort          int
-	tlsPort            int
-	userAgent          string
-	clk                clock.Clock
-	remoteVAs          []RemoteVA
-	maxRemoteFailures  int
-	accountURIPrefixes []string
-	singleDialTimeout  time.Duration
+	log                  blog.Logger
+	dnsClient            bdns.Client
+	issuerDomain         string
+	httpPort             int
+	httpsPort            int
+	tlsPort              int
+	userAgent            string
+	clk                  clock.Clock
+	remoteVAs            []RemoteVA
+	maxRemoteFailures    int
+	maxRemoteCAAFailures int
+	accountURIPrefixes   []string
+	singleDialTimeout    time.Duration
+	// perspective and rir identify this VA, and the Regional Internet Registry
+	// in which it's located, in the per-perspective results it returns.
+	perspective string
+	rir         string
+	// cnamePolicies are the CNAME policies for DNS-01 validations, by the
+	// account they apply to.
+	cnamePolicies map[int64]CNAMEPolicy
+	// evidenceArchive, if not nil, stores the raw DNS, HTTP and TLS responses
+	// seen during each validation.
+	evidenceArchive *evidence.Archive
+	// iodefReporter, if not nil, reports issuance forbidden by CAA to the
+	// iodef URLs in the relevant CAA records.
+	iodefReporter *iodef.Reporter
+	// egressProxy, if not nil, is the proxy through which HTTP-01 and
+	// TLS-ALPN-01 validation connections are made.
+	egressProxy *egressProxy
+	// ipLimiter and domainLimiter bound the number of concurrent HTTP-01 and
+	// TLS-ALPN-01 validations against a single IP address and registered
+	// domain respectively.
+	ipLimiter     *hostLimiter
+	domainLimiter *hostLimiter
+	// inflight collapses concurrent identical validations when the
+	// DeduplicateValidations feature is enabled.
+	inflight *validationGroup
+	// sa, if not nil, stores the results of remote VAs which only report after
+	// a validation's outcome has been decided.
+	sa sapb.StorageAuthorityClient
 
 	metrics *vaMetrics
 }
@@ -221,33 +325,66 @@ func NewValidationAuthorityImpl(
 	resolver bdns.Client,
 	remoteVAs []RemoteVA,
 	maxRemoteFailures int,
+	maxRemoteCAAFailures int,
 	userAgent string,
 	issuerDomain string,
 	stats prometheus.Registerer,
 	clk clock.Clock,
 	logger blog.Logger,
 	accountURIPrefixes []string,
+	perspective string,
+	rir string,
+	cnamePolicies []CNAMEPolicy,
+	evidenceArchive *evidence.Archive,
+	concurrencyLimits ConcurrencyLimits,
+	iodefReporter *iodef.Reporter,
+	egressProxyURL string,
+	sa sapb.StorageAuthorityClient,
 ) (*ValidationAuthorityImpl, error) {
 
 	if features.Enabled(features.CAAAccountURI) && len(accountURIPrefixes) == 0 {
 		return nil, errors.New("no account URI prefixes configured")
 	}
 
+	cnamePoliciesByAccount, err := newCNAMEPolicies(cnamePolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := newEgressProxy(egressProxyURL)
+	if err != nil {
+		return nil, err
+	}
+
 	pc := newDefaultPortConfig()
+	vaMetrics := initMetrics(stats)
 
 	va := &ValidationAuthorityImpl{
-		log:                logger,
-		dnsClient:          resolver,
-		issuerDomain:       issuerDomain,
-		httpPort:           pc.HTTPPort,
-		httpsPort:          pc.HTTPSPort,
-		tlsPort:            pc.TLSPort,
-		userAgent:          userAgent,
-		clk:                clk,
-		metrics:            initMetrics(stats),
-		remoteVAs:          remoteVAs,
-		maxRemoteFailures:  maxRemoteFailures,
-		accountURIPrefixes: accountURIPrefixes,
+		log:                  logger,
+		dnsClient:            resolver,
+		issuerDomain:         issuerDomain,
+		httpPort:             pc.HTTPPort,
+		httpsPort:            pc.HTTPSPort,
+		tlsPort:              pc.TLSPort,
+		userAgent:            userAgent,
+		clk:                  clk,
+		metrics:              vaMetrics,
+		remoteVAs:            remoteVAs,
+		maxRemoteFailures:    maxRemoteFailures,
+		maxRemoteCAAFailures: maxRemoteCAAFailures,
+		accountURIPrefixes:   accountURIPrefixes,
+		perspective:          perspective,
+		rir:                  rir,
+		cnamePolicies:        cnamePoliciesByAccount,
+		evidenceArchive:      evidenceArchive,
+		sa:                   sa,
+		iodefReporter:        iodefReporter,
+		egressProxy:          proxy,
+		ipLimiter: newHostLimiter("ip", concurrencyLimits.PerIP, clk,
+			vaMetrics.validationQueueDepth, vaMetrics.validationQueueWaitTime),
+		domainLimiter: newHostLimiter("domain", concurrencyLimits.PerDomain, clk,
+			vaMetrics.validationQueueDepth, vaMetrics.validationQueueWaitTime),
+		inflight: newValidationGroup(),
 		// singleDialTimeout specifies how long an individual `DialContext` operation may take
 		// before timing out. This timeout ignores the base RPC timeout and is strictly
 		// used for the DialContext operations that take place during an
@@ -264,6 +401,7 @@ type verificationRequestEvent struct {
 	Requester         int64          `json:",omitempty"`
 	Hostname          string         `json:",omitempty"`
 	Challenge         core.Challenge `json:",omitempty"`
+	Proxy             string         `json:",omitempty"`
 	ValidationLatency float64
 	Error             string `json:",omitempty"`
 }
@@ -387,11 +525,27 @@ func (va *ValidationAuthorityImpl) validate(
 			accountURIID:     regid,
 			validationMethod: challenge.Type,
 		}
-		ch <- va.checkCAA(ctx, identifier, params)
+		caaReq := &vapb.IsCAAValidRequest{
+			Domain:           identifier.Value,
+			ValidationMethod: string(challenge.Type),
+			AccountURIID:     regid,
+		}
+		remoteResults := va.startRemoteCAACheck(ctx, caaReq)
+		prob, incident := va.checkCAA(ctx, identifier, params)
+		prob = va.finishRemoteCAACheck(caaReq, prob, remoteResults)
+		// Only this VA's own refusal is reported, and only once its final
+		// decision has been made.
+		if prob != nil {
+			va.reportCAAIncident(incident)
+		}
+		ch <- prob
 	}()
 
 	// TODO(#1292): send into another goroutine
 	validationRecords, prob := va.validateChallenge(ctx, baseIdentifier, challenge)
+	if prob == nil && challenge.Type == core.ChallengeTypeDNS01 {
+		prob = va.checkCNAMEPolicy(regid, baseIdentifier.Value, validationRecords)
+	}
 	if prob != nil {
 		// The ProblemDetails will be serialized through gRPC, which requires UTF-8.
 		// It will also later be serialized in JSON, which defaults to UTF-8. Make
@@ -414,6 +568,15 @@ func (va *ValidationAuthorityImpl) validateChallenge(ctx context.Context, identi
 	if err != nil {
 		return nil, probs.Malformed("Challenge failed consistency check: %s", err)
 	}
+	if challenge.Type == core.ChallengeTypeHTTP01 || challenge.Type == core.ChallengeTypeTLSALPN01 {
+		// Only these challenge types connect to the subscriber's servers, so
+		// only they count against the per-domain concurrency limit.
+		release, err := va.domainLimiter.acquire(ctx, registeredDomain(strings.ToLower(identifier.Value)))
+		if err != nil {
+			return nil, detailedError(err)
+		}
+		defer release()
+	}
 	switch challenge.Type {
 	case core.ChallengeTypeHTTP01:
 		return va.validateHTTP01(ctx, identifier, challenge)
@@ -444,6 +607,10 @@ func (va *ValidationAuthorityImpl) performRemoteValidation(
 				VAHostname: rva.Address,
 			}
 			res, err := rva.PerformValidation(ctx, req)
+			if err == nil && res.Perspective != "" {
+				result.Perspective = res.Perspective
+				result.RIR = res.Rir
+			}
 			if err != nil && canceled.Is(err) {
 				// If the non-nil err was a canceled error, ignore it. That's fine: it
 				// just means we cancelled the remote VA request before it was
@@ -486,13 +653,16 @@ func (va *ValidationAuthorityImpl) performRemoteValidation(
 // a chance to respond. This happens if the success or failure threshold is met.
 // This doesn't allow for logging the differential between the primary and
 // remote VAs but is more performant.
+//
+// The remote results read before the overall result was reached are returned
+// alongside it.
 func (va *ValidationAuthorityImpl) processRemoteResults(
 	domain string,
 	acctID int64,
 	challengeType string,
 	primaryResult *probs.ProblemDetails,
 	remoteResultsChan chan *remoteValidationResult,
-	numRemoteVAs int) *probs.ProblemDetails {
+	numRemoteVAs int) (*probs.ProblemDetails, []*remoteValidationResult) {
 
 	state := "failure"
 	start := va.clk.Now()
@@ -533,11 +703,11 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 		if !features.Enabled(features.MultiVAFullResults) {
 			if good >= required {
 				state = "success"
-				return nil
+				return nil, remoteResults
 			} else if bad > va.maxRemoteFailures {
 				modifiedProblem := *result.Problem
 				modifiedProblem.Detail = "During secondary validation: " + firstProb.Detail
-				return &modifiedProblem
+				return &modifiedProblem, remoteResults
 			}
 		}
 
@@ -561,16 +731,16 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 	// Based on the threshold of good/bad return nil or a problem.
 	if good >= required {
 		state = "success"
-		return nil
+		return nil, remoteResults
 	} else if bad > va.maxRemoteFailures {
 		modifiedProblem := *firstProb
 		modifiedProblem.Detail = "During secondary validation: " + firstProb.Detail
-		return &modifiedProblem
+		return &modifiedProblem, remoteResults
 	}
 
 	// This condition should not occur - it indicates the good/bad counts didn't
 	// meet either the required threshold or the maxRemoteFailures threshold.
-	return probs.ServerInternal("Too few remote PerformValidation RPC results")
+	return probs.ServerInternal("Too few remote PerformValidation RPC results"), remoteResults
 }
 
 // logRemoteValidationDifferentials is called by `processRemoteResults` when the
@@ -640,15 +810,110 @@ func (va *ValidationAuthorityImpl) logRemoteValidationDifferentials(
 }
 
 // remoteValidationResult is a struct that combines a problem details instance
-// (that may be nil) with the remote VA hostname that produced it.
+// (that may be nil) with the remote VA hostname that produced it, and the
+// perspective and RIR the remote VA reported, if any.
 type remoteValidationResult struct {
-	VAHostname string
-	Problem    *probs.ProblemDetails
+	VAHostname  string
+	Perspective string `json:",omitempty"`
+	RIR         string `json:",omitempty"`
+	Problem     *probs.ProblemDetails
+}
+
+// perspectiveResultsToPB returns the result of a validation from each
+// perspective: this VA's, followed by each remote VA's. Remote VAs which
+// didn't report a perspective are named by their address.
+func (va *ValidationAuthorityImpl) perspectiveResultsToPB(primaryResult *probs.ProblemDetails, remoteResults []*remoteValidationResult) ([]*corepb.PerspectiveResult, error) {
+	primaryProb, err := bgrpc.ProblemDetailsToPB(primaryResult)
+	if err != nil {
+		return nil, err
+	}
+	results := []*corepb.PerspectiveResult{{
+		Perspective: va.perspective,
+		Rir:         va.rir,
+		Success:     primaryResult == nil,
+		Problem:     primaryProb,
+	}}
+	for _, r := range remoteResults {
+		prob, err := bgrpc.ProblemDetailsToPB(r.Problem)
+		if err != nil {
+			return nil, err
+		}
+		perspective := r.Perspective
+		if perspective == "" {
+			perspective = r.VAHostname
+		}
+		results = append(results, &corepb.PerspectiveResult{
+			Perspective: perspective,
+			Rir:         r.RIR,
+			Success:     r.Problem == nil,
+			Problem:     prob,
+		})
+	}
+	return results, nil
+}
+
+// collectRemoteResults waits for n results from the remote VAs.
+func (va *ValidationAuthorityImpl) collectRemoteResults(remoteResults chan *remoteValidationResult, n int) []*remoteValidationResult {
+	results := make([]*remoteValidationResult, 0, n)
+	for len(results) < n {
+		results = append(results, <-remoteResults)
+	}
+	return results
+}
+
+// remoteValidationContext returns the context for the remote VA RPCs of a
+// validation. If late remote results are stored, remote VAs which are still
+// working when the validation's outcome is decided must be allowed to finish,
+// so the returned context isn't canceled along with ctx, though it keeps
+// ctx's deadline.
+func (va *ValidationAuthorityImpl) remoteValidationContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if va.sa == nil {
+		return context.WithCancel(ctx)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(context.WithoutCancel(ctx))
+	}
+	return context.WithDeadline(context.WithoutCancel(ctx), deadline)
+}
+
+// storeLateRemoteResults waits for the results of the remote VAs which hadn't
+// reported when a validation's outcome was decided, and has the SA store them
+// alongside the perspectives stored with the authorization. It runs in its own
+// goroutine so as not to delay the validation result, and calls cancel once
+// every remote VA has reported.
+func (va *ValidationAuthorityImpl) storeLateRemoteResults(authzID string, remoteResults chan *remoteValidationResult, n int, cancel context.CancelFunc) {
+	results := va.collectRemoteResults(remoteResults, n)
+	cancel()
+
+	id, err := strconv.ParseInt(authzID, 10, 64)
+	if err != nil {
+		va.log.Errf("Storing late remote results for authorization %q: %s", authzID, err)
+		return
+	}
+	perspectives, err := va.perspectiveResultsToPB(nil, results)
+	if err != nil {
+		va.log.Errf("Storing late remote results for authorization %d: %s", id, err)
+		return
+	}
+	ctx, cancelStore := context.WithTimeout(context.Background(), lateResultsStoreTimeout)
+	defer cancelStore()
+	_, err = va.sa.AddValidationPerspectives(ctx, &sapb.AddValidationPerspectivesRequest{
+		AuthzID: id,
+		// The first result is this VA's, which was returned with the rest.
+		Perspectives: perspectives[1:],
+	})
+	if err != nil {
+		va.log.Errf("Storing late remote results for authorization %d: %s", id, err)
+	}
 }
 
 // PerformValidation validates the challenge for the domain in the request.
 // The returned result will always contain a list of validation records, even
-// when it also contains a problem.
+// when it also contains a problem. Its perspectives include the result from
+// every remote VA, except that if the outcome is decided before some remote
+// VAs have reported, their results are instead stored with the SA once they
+// arrive, if the VA has one.
 func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *vapb.PerformValidationRequest) (*vapb.ValidationResult, error) {
 	if core.IsAnyNilOrZero(req, req.Domain, req.Challenge, req.Authz) {
 		return nil, berrors.InternalServerError("Incomplete validation request")
@@ -657,22 +922,53 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 		ID:        req.Authz.Id,
 		Requester: req.Authz.RegID,
 		Hostname:  req.Domain,
+		Proxy:     va.egressProxy.String(),
 	}
 	vStart := va.clk.Now()
 
 	var remoteResults chan *remoteValidationResult
+	cancelRemote := func() {}
 	if remoteVACount := len(va.remoteVAs); remoteVACount > 0 {
 		remoteResults = make(chan *remoteValidationResult, remoteVACount)
-		go va.performRemoteValidation(ctx, req, remoteResults)
+		var remoteCtx context.Context
+		remoteCtx, cancelRemote = va.remoteValidationContext(ctx)
+		go va.performRemoteValidation(remoteCtx, req, remoteResults)
 	}
+	// cancelRemote is handed off if remote results are still to be stored once
+	// this returns.
+	defer func() { cancelRemote() }()
 
 	challenge, err := bgrpc.PBToChallenge(req.Challenge)
 	if err != nil {
 		return nil, probs.ServerInternal("Challenge failed to deserialize")
 	}
 
-	records, prob := va.validate(ctx, identifier.DNSIdentifier(req.Domain), req.Authz.RegID, challenge)
+	var collector *evidence.Collector
+	if va.evidenceArchive != nil {
+		ctx, collector = evidence.WithCollector(ctx)
+	}
+
+	ident := identifier.DNSIdentifier(req.Domain)
+	var records []core.ValidationRecord
+	var prob *probs.ProblemDetails
+	var collapsed bool
+	if features.Enabled(features.DeduplicateValidations) {
+		records, prob, collapsed = va.inflight.do(ctx, validationKey(ident, req.Authz.RegID, challenge), sharedValidationTimeout,
+			func(ctx context.Context) ([]core.ValidationRecord, *probs.ProblemDetails) {
+				return va.validate(ctx, ident, req.Authz.RegID, challenge)
+			})
+		if collapsed {
+			va.metrics.collapsedValidations.WithLabelValues(string(challenge.Type)).Inc()
+		}
+	} else {
+		records, prob = va.validate(ctx, ident, req.Authz.RegID, challenge)
+	}
 	challenge.ValidationRecord = records
+	// A collapsed validation made no network requests of its own, so there is
+	// no evidence to save; the validation whose result it shared saves it.
+	if collector != nil && !collapsed {
+		go va.saveEvidence(collector, req, challenge.Type, prob)
+	}
 	localValidationLatency := time.Since(vStart)
 
 	// Check for malformed ValidationRecords
@@ -680,32 +976,46 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 		prob = probs.ServerInternal("Records for validation failed sanity check")
 	}
 
+	// The primary VA's own result, before any remote results are considered.
+	primaryProb := prob
+	var remoteResultsSeen []*remoteValidationResult
+
 	var problemType string
 	if prob != nil {
 		problemType = string(prob.Type)
 		challenge.Status = core.StatusInvalid
 		challenge.Error = prob
 		logEvent.Error = prob.Error()
+		if remoteResults != nil {
+			// The remote results can't change the outcome, but they're still
+			// returned as perspectives.
+			remoteResultsSeen = va.collectRemoteResults(remoteResults, len(va.remoteVAs))
+		}
 	} else if remoteResults != nil {
 		if !features.Enabled(features.EnforceMultiVA) && features.Enabled(features.MultiVAFullResults) {
 			// If we're not going to enforce multi VA but we are logging the
-			// differentials then collect and log the remote results in a separate go
-			// routine to avoid blocking the primary VA.
-			go func() {
-				_ = va.processRemoteResults(
-					req.Domain,
-					req.Authz.RegID,
-					string(challenge.Type),
-					prob,
-					remoteResults,
-					len(va.remoteVAs))
-			}()
+			// differentials then collect and log the remote results. They're
+			// waited for, rather than collected in the background, so that they
+			// can be returned as perspectives.
+			_, remoteResultsSeen = va.processRemoteResults(
+				req.Domain,
+				req.Authz.RegID,
+				string(challenge.Type),
+				prob,
+				remoteResults,
+				len(va.remoteVAs))
 			// Since prob was nil and we're not enforcing the results from
 			// `processRemoteResults` set the challenge status to valid so the
 			// validationTime metrics increment has the correct result label.
 			challenge.Status = core.StatusValid
-		} else if features.Enabled(features.EnforceMultiVA) {
-			remoteProb := va.processRemoteResults(
+		} else if !features.Enabled(features.EnforceMultiVA) {
+			// The remote results are neither enforced nor logged, but they're
+			// still returned as perspectives.
+			remoteResultsSeen = va.collectRemoteResults(remoteResults, len(va.remoteVAs))
+			challenge.Status = core.StatusValid
+		} else {
+			var remoteProb *probs.ProblemDetails
+			remoteProb, remoteResultsSeen = va.processRemoteResults(
 				req.Domain,
 				req.Authz.RegID,
 				string(challenge.Type),
@@ -725,6 +1035,14 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 			} else {
 				challenge.Status = core.StatusValid
 			}
+
+			// If the outcome was decided before every remote VA reported, the
+			// rest can only be stored once they do.
+			late := len(va.remoteVAs) - len(remoteResultsSeen)
+			if late > 0 && va.sa != nil {
+				go va.storeLateRemoteResults(req.Authz.Id, remoteResults, late, cancelRemote)
+				cancelRemote = func() {}
+			}
 		}
 	} else {
 		challenge.Status = core.StatusValid
@@ -747,5 +1065,29 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 
 	va.log.AuditObject("Validation result", logEvent)
 
-	return bgrpc.ValidationResultToPB(records, prob)
+	res, err := bgrpc.ValidationResultToPB(records, prob)
+	if err != nil {
+		return nil, err
+	}
+	res.Perspective = va.perspective
+	res.Rir = va.rir
+	res.Perspectives, err = va.perspectiveResultsToPB(primaryProb, remoteResultsSeen)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// saveEvidence archives the evidence collected during a validation, along with
+// the problem the validation found, if any. It runs in its own goroutine so as
+// not to delay the validation result, and so has its own timeout.
+func (va *ValidationAuthorityImpl) saveEvidence(collector *evidence.Collector, req *vapb.PerformValidationRequest, challengeType core.AcmeChallenge, prob *probs.ProblemDetails) {
+	ctx, cancel := context.WithTimeout(context.Background(), evidenceSaveTimeout)
+	defer cancel()
+	var err error
+	if prob != nil {
+		err = prob
+	}
+	// Errors are logged and counted by the archive.
+	_ = va.evidenceArchive.Save(ctx, collector, req.Authz.Id, req.Domain, challengeType, err)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -26,10 +27,13 @@ import (
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/probs"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/va/evidence"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"gopkg.in/go-jose/go-jose.v2"
 )
 
@@ -131,12 +135,21 @@ func setup(srv *httptest.Server, maxRemoteFailures int, userAgent string, remote
 		&bdns.MockClient{Log: logger},
 		nil,
 		maxRemoteFailures,
+		maxRemoteFailures,
 		userAgent,
 		"letsencrypt.org",
 		metrics.NoopRegisterer,
 		fc,
 		logger,
 		accountURIPrefixes,
+		"primary",
+		"ARIN",
+		nil,
+		nil,
+		ConcurrencyLimits{},
+		nil,
+		"",
+		nil,
 	)
 
 	// Adjusting industry regulated ACME challenge port settings is fine during
@@ -156,9 +169,10 @@ func setup(srv *httptest.Server, maxRemoteFailures int, userAgent string, remote
 	return va, logger
 }
 
-func setupRemote(srv *httptest.Server, userAgent string) vapb.VAClient {
+func setupRemote(srv *httptest.Server, userAgent string) RemoteClients {
 	innerVA, _ := setup(srv, 0, userAgent, nil)
-	return &localRemoteVA{remote: *innerVA}
+	lrva := &localRemoteVA{remote: *innerVA}
+	return RemoteClients{VAClient: lrva, CAAClient: lrva}
 }
 
 type multiSrv struct {
@@ -210,6 +224,10 @@ func (v cancelledVA) PerformValidation(_ context.Context, _ *vapb.PerformValidat
 	return nil, context.Canceled
 }
 
+func (v cancelledVA) IsCAAValid(_ context.Context, _ *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return nil, context.Canceled
+}
+
 // brokenRemoteVA is a mock for the vapb.VAClient interface mocked to
 // always return errors.
 type brokenRemoteVA struct{}
@@ -223,6 +241,11 @@ func (b brokenRemoteVA) PerformValidation(_ context.Context, _ *vapb.PerformVali
 	return nil, errBrokenRemoteVA
 }
 
+// IsCAAValid returns errBrokenRemoteVA unconditionally
+func (b brokenRemoteVA) IsCAAValid(_ context.Context, _ *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return nil, errBrokenRemoteVA
+}
+
 // localRemoteVA is a wrapper which fulfills the VAClient interface, but then
 // forwards requests directly to its inner ValidationAuthorityImpl rather than
 // over the network. This lets a local in-memory mock VA act like a remote VA.
@@ -234,6 +257,10 @@ func (lrva localRemoteVA) PerformValidation(ctx context.Context, req *vapb.Perfo
 	return lrva.remote.PerformValidation(ctx, req)
 }
 
+func (lrva localRemoteVA) IsCAAValid(ctx context.Context, req *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return lrva.remote.IsCAAValid(ctx, req)
+}
+
 func TestValidateMalformedChallenge(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
@@ -278,6 +305,49 @@ func TestPerformValidationValid(t *testing.T) {
 	}
 }
 
+// evidenceChanStore is an evidence.Store which sends each stored object on a
+// channel.
+type evidenceChanStore chan []byte
+
+func (s evidenceChanStore) Put(_ context.Context, _ string, data []byte) error {
+	s <- data
+	return nil
+}
+
+func TestPerformValidationEvidence(t *testing.T) {
+	hs := httpSrv(t, expectedToken)
+	defer hs.Close()
+	va, _ := setup(hs, 0, "", nil)
+	store := make(evidenceChanStore, 1)
+	va.evidenceArchive = evidence.NewArchive(store, "primary", va.clk, metrics.NoopRegisterer, blog.NewMock())
+
+	req := createValidationRequest("localhost.com", core.ChallengeTypeHTTP01)
+	req.Authz.Id = "1234"
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := va.PerformValidation(ctx, req)
+	test.AssertNotError(t, err, "PerformValidation failed")
+	test.Assert(t, res.Problems == nil, fmt.Sprintf("validation failed: %#v", res.Problems))
+
+	var data []byte
+	select {
+	case data = <-store:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for evidence to be saved")
+	}
+	var ev evidence.Evidence
+	err = json.Unmarshal(data, &ev)
+	test.AssertNotError(t, err, "unmarshalling evidence")
+	test.AssertEquals(t, ev.AuthzID, "1234")
+	test.AssertEquals(t, ev.Perspective, "primary")
+	test.AssertEquals(t, ev.Challenge, core.ChallengeTypeHTTP01)
+	test.AssertEquals(t, ev.Problem, "")
+	test.AssertEquals(t, len(ev.HTTP), 1)
+	test.AssertEquals(t, ev.HTTP[0].StatusCode, http.StatusOK)
+	// The body is recorded as received, before whitespace is trimmed.
+	test.AssertEquals(t, strings.TrimSpace(string(ev.HTTP[0].Body)), expectedKeyAuthorization)
+}
+
 // TestPerformValidationWildcard tests that the VA properly strips the `*.`
 // prefix from a wildcard name provided to the PerformValidation function.
 func TestPerformValidationWildcard(t *testing.T) {
@@ -403,7 +473,7 @@ func TestMultiVA(t *testing.T) {
 			Name: "Local VA ok, remote VA internal err, enforce multi VA",
 			RemoteVAs: []RemoteVA{
 				{remoteVA1, remoteUA1},
-				{&brokenRemoteVA{}, "broken"},
+				{RemoteClients{&brokenRemoteVA{}, &brokenRemoteVA{}}, "broken"},
 			},
 			AllowedUAs:   allowedUAs,
 			Features:     enforceMultiVA,
@@ -417,7 +487,7 @@ func TestMultiVA(t *testing.T) {
 			Name: "Local VA ok, remote VA internal err, no enforce multi VA",
 			RemoteVAs: []RemoteVA{
 				{remoteVA1, remoteUA1},
-				{&brokenRemoteVA{}, "broken"},
+				{RemoteClients{&brokenRemoteVA{}, &brokenRemoteVA{}}, "broken"},
 			},
 			AllowedUAs: allowedUAs,
 			Features:   noEnforceMultiVA,
@@ -449,7 +519,7 @@ func TestMultiVA(t *testing.T) {
 			Name: "Local VA and one remote VA OK, one cancelled VA, enforce multi VA",
 			RemoteVAs: []RemoteVA{
 				{remoteVA1, remoteUA1},
-				{cancelledVA{}, remoteUA2},
+				{RemoteClients{cancelledVA{}, cancelledVA{}}, remoteUA2},
 			},
 			AllowedUAs:   allowedUAs,
 			Features:     enforceMultiVA,
@@ -459,8 +529,8 @@ func TestMultiVA(t *testing.T) {
 			// When enforcing multi-VA, any cancellations are a problem.
 			Name: "Local VA OK, two cancelled remote VAs, enforce multi VA",
 			RemoteVAs: []RemoteVA{
-				{cancelledVA{}, remoteUA1},
-				{cancelledVA{}, remoteUA2},
+				{RemoteClients{cancelledVA{}, cancelledVA{}}, remoteUA1},
+				{RemoteClients{cancelledVA{}, cancelledVA{}}, remoteUA2},
 			},
 			AllowedUAs:   allowedUAs,
 			Features:     enforceMultiVA,
@@ -656,6 +726,172 @@ func TestMultiVAPolicy(t *testing.T) {
 	}
 }
 
+func TestMultiVAPerspectives(t *testing.T) {
+	const (
+		remoteUA1 = "remote 1"
+		remoteUA2 = "remote 2"
+		localUA   = "local 1"
+	)
+	allowedUAs := map[string]bool{
+		localUA:   true,
+		remoteUA1: true,
+		remoteUA2: false,
+	}
+
+	ms := httpMultiSrv(t, expectedToken, allowedUAs)
+	defer ms.Close()
+
+	remoteVA1, _ := setup(ms.Server, 0, remoteUA1, nil)
+	remoteVA1.perspective = "remoteA"
+	remoteVA1.rir = "RIPE"
+	// The second remote VA doesn't report a perspective, so it's named by its
+	// address.
+	remoteVA2, _ := setup(ms.Server, 0, remoteUA2, nil)
+	remoteVA2.perspective = ""
+	remoteVA2.rir = ""
+	remoteVAs := []RemoteVA{
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA1}}, remoteUA1},
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA2}}, remoteUA2},
+	}
+	localVA, _ := setup(ms.Server, 1, localUA, remoteVAs)
+
+	err := features.Set(map[string]bool{
+		"EnforceMultiVA":     true,
+		"MultiVAFullResults": true,
+	})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	req := createValidationRequest("localhost", core.ChallengeTypeHTTP01)
+	res, err := localVA.PerformValidation(ctx, req)
+	test.AssertNotError(t, err, "performing validation")
+	test.Assert(t, res.Problems == nil, fmt.Sprintf("validation failed: %#v", res.Problems))
+	test.AssertEquals(t, res.Perspective, "primary")
+	test.AssertEquals(t, res.Rir, "ARIN")
+
+	// The primary VA's result comes first, followed by the remote VAs' in the
+	// order they responded.
+	test.AssertEquals(t, len(res.Perspectives), 3)
+	test.AssertEquals(t, res.Perspectives[0].Perspective, "primary")
+	test.Assert(t, res.Perspectives[0].Success, "primary perspective failed")
+	remotes := map[string]*corepb.PerspectiveResult{}
+	for _, p := range res.Perspectives[1:] {
+		remotes[p.Perspective] = p
+	}
+	test.Assert(t, remotes["remoteA"].Success, "remoteA perspective failed")
+	test.AssertEquals(t, remotes["remoteA"].Rir, "RIPE")
+	test.Assert(t, !remotes[remoteUA2].Success, "remote 2 perspective succeeded")
+	test.AssertEquals(t, remotes[remoteUA2].Problem.ProblemType, string(probs.UnauthorizedProblem))
+}
+
+func TestMultiVAPerspectivesPrimaryFailure(t *testing.T) {
+	const (
+		remoteUA1 = "remote 1"
+		remoteUA2 = "remote 2"
+		localUA   = "local 1"
+	)
+	allowedUAs := map[string]bool{
+		localUA:   false,
+		remoteUA1: true,
+		remoteUA2: true,
+	}
+
+	ms := httpMultiSrv(t, expectedToken, allowedUAs)
+	defer ms.Close()
+
+	remoteVA1, _ := setup(ms.Server, 0, remoteUA1, nil)
+	remoteVA1.perspective = "remoteA"
+	remoteVA2, _ := setup(ms.Server, 0, remoteUA2, nil)
+	remoteVA2.perspective = "remoteB"
+	remoteVAs := []RemoteVA{
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA1}}, remoteUA1},
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA2}}, remoteUA2},
+	}
+	localVA, _ := setup(ms.Server, 0, localUA, remoteVAs)
+
+	err := features.Set(map[string]bool{"EnforceMultiVA": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	req := createValidationRequest("localhost", core.ChallengeTypeHTTP01)
+	res, err := localVA.PerformValidation(ctx, req)
+	test.AssertNotError(t, err, "performing validation")
+	test.AssertNotNil(t, res.Problems, "validation should have failed")
+
+	// The remote VAs' results are returned even though the primary VA's
+	// failure already decided the outcome.
+	test.AssertEquals(t, len(res.Perspectives), 3)
+	test.Assert(t, !res.Perspectives[0].Success, "primary perspective succeeded")
+	for _, p := range res.Perspectives[1:] {
+		test.Assert(t, p.Success, fmt.Sprintf("%s perspective failed", p.Perspective))
+	}
+}
+
+// mockSALatePerspectives is a mock SA which passes on the late remote results
+// it's asked to store.
+type mockSALatePerspectives struct {
+	sapb.StorageAuthorityClient
+	added chan *sapb.AddValidationPerspectivesRequest
+}
+
+func (sa *mockSALatePerspectives) AddValidationPerspectives(_ context.Context, req *sapb.AddValidationPerspectivesRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.added <- req
+	return &emptypb.Empty{}, nil
+}
+
+func TestMultiVAPerspectivesEarlyQuorum(t *testing.T) {
+	const (
+		remoteUA1 = "remote 1"
+		remoteUA2 = "remote 2"
+		localUA   = "local 1"
+	)
+	allowedUAs := map[string]bool{
+		localUA:   true,
+		remoteUA1: true,
+		remoteUA2: true,
+	}
+
+	ms := httpMultiSrv(t, expectedToken, allowedUAs)
+	defer ms.Close()
+
+	remoteVA1, _ := setup(ms.Server, 0, remoteUA1, nil)
+	remoteVA1.perspective = "remoteA"
+	remoteVA2, _ := setup(ms.Server, 0, remoteUA2, nil)
+	remoteVA2.perspective = "remoteB"
+	remoteVAs := []RemoteVA{
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA1}}, remoteUA1},
+		{RemoteClients{VAClient: &localRemoteVA{remote: *remoteVA2}}, remoteUA2},
+	}
+	// With one remote failure allowed, the first remote success decides the
+	// outcome, before the other remote VA has reported.
+	localVA, _ := setup(ms.Server, 1, localUA, remoteVAs)
+	msa := &mockSALatePerspectives{added: make(chan *sapb.AddValidationPerspectivesRequest, 1)}
+	localVA.sa = msa
+
+	err := features.Set(map[string]bool{"EnforceMultiVA": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	req := createValidationRequest("localhost", core.ChallengeTypeHTTP01)
+	req.Authz.Id = "1"
+	res, err := localVA.PerformValidation(ctx, req)
+	test.AssertNotError(t, err, "performing validation")
+	test.Assert(t, res.Problems == nil, fmt.Sprintf("validation failed: %#v", res.Problems))
+	test.AssertEquals(t, len(res.Perspectives), 2)
+
+	// The other remote VA's result is stored once it arrives.
+	select {
+	case added := <-msa.added:
+		test.AssertEquals(t, fmt.Sprint(added.AuthzID), req.Authz.Id)
+		test.AssertEquals(t, len(added.Perspectives), 1)
+		test.Assert(t, added.Perspectives[0].Success, "late perspective failed")
+		test.Assert(t, added.Perspectives[0].Perspective != res.Perspectives[1].Perspective,
+			"late perspective was also returned")
+	case <-time.After(5 * time.Second):
+		t.Fatal("late remote result wasn't stored")
+	}
+}
+
 func TestDetailedError(t *testing.T) {
 	cases := []struct {
 		err      error
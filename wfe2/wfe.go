@@ -131,6 +131,15 @@ type WebFrontEndImpl struct {
 	// URL to the current subscriber agreement (should contain some version identifier)
 	SubscriberAgreementURL string
 
+	// TermsOfServiceVersion is the version of the subscriber agreement at
+	// SubscriberAgreementURL, recorded when an account agrees to it.
+	TermsOfServiceVersion string
+
+	// MinimumTermsOfServiceVersion is the oldest version of the subscriber
+	// agreement which an account may have agreed to and still create orders.
+	// Versions are compared as strings. If empty, no version is required.
+	MinimumTermsOfServiceVersion string
+
 	// DirectoryCAAIdentity is used for the /directory response's "meta"
 	// element's "caaIdentities" field. It should match the VA's issuerDomain
 	// field value.
@@ -731,6 +740,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		Agreement:       wfe.SubscriberAgreementURL,
 		Key:             keyBytes,
 		InitialIP:       ipBytes,
+		TosVersion:      wfe.TermsOfServiceVersion,
 	}
 
 	// Send the registration to the RA via grpc
@@ -1328,11 +1338,13 @@ func (wfe *WebFrontEndImpl) updateAccount(
 	ctx context.Context,
 	requestBody []byte,
 	currAcct *core.Registration) (*core.Registration, *probs.ProblemDetails) {
-	// Only the Contact and Status fields of an account may be updated this way.
-	// For key updates clients should be using the key change endpoint.
+	// Only the Contact and Status fields of an account may be updated this way,
+	// and the account may agree to the current terms of service. For key
+	// updates clients should be using the key change endpoint.
 	var accountUpdateRequest struct {
-		Contact *[]string       `json:"contact"`
-		Status  core.AcmeStatus `json:"status"`
+		Contact              *[]string       `json:"contact"`
+		Status               core.AcmeStatus `json:"status"`
+		TermsOfServiceAgreed bool            `json:"termsOfServiceAgreed"`
 	}
 
 	err := json.Unmarshal(requestBody, &accountUpdateRequest)
@@ -1361,6 +1373,10 @@ func (wfe *WebFrontEndImpl) updateAccount(
 		ContactsPresent: contactsPresent,
 		Status:          string(accountUpdateRequest.Status),
 	}
+	if accountUpdateRequest.TermsOfServiceAgreed && wfe.TermsOfServiceVersion != "" {
+		updatePb.Agreement = wfe.SubscriberAgreementURL
+		updatePb.TosVersion = wfe.TermsOfServiceVersion
+	}
 
 	// People *will* POST their full accounts to this endpoint, including
 	// the 'valid' status, to avoid always failing out when that happens only
@@ -1899,7 +1915,10 @@ type orderJSON struct {
 	Authorizations []string                    `json:"authorizations"`
 	Finalize       string                      `json:"finalize"`
 	Certificate    string                      `json:"certificate,omitempty"`
-	Error          *probs.ProblemDetails       `json:"error,omitempty"`
+	// AlternateCertificate is only present for valid orders finalized with an
+	// alternate CSR. It is a Boulder-specific extension of the ACME order object.
+	AlternateCertificate string                `json:"alternateCertificate,omitempty"`
+	Error                *probs.ProblemDetails `json:"error,omitempty"`
 }
 
 // orderToOrderJSON converts a *corepb.Order instance into an orderJSON struct
@@ -1936,10 +1955,39 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 		certURL := web.RelativeEndpoint(request,
 			fmt.Sprintf("%s%s", certPath, order.CertificateSerial))
 		respObj.Certificate = certURL
+		if order.AlternateCertificateSerial != "" {
+			respObj.AlternateCertificate = web.RelativeEndpoint(request,
+				fmt.Sprintf("%s%s", certPath, order.AlternateCertificateSerial))
+		}
 	}
 	return respObj
 }
 
+// checkTermsAgreement returns a UserActionRequiredProblem if the account acctID
+// has not agreed to at least the MinimumTermsOfServiceVersion of the
+// subscriber agreement. As RFC 8555 Section 7.3.3 requires, the problem's
+// instance URL is where a human can review the current agreement.
+func (wfe *WebFrontEndImpl) checkTermsAgreement(ctx context.Context, acctID int64) *probs.ProblemDetails {
+	if wfe.MinimumTermsOfServiceVersion == "" || !features.Enabled(features.TrackTermsAgreements) {
+		return nil
+	}
+	var version string
+	agreement, err := wfe.sa.GetTermsAgreement(ctx, &sapb.RegistrationID{Id: acctID})
+	if err == nil {
+		version = agreement.Version
+	} else if !errors.Is(err, berrors.NotFound) {
+		return web.ProblemDetailsForError(err, "Error checking terms of service agreement")
+	}
+	if version < wfe.MinimumTermsOfServiceVersion {
+		prob := probs.UserActionRequired(
+			"Account must agree to the current terms of service at %s, by updating the account with termsOfServiceAgreed set to true",
+			wfe.SubscriberAgreementURL)
+		prob.Instance = wfe.SubscriberAgreementURL
+		return prob
+	}
+	return nil
+}
+
 // NewOrder is used by clients to create a new order object and a set of
 // authorizations to fulfill for issuance.
 func (wfe *WebFrontEndImpl) NewOrder(
@@ -1955,6 +2003,15 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
+	prob = wfe.checkTermsAgreement(ctx, acct.ID)
+	if prob != nil {
+		if prob.Type == probs.UserActionRequiredProblem {
+			response.Header().Add("Link", link(wfe.SubscriberAgreementURL, "terms-of-service"))
+		}
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
 	// We only allow specifying Identifiers in a new order request - if the
 	// `notBefore` and/or `notAfter` fields described in Section 7.4 of acme-08
 	// are sent we return a probs.Malformed as we do not support them
@@ -2211,9 +2268,23 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 	logEvent.DNSNames = order.Names
 	logEvent.Extra["KeyType"] = web.KeyTypeToString(csr.PublicKey)
 
+	if len(rawCSR.AlternateCSR) != 0 {
+		if !features.Enabled(features.DualCertificateOrders) {
+			wfe.sendError(response, logEvent, probs.Malformed("Alternate certificate requests are not supported"), nil)
+			return
+		}
+		altCSR, err := x509.ParseCertificateRequest(rawCSR.AlternateCSR)
+		if err != nil {
+			wfe.sendError(response, logEvent, probs.Malformed("Error parsing alternate certificate request: %s", err), err)
+			return
+		}
+		logEvent.Extra["AlternateKeyType"] = web.KeyTypeToString(altCSR.PublicKey)
+	}
+
 	updatedOrder, err := wfe.ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{
-		Csr:   rawCSR.CSR,
-		Order: order,
+		Csr:          rawCSR.CSR,
+		AlternateCsr: rawCSR.AlternateCSR,
+		Order:        order,
 	})
 	if err != nil {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error finalizing order"), err)
@@ -247,6 +247,14 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, in *rapb
 	return in.Order, nil
 }
 
+func (ra *MockRegistrationAuthority) ResumeIssuance(context.Context, *rapb.ResumeIssuanceRequest, ...grpc.CallOption) (*corepb.Order, error) {
+	return &corepb.Order{}, nil
+}
+
+func (ra *MockRegistrationAuthority) ReviewOrder(context.Context, *rapb.ReviewOrderRequest, ...grpc.CallOption) (*sapb.OrderReview, error) {
+	return &sapb.OrderReview{}, nil
+}
+
 func makeBody(s string) io.ReadCloser {
 	return io.NopCloser(strings.NewReader(s))
 }
@@ -2579,6 +2587,101 @@ func TestNewOrder(t *testing.T) {
 	}
 }
 
+// mockSAWithTermsAgreement is a StorageAuthorityReadOnly for which every
+// account has agreed to the given version of the terms of service.
+type mockSAWithTermsAgreement struct {
+	sapb.StorageAuthorityReadOnlyClient
+	version string
+}
+
+func (sa *mockSAWithTermsAgreement) GetTermsAgreement(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.TermsAgreement, error) {
+	return &sapb.TermsAgreement{RegistrationID: req.Id, Version: sa.version}, nil
+}
+
+func TestNewOrderTermsOfService(t *testing.T) {
+	wfe, _, signer := setupWFE(t)
+	wfe.SubscriberAgreementURL = "https://example.com/terms/2023-07-01"
+	wfe.TermsOfServiceVersion = "2023-07-01"
+	wfe.MinimumTermsOfServiceVersion = "2023-07-01"
+
+	signedURL := "http://localhost/new-order"
+	body := `{"identifiers":[{"type":"dns","value":"not-example.com"}]}`
+
+	// Without the TrackTermsAgreements feature, the agreement isn't checked.
+	responseWriter := httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "new-order", signedURL, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+
+	err := features.Set(map[string]bool{"TrackTermsAgreements": true})
+	test.AssertNotError(t, err, "setting feature flags")
+	defer features.Reset()
+
+	// The mock SA has no agreement recorded for the account.
+	responseWriter = httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "new-order", signedURL, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(),
+		`{"type":"`+probs.ErrorNS+`userActionRequired","detail":"Account must agree to the current terms of service at https://example.com/terms/2023-07-01, by updating the account with termsOfServiceAgreed set to true","status":403,"instance":"https://example.com/terms/2023-07-01"}`)
+	test.AssertEquals(t, responseWriter.Header().Get("Link"), `<https://example.com/terms/2023-07-01>;rel="terms-of-service"`)
+
+	wfe.sa = &mockSAWithTermsAgreement{wfe.sa, "2023-01-01"}
+	responseWriter = httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "new-order", signedURL, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+
+	wfe.sa = &mockSAWithTermsAgreement{wfe.sa, "2023-07-01"}
+	responseWriter = httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "new-order", signedURL, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+
+	// Without a minimum version, the agreement isn't checked.
+	wfe.MinimumTermsOfServiceVersion = ""
+	wfe.sa = &mockSAWithTermsAgreement{wfe.sa, "2023-01-01"}
+	responseWriter = httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "new-order", signedURL, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+}
+
+// mockRAWithUpdate is a RegistrationAuthority which keeps the last update it
+// was asked to make to an account.
+type mockRAWithUpdate struct {
+	MockRegistrationAuthority
+	lastUpdate *corepb.Registration
+}
+
+func (ra *mockRAWithUpdate) UpdateRegistration(ctx context.Context, in *rapb.UpdateRegistrationRequest, opts ...grpc.CallOption) (*corepb.Registration, error) {
+	ra.lastUpdate = in.Update
+	return ra.MockRegistrationAuthority.UpdateRegistration(ctx, in, opts...)
+}
+
+func TestUpdateAccountTermsOfServiceAgreed(t *testing.T) {
+	wfe, _, _ := setupWFE(t)
+	mra := &mockRAWithUpdate{}
+	wfe.ra = mra
+	wfe.SubscriberAgreementURL = "https://example.com/terms/2023-07-01"
+	wfe.TermsOfServiceVersion = "2023-07-01"
+
+	var key jose.JSONWebKey
+	err := json.Unmarshal([]byte(test1KeyPublicJSON), &key)
+	test.AssertNotError(t, err, "unmarshaling key")
+	acct := &core.Registration{
+		ID:        1,
+		Key:       &key,
+		Agreement: "https://example.com/terms/2023-01-01",
+		InitialIP: []byte{1, 1, 1, 1},
+		Status:    core.StatusValid,
+	}
+	_, prob := wfe.updateAccount(ctx, []byte(`{"termsOfServiceAgreed":true}`), acct)
+	test.Assert(t, prob == nil, "updating account")
+	test.AssertEquals(t, mra.lastUpdate.TosVersion, "2023-07-01")
+	test.AssertEquals(t, mra.lastUpdate.Agreement, "https://example.com/terms/2023-07-01")
+
+	_, prob = wfe.updateAccount(ctx, []byte(`{}`), acct)
+	test.Assert(t, prob == nil, "updating account")
+	test.AssertEquals(t, mra.lastUpdate.TosVersion, "")
+	test.AssertEquals(t, mra.lastUpdate.Agreement, "")
+}
+
 func TestFinalizeOrder(t *testing.T) {
 	wfe, _, signer := setupWFE(t)
 	responseWriter := httptest.NewRecorder()
@@ -3316,6 +3419,52 @@ func TestFinalizeSCTError(t *testing.T) {
 		`{"type":"`+probs.ErrorNS+`serverInternal","detail":"Error finalizing order :: Unable to meet CA SCT embedding requirements","status":500}`)
 }
 
+// alternateCSRMockRA is a mock RA that records the `FinalizeOrder` request it
+// receives.
+type alternateCSRMockRA struct {
+	MockRegistrationAuthority
+	req *rapb.FinalizeOrderRequest
+}
+
+func (ra *alternateCSRMockRA) FinalizeOrder(ctx context.Context, in *rapb.FinalizeOrderRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	ra.req = in
+	return ra.MockRegistrationAuthority.FinalizeOrder(ctx, in)
+}
+
+func TestFinalizeOrderAlternateCSR(t *testing.T) {
+	wfe, _, signer := setupWFE(t)
+	mockRA := &alternateCSRMockRA{}
+	wfe.ra = mockRA
+	responseWriter := httptest.NewRecorder()
+
+	// Example CSR taken from `TestFinalizeOrder`. The WFE doesn't check that
+	// the two CSRs use different key types; that is left to the RA.
+	csr := "MIICYjCCAUoCAQAwHTEbMBkGA1UEAwwSbm90LWFuLWV4YW1wbGUuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAmqs7nue5oFxKBk2WaFZJAma2nm1oFyPIq19gYEAdQN4mWvaJ8RjzHFkDMYUrlIrGxCYuFJDHFUk9dh19Na1MIY-NVLgcSbyNcOML3bLbLEwGmvXPbbEOflBA9mxUS9TLMgXW5ghf_qbt4vmSGKloIim41QXt55QFW6O-84s8Kd2OE6df0wTsEwLhZB3j5pDU-t7j5vTMv4Tc7EptaPkOdfQn-68viUJjlYM_4yIBVRhWCdexFdylCKVLg0obsghQEwULKYCUjdg6F0VJUI115DU49tzscXU_3FS3CyY8rchunuYszBNkdmgpAwViHNWuP7ESdEd_emrj1xuioSe6PwIDAQABoAAwDQYJKoZIhvcNAQELBQADggEBAE_T1nWU38XVYL28hNVSXU0rW5IBUKtbvr0qAkD4kda4HmQRTYkt-LNSuvxoZCC9lxijjgtJi-OJe_DCTdZZpYzewlVvcKToWSYHYQ6Wm1-fxxD_XzphvZOujpmBySchdiz7QSVWJmVZu34XD5RJbIcrmj_cjRt42J1hiTFjNMzQu9U6_HwIMmliDL-soFY2RTvvZf-dAFvOUQ-Wbxt97eM1PbbmxJNWRhbAmgEpe9PWDPTpqV5AK56VAa991cQ1P8ZVmPss5hvwGWhOtpnpTZVHN3toGNYFKqxWPboirqushQlfKiFqT9rpRgM3-mFjOHidGqsKEkTdmfSVlVEk3oo="
+	dualPayload := fmt.Sprintf(`{"csr": %q, "alternateCSR": %q}`, csr, csr)
+
+	// Without the feature enabled an alternate CSR is rejected.
+	wfe.FinalizeOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "1/8", "http://localhost/1/8", dualPayload))
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(),
+		`{"type":"`+probs.ErrorNS+`malformed","detail":"Alternate certificate requests are not supported","status":400}`)
+	test.Assert(t, mockRA.req == nil, "RA was called for a rejected request")
+
+	err := features.Set(map[string]bool{"DualCertificateOrders": true})
+	test.AssertNotError(t, err, "setting feature flag")
+	defer features.Reset()
+
+	responseWriter.Body.Reset()
+	badPayload := fmt.Sprintf(`{"csr": %q, "alternateCSR": "ABCD"}`, csr)
+	wfe.FinalizeOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "1/8", "http://localhost/1/8", badPayload))
+	test.AssertContains(t, responseWriter.Body.String(), "Error parsing alternate certificate request")
+	test.Assert(t, mockRA.req == nil, "RA was called for a rejected request")
+
+	responseWriter = httptest.NewRecorder()
+	wfe.FinalizeOrder(ctx, newRequestEvent(), responseWriter, signAndPost(signer, "1/8", "http://localhost/1/8", dualPayload))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	test.AssertNotNil(t, mockRA.req, "RA wasn't called")
+	test.AssertByteEquals(t, mockRA.req.AlternateCsr, mockRA.req.Csr)
+}
+
 func TestOrderToOrderJSONV2Authorizations(t *testing.T) {
 	wfe, fc, _ := setupWFE(t)
 
@@ -3333,6 +3482,27 @@ func TestOrderToOrderJSONV2Authorizations(t *testing.T) {
 	})
 }
 
+func TestOrderToOrderJSONAlternateCertificate(t *testing.T) {
+	wfe, fc, _ := setupWFE(t)
+
+	order := &corepb.Order{
+		Id:                         1,
+		RegistrationID:             1,
+		Names:                      []string{"a"},
+		Status:                     string(core.StatusValid),
+		Expires:                    fc.Now().UnixNano(),
+		CertificateSerial:          "serial",
+		AlternateCertificateSerial: "altserial",
+	}
+	orderJSON := wfe.orderToOrderJSON(&http.Request{}, order)
+	test.AssertEquals(t, orderJSON.Certificate, "http://localhost/acme/cert/serial")
+	test.AssertEquals(t, orderJSON.AlternateCertificate, "http://localhost/acme/cert/altserial")
+
+	order.AlternateCertificateSerial = ""
+	orderJSON = wfe.orderToOrderJSON(&http.Request{}, order)
+	test.AssertEquals(t, orderJSON.AlternateCertificate, "")
+}
+
 func TestGetChallengeUpRel(t *testing.T) {
 	wfe, _, _ := setupWFE(t)
 